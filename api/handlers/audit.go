@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/postgres"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AuditHandler struct {
+	AuditRepository postgres.AuditRepository
+}
+
+func (h *AuditHandler) GetAuditEntries(c *gin.Context) {
+	entityType := c.Query("entity")
+	entityID := c.Query("id")
+
+	if entityType != "" && !audit.IsValidEntity(entityType) {
+		c.Error(apperrors.Validation("unknown_entity", "Unknown entity type").WithField("entity", "must be one of "+strings.Join(audit.Entities, ", ")))
+		return
+	}
+
+	entries, err := h.AuditRepository.GetEntries(c.Request.Context(), entityType, entityID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// http://localhost:8080/api/v1/audit?entity=vacancy&id=9a95ebb5-4ef1-422b-b2a0-a8336d611f8a
+// [
+//     {
+//         "id": "b5f7d1f6-6f1e-4c87-9a0c-1d8f5b6f2a11",
+//         "actor": "recruiter-42",
+//         "entity_type": "vacancy",
+//         "entity_id": "9a95ebb5-4ef1-422b-b2a0-a8336d611f8a",
+//         "action": "update",
+//         "before": {"min_exp": 5, "position": "Backend Engineer", ...},
+//         "after": {"min_exp": 6, "position": "junior engineer", ...},
+//         "diff": {"min_exp": {"from": 5, "to": 6}, "position": {"from": "Backend Engineer", "to": "junior engineer"}},
+//         "request_id": "4a0e3c7e-5c1b-4f0e-8e55-0b1c8c1f7d2a",
+//         "ip": "127.0.0.1",
+//         "created_at": "2024-05-20T11:19:14.47031Z"
+//     }
+// ]
+
+// History bitta obyektning o'zgarishlar tarixini qaytaradigan handler yaratadi,
+// masalan GET /vacancies/:id/history
+func (h *AuditHandler) History(entityType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.Error(apperrors.Validation("invalid_id", "Invalid ID"))
+			return
+		}
+
+		entries, err := h.AuditRepository.GetEntries(c.Request.Context(), entityType, id.String())
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, entries)
+	}
+}
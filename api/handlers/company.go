@@ -3,8 +3,14 @@ package handlers
 import (
 	"net/http"
 
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/expand"
 	"hrplatform/models"
 	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/validation"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -12,24 +18,29 @@ import (
 
 type CompanyHandler struct {
 	CompanyRepository postgres.CompanyRepository
+	Service           *service.CompanyService
+	Audit             *audit.Recorder
+	Expand            *expand.Expander // ?expand= va ?fields= uchun
 }
 
 func (h *CompanyHandler) CreateCompany(c *gin.Context) {
 	var companyCreate models.CreateCompany
-	if err := c.BindJSON(&companyCreate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&companyCreate); err != nil {
+		c.Error(validation.FromBindError(err))
 		return
 	}
-	company, err := h.CompanyRepository.CreateCompany(companyCreate)
+	company, err := h.CompanyRepository.CreateCompany(c.Request.Context(), companyCreate)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
+	h.Audit.Record(c, audit.EntityCompany, company.ID.String(), audit.ActionCreate, nil, company)
+
 	c.JSON(http.StatusCreated, company)
 }
 
-// http://localhost:8080/companies
+// http://localhost:8080/api/v1/companies
 // {
 //     "name": "Google",
 //     "location": "Tashkent",
@@ -49,19 +60,20 @@ func (h *CompanyHandler) GetCompanyByID(c *gin.Context) {
 	id := c.Param("id")
 	companyID, err := uuid.Parse(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid company ID"))
 		return
 	}
 
-	company, err := h.CompanyRepository.GetCompanyByID(companyID.String())
+	company, err := h.CompanyRepository.GetCompanyByID(c.Request.Context(), companyID.String())
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+		c.Error(err)
 		return
 	}
+	setETag(c, company.Version)
 
-	c.JSON(http.StatusOK, company)
+	writeExpanded(c, h.Expand, audit.EntityCompany, company)
 }
-// http://localhost:8080/companies/8568841e-de0a-4ff6-8ff6-c0d298799b03
+// http://localhost:8080/api/v1/companies/8568841e-de0a-4ff6-8ff6-c0d298799b03
 // {
 //     "id": "8568841e-de0a-4ff6-8ff6-c0d298799b03",
 //     "name": "Najot Ta'lim",
@@ -73,82 +85,117 @@ func (h *CompanyHandler) GetCompanyByID(c *gin.Context) {
 // }
 // GetAllCompanies handles GET requests to retrieve all companies.
 func (h *CompanyHandler) GetAllCompanies(c *gin.Context) {
-	companies, err := h.CompanyRepository.GetAllCompanies()
+	companies, err := h.CompanyRepository.GetAllCompanies(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, companies)
+	writeExpanded(c, h.Expand, audit.EntityCompany, companies)
 }
-// http://localhost:8080/companies
-// [{
-// "id": "dd8134d4-ba10-4064-b7da-fa9fc0da7347",
-// "name": "Acme Corporation",
-// "location": "Silicon Valley",
-// "workers": 500,
-// "created_at": "2024-05-20T05:52:16.91036Z",
-// "updated_at": "2024-05-20T05:52:16.91036Z",
-// "deleted_at": 0},
-// {"id": "8568841e-de0a-4ff6-8ff6-c0d298799b03",
-// "name": "Najot Ta'lim",
-// "location": "Tashkent",
-// "workers": 500,
-// "created_at": "2024-05-20T05:52:59.996994Z",
-// "updated_at": "2024-05-20T05:52:59.996994Z",
-// "deleted_at": 0},
-// {"id": "d6c95d8b-c36c-4265-bc53-6b05b5cab4ab",
-// "name": "RealSoft",
-// "location": "Tashkent",
-// "workers": 120,
-// "created_at": "2024-05-20T05:53:31.496829Z",
-// "updated_at": "2024-05-20T05:53:31.496829Z",
-// "deleted_at": 0},
-// {"id": "106870ef-d698-415d-a476-73dc8edbc171",
-// "name": "Abu Tech",
-// "location": "Tashkent",
-// "workers": 500,
-// "created_at": "2024-05-20T05:56:09.182291Z",
-// "updated_at": "2024-05-20T05:56:09.182291Z",
-// "deleted_at": },
-// {"id": "73f9a53c-eb74-477f-b0aa-62e1485c3b8c",
-// "name": "Abu Tech",
-// "location": "Tashkent",
-// "workers": 500,
-// "created_at": "2024-05-20T05:57:22.4134Z",
-// "updated_at": "2024-05-20T05:57:22.4134Z",
-// "deleted_at": 0}
-// ]
-// UpdateCompany handles PUT requests to update a company's information.
-// UpdateCompany handles PUT requests to update a company's information.
+// UpdateCompany (PUT) kompaniyani to'liq almashtiradi
 func (h *CompanyHandler) UpdateCompany(c *gin.Context) {
 	id := c.Param("id")
+	companyID, err := uuid.Parse(id)
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid company ID"))
+		return
+	}
 
 	var companyUpdate models.UpdateCompany
-	if err := c.BindJSON(&companyUpdate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&companyUpdate); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	before, err := h.CompanyRepository.GetCompanyByID(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if _, ok := h.saveCompany(c, before, companyID, companyUpdate); ok {
+		c.JSON(http.StatusOK, middleware.Message(c, "company_updated"))
+	}
+}
+
+// PatchCompany (PATCH) joriy kompaniyaga JSON Merge Patch qo'llaydi va yangilangan yozuvni qaytaradi
+func (h *CompanyHandler) PatchCompany(c *gin.Context) {
+	id := c.Param("id")
+	companyID, err := uuid.Parse(id)
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid company ID"))
 		return
 	}
 
-	companyUpdate.ID = uuid.MustParse(id)
+	before, err := h.CompanyRepository.GetCompanyByID(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
-	if err := h.CompanyRepository.UpdateCompany(companyUpdate); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	companyUpdate := before.ToUpdate()
+	if err := bindMergePatch(c, &companyUpdate); err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Company updated successfully"})
+	if after, ok := h.saveCompany(c, before, companyID, companyUpdate); ok {
+		c.JSON(http.StatusOK, after)
+	}
+}
+
+// saveCompany PUT va PATCH uchun umumiy: If-Match ni tekshiradi, yozadi va auditga qayd qiladi
+func (h *CompanyHandler) saveCompany(c *gin.Context, before models.Company, companyID uuid.UUID, companyUpdate models.UpdateCompany) (models.Company, bool) {
+	if err := checkIfMatch(c, before.Version); err != nil {
+		c.Error(err)
+		return models.Company{}, false
+	}
+	companyUpdate.Version = before.Version
+	companyUpdate.ID = companyID
+	if err := h.CompanyRepository.UpdateCompany(c.Request.Context(), companyUpdate); err != nil {
+		c.Error(err)
+		return models.Company{}, false
+	}
+
+	after, err := h.CompanyRepository.GetCompanyByID(c.Request.Context(), companyID.String())
+	if err != nil {
+		c.Error(err)
+		return models.Company{}, false
+	}
+	h.Audit.Record(c, audit.EntityCompany, companyID.String(), audit.ActionUpdate, before, after)
+	setETag(c, after.Version)
+	return after, true
 }
-// http://localhost:8080/companies/dd8134d4-ba10-4064-b7da-fa9fc0da7347
-// {"name": "Udevs",
-// "location": "Tashkent"}
+// DeleteCompany kompaniyani uning vakansiyalari, rekruiterlari, ularning intervyulari va
+// webhooklari bilan birga bitta tranzaksiyada o'chiradi
 func (h *CompanyHandler) DeleteCompany(c *gin.Context) {
 	id := c.Param("id")
 
-	if err := h.CompanyRepository.DeleteCompany(id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	before, err := h.CompanyRepository.GetCompanyByID(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := checkIfMatch(c, before.Version); err != nil {
+		c.Error(err)
+		return
+	}
+
+	deleted, err := h.Service.Delete(c.Request.Context(), before.ID)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Company deleted successfully"})
+	for _, vacancy := range deleted.Vacancies {
+		h.Audit.Record(c, audit.EntityVacancy, vacancy.ID.String(), audit.ActionDelete, vacancy, nil)
+	}
+	for _, recruiter := range deleted.Recruiters {
+		h.Audit.Record(c, audit.EntityRecruiter, recruiter.ID.String(), audit.ActionDelete, recruiter, nil)
+	}
+	h.Audit.Record(c, audit.EntityCompany, id, audit.ActionDelete, before, nil)
+
+	c.JSON(http.StatusOK, middleware.Message(c, "company_deleted"))
 }
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/eligibility"
+	"hrplatform/service"
+	"hrplatform/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type EligibilityHandler struct {
+	Service *service.EligibilityService
+	Audit   *audit.Recorder
+}
+
+// EligibilityCheckRequest - POST /vacancies/:id/eligibility-check tanasi
+type EligibilityCheckRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
+}
+
+// CheckEligibility nomzodni vakansiya qoidalari bo'yicha sinab ko'radi (dry-run): intervyu
+// yaratilmaydi, nomzod mos kelmasa ham javob 200, sabablari esa so'rov tilida qaytariladi
+func (h *EligibilityHandler) CheckEligibility(c *gin.Context) {
+	vacancyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid vacancy ID"))
+		return
+	}
+
+	var request EligibilityCheckRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	result, err := h.Service.Check(c.Request.Context(), vacancyID, request.UserID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"vacancy_id": vacancyID,
+		"user_id":    request.UserID,
+		"eligible":   result.Eligible,
+		"rules":      result.Rules,
+		"reasons":    middleware.LocalizeFields(c, result.Reasons),
+	})
+}
+
+// http://localhost:8080/api/v1/vacancies/0b1a5a3e-7c55-4f2a-9d67-3f1a0e4c2b11/eligibility-check
+// {
+//     "user_id": "ac7b5e32-9f18-445c-81cf-a2422457964c"
+// }
+// {
+//     "vacancy_id": "0b1a5a3e-7c55-4f2a-9d67-3f1a0e4c2b11",
+//     "user_id": "ac7b5e32-9f18-445c-81cf-a2422457964c",
+//     "eligible": false,
+//     "rules": [
+//         {"type": "min_age", "params": {"value": 16}},
+//         {"type": "position_match", "params": {"mode": "ignore_case"}},
+//         {"type": "required_languages", "params": {"languages": ["en"]}}
+//     ],
+//     "reasons": [
+//         {"field": "user_id", "message": "Nomzod quyidagi tillarni bilishi kerak: en", "rule": "required_languages", "param": "en"}
+//     ]
+// }
+
+func (h *EligibilityHandler) GetCompanyRules(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid company ID"))
+		return
+	}
+
+	rules, err := h.Service.CompanyRules(c.Request.Context(), companyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"company_id": companyID, "rules": rules})
+}
+
+// ReplaceCompanyRules kompaniyaning barcha vakansiyalariga amal qiladigan qoidalarni almashtiradi
+func (h *EligibilityHandler) ReplaceCompanyRules(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid company ID"))
+		return
+	}
+
+	var ruleSet eligibility.RuleSet
+	if err := c.ShouldBindJSON(&ruleSet); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	before, err := h.Service.CompanyRules(c.Request.Context(), companyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	if err := h.Service.ReplaceCompanyRules(c.Request.Context(), companyID, ruleSet.Rules); err != nil {
+		c.Error(err)
+		return
+	}
+	after, err := h.Service.CompanyRules(c.Request.Context(), companyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.Audit.Record(c, audit.EntityEligibilityRules, companyID.String(), audit.ActionUpdate, gin.H{"rules": before}, gin.H{"rules": after})
+
+	c.JSON(http.StatusOK, gin.H{"company_id": companyID, "rules": after})
+}
+
+// http://localhost:8080/api/v1/companies/ad277609-f698-489a-a744-ec3cb9e812ce/eligibility-rules
+// {
+//     "rules": [
+//         {"type": "min_age", "params": {"value": 16}},
+//         {"type": "position_match", "params": {"mode": "ignore_case"}}
+//     ]
+// }
+
+// GetVacancyRules vakansiyaning o'z qoidalarini va amaldagi (standart + kompaniya + vakansiya) qoidalarini qaytaradi
+func (h *EligibilityHandler) GetVacancyRules(c *gin.Context) {
+	vacancyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid vacancy ID"))
+		return
+	}
+
+	rules, effective, err := h.Service.VacancyRules(c.Request.Context(), vacancyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"vacancy_id": vacancyID, "rules": rules, "effective_rules": effective})
+}
+
+// ReplaceVacancyRules vakansiya qoidalarini almashtiradi, ular kompaniya qoidalaridan ustun turadi
+func (h *EligibilityHandler) ReplaceVacancyRules(c *gin.Context) {
+	vacancyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid vacancy ID"))
+		return
+	}
+
+	var ruleSet eligibility.RuleSet
+	if err := c.ShouldBindJSON(&ruleSet); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	before, _, err := h.Service.VacancyRules(c.Request.Context(), vacancyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	if err := h.Service.ReplaceVacancyRules(c.Request.Context(), vacancyID, ruleSet.Rules); err != nil {
+		c.Error(err)
+		return
+	}
+	after, effective, err := h.Service.VacancyRules(c.Request.Context(), vacancyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.Audit.Record(c, audit.EntityEligibilityRules, vacancyID.String(), audit.ActionUpdate, gin.H{"rules": before}, gin.H{"rules": after})
+
+	c.JSON(http.StatusOK, gin.H{"vacancy_id": vacancyID, "rules": after, "effective_rules": effective})
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	"hrplatform/apperrors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// etag yozuv versiyasidan kuchli ETag yasaydi: 3 -> "3"
+func etag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// setETag javobga yozuvning joriy versiyasini ETag sarlavhasi sifatida qo'yadi
+func setETag(c *gin.Context, version int64) {
+	c.Header("ETag", etag(version))
+}
+
+// checkIfMatch PUT/PATCH/DELETE so'rovidagi If-Match sarlavhasini yozuvning joriy
+// versiyasi bilan solishtiradi. Sarlavha bo'lmasa 428, mos kelmasa 412 qaytadi.
+// "*" istalgan mavjud versiyaga mos keladi.
+func checkIfMatch(c *gin.Context, version int64) error {
+	header := c.GetHeader("If-Match")
+	if header == "" {
+		return apperrors.PreconditionRequired("if_match_required", "If-Match header is required")
+	}
+
+	current := etag(version)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == current {
+			return nil
+		}
+	}
+	return apperrors.PreconditionFailed("version_mismatch", "The record was modified by another request")
+}
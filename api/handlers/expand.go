@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"net/http"
+
+	"hrplatform/expand"
+
+	"github.com/gin-gonic/gin"
+)
+
+// writeExpanded GET javobini ?expand= va ?fields= bo'yicha yozadi. Parametrlar
+// berilmasa value o'zgarishsiz yoziladi.
+func writeExpanded(c *gin.Context, expander *expand.Expander, entity string, value interface{}) {
+	query, err := expand.Parse(entity, c.Query("expand"), c.Query("fields"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	if query.IsZero() {
+		c.JSON(http.StatusOK, value)
+		return
+	}
+
+	result, err := expander.Apply(c.Request.Context(), entity, query, value)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/importer"
+	"hrplatform/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaxImportFileSize - yuklanadigan CSV/XLSX faylining eng katta hajmi
+const MaxImportFileSize = 10 << 20
+
+type ImportHandler struct {
+	Importer *importer.Importer
+}
+
+// ImportForm - POST /imports/* ning multipart/form-data tanasi. mapping - importer.Spec JSON i.
+type ImportForm struct {
+	File         *multipart.FileHeader `form:"file" json:"file" binding:"required"`
+	Mapping      string                `form:"mapping" json:"mapping"`
+	BatchSize    int                   `form:"batch_size" json:"batch_size" binding:"omitempty,min=1,max=1000"`
+	AllOrNothing bool                  `form:"all_or_nothing" json:"all_or_nothing"`
+}
+
+// Import entity turidagi yozuvlarni yuklangan fayldan yaratadigan handler, masalan
+// POST /imports/vacancies. Fayl o'qilgan bo'lsa qatorlardagi xatoliklardan qat'i
+// nazar 200 va hisobot qaytadi.
+func (h *ImportHandler) Import(entity string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// forma maydonlari uchun biroz joy qoldiriladi
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, MaxImportFileSize+64<<10)
+
+		var form ImportForm
+		if err := c.ShouldBind(&form); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				c.Error(fileTooLarge())
+				return
+			}
+			c.Error(validation.FromBindError(err))
+			return
+		}
+		if form.File.Size > MaxImportFileSize {
+			c.Error(fileTooLarge())
+			return
+		}
+
+		spec, err := importer.ParseSpec(form.Mapping)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		data, err := readFile(form.File)
+		if err != nil {
+			c.Error(apperrors.Validation("invalid_body", "Failed to read request body"))
+			return
+		}
+		table, err := importer.ReadTable(data, importer.DetectFormat(form.File.Filename, data))
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		locale := middleware.GetLocale(c)
+		report, err := h.Importer.Import(c.Request.Context(), entity, table, spec, importer.Options{
+			BatchSize:    form.BatchSize,
+			AllOrNothing: form.AllOrNothing,
+			Source:       audit.SourceOf(c),
+			Localize: func(appErr *apperrors.Error) (string, []apperrors.FieldError) {
+				return middleware.Localize(locale, appErr)
+			},
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, report)
+	}
+}
+
+// http://localhost:8080/api/v1/imports/users
+// curl -F file=@candidates.csv -F 'mapping={"columns":{"name":"Full name"},"defaults":{"gender":"f"}}' -F batch_size=200 ...
+// {
+//     "entity": "user",
+//     "total": 3,
+//     "created": 2,
+//     "failed": 1,
+//     "batches": 1,
+//     "rolled_back": false,
+//     "records": [{"row": 2, "id": "41cf99a7-16f9-4256-98fc-9bb495a455e8"}, {"row": 4, "id": "..."}],
+//     "errors": [{"row": 3, "code": "validation_failed", "message": "Request validation failed",
+//                 "details": [{"field": "email", "message": "Must be a valid email address", "rule": "email"}]}]
+// }
+
+func fileTooLarge() *apperrors.Error {
+	return apperrors.Validation("import_file_too_large", "The import file is too large").WithArg("Limit", "10 MB").WithField("file", "must be at most 10 MB")
+}
+
+func readFile(header *multipart.FileHeader) ([]byte, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return io.ReadAll(file)
+}
@@ -1,8 +1,17 @@
 package handlers
 
 import (
+	"context"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/expand"
 	"hrplatform/models"
+	"hrplatform/notification"
 	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/webhook"
+	"hrplatform/validation"
+	"log"
 	"net/http"
 	"strconv"
 	"github.com/gin-gonic/gin"
@@ -11,24 +20,48 @@ import (
 
 type InterviewHandler struct {
 	InterviewRepository postgres.InterviewRepository
+	VacancyRepository   postgres.VacancyRepository
+	Service             *service.InterviewService
+	Webhooks            *webhook.Dispatcher
+	Notifier            *notification.Notifier
+	Audit               *audit.Recorder
+	Expand              *expand.Expander // ?expand= va ?fields= uchun
+}
+
+// dispatchEvent eventni vakansiya egasi bo'lgan kompaniyaning webhooklariga yuboradi
+func (h *InterviewHandler) dispatchEvent(ctx context.Context, vacancyID uuid.UUID, event string, data interface{}) {
+	if h.Webhooks == nil || h.VacancyRepository == nil {
+		return
+	}
+	vacancy, err := h.VacancyRepository.GetVacancyByID(ctx, vacancyID)
+	if err != nil {
+		log.Printf("webhook: %s uchun vakansiyani olishda xatolik: %v", event, err)
+		return
+	}
+	h.Webhooks.Dispatch(ctx, vacancy.CompanyID, event, data)
 }
 
 func (h *InterviewHandler) CreateInterview(c *gin.Context) {
 	var interviewCreate models.CreateInterview
-	if err := c.BindJSON(&interviewCreate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&interviewCreate); err != nil {
+		c.Error(validation.FromBindError(err))
 		return
 	}
 
-	interview, err := h.InterviewRepository.CreateInterview(interviewCreate)
+	// Yosh va lavozim qoidalari service da tekshiriladi
+	interview, err := h.Service.Create(c.Request.Context(), interviewCreate)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
+	h.Audit.Record(c, audit.EntityInterview, interview.ID.String(), audit.ActionCreate, nil, interview)
+	h.dispatchEvent(c.Request.Context(), interview.VacancyID, webhook.EventInterviewScheduled, interview)
+	h.Notifier.NotifyInterview(c.Request.Context(), notification.KindInterviewScheduled, interview)
+
 	c.JSON(http.StatusCreated, interview)
 }
-// http://localhost:8080/interviews/
+// http://localhost:8080/api/v1/interviews/
 // {
 //     "user_id": "ac7b5e32-9f18-445c-81cf-a2422457964c",
 //     "vacancy_id": "17db725a-6627-4226-b564-90a75f3a0f11",
@@ -71,19 +104,20 @@ func (h *InterviewHandler) GetInterviewByID(c *gin.Context) {
 	id := c.Param("id")
 	interviewID, err := uuid.Parse(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interview ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid interview ID"))
 		return
 	}
 
-	interview, err := h.InterviewRepository.GetInterviewByID(interviewID)
+	interview, err := h.InterviewRepository.GetInterviewByID(c.Request.Context(), interviewID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Interview not found"})
+		c.Error(err)
 		return
 	}
+	setETag(c, interview.Version)
 
-	c.JSON(http.StatusOK, interview)
+	writeExpanded(c, h.Expand, audit.EntityInterview, interview)
 }
-// http://localhost:8080/interviews/30a81c19-b0c4-4d55-a2b9-a17a5af60ff2
+// http://localhost:8080/api/v1/interviews/30a81c19-b0c4-4d55-a2b9-a17a5af60ff2
 // {
 //     "id": "30a81c19-b0c4-4d55-a2b9-a17a5af60ff2",
 //     "user_id": "ac7b5e32-9f18-445c-81cf-a2422457964c",
@@ -99,19 +133,19 @@ func (h *InterviewHandler) GetInterviewsByUserID(c *gin.Context) {
 	userID := c.Param("user_id")
 	parsedUserID, err := uuid.Parse(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid user ID"))
 		return
 	}
 
-	interviews, err := h.InterviewRepository.GetInterviewsByUserID(parsedUserID)
+	interviews, err := h.InterviewRepository.GetInterviewsByUserID(c.Request.Context(), parsedUserID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "No interviews found for the specified user"})
+		c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, interviews)
 }
-// http://localhost:8080/interviews/user/ac7b5e32-9f18-445c-81cf-a2422457964c
+// http://localhost:8080/api/v1/interviews/user/ac7b5e32-9f18-445c-81cf-a2422457964c
 // {
 //     "user_id": "ac7b5e32-9f18-445c-81cf-a2422457964c",
 //     "vacancy_id": "17db725a-6627-4226-b564-90a75f3a0f11",
@@ -138,7 +172,7 @@ func (h *InterviewHandler) GetAllInterviews(c *gin.Context) {
 	if companyIDStr := c.Query("company_id"); companyIDStr != "" {
 		companyID, err := uuid.Parse(companyIDStr)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID format"})
+			c.Error(apperrors.Validation("invalid_id", "Invalid company ID"))
 			return
 		}
 		filter["company_id"] = companyID
@@ -151,45 +185,98 @@ func (h *InterviewHandler) GetAllInterviews(c *gin.Context) {
 	if experienceStr := c.Query("experience"); experienceStr != "" {
 		minExp, err := strconv.Atoi(experienceStr)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid experience value"})
+			c.Error(apperrors.Validation("invalid_query", "Invalid experience value").WithField("experience", "must be an integer"))
 			return
 		}
 		filter["experience"] = minExp
 	}
 
-	interviews, err := h.InterviewRepository.GetAllInterviews(filter)
+	interviews, err := h.InterviewRepository.GetAllInterviews(c.Request.Context(), filter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, interviews)
+	writeExpanded(c, h.Expand, audit.EntityInterview, interviews)
 }
-// http://localhost:8080/interviews/
+// http://localhost:8080/api/v1/interviews/
 
+// UpdateInterview (PUT) intervyuni to'liq almashtiradi
 func (h *InterviewHandler) UpdateInterview(c *gin.Context) {
-	id := c.Param("id")
-	interviewID, err := uuid.Parse(id)
+	interviewID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interview ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid interview ID"))
 		return
 	}
 
 	var interviewUpdate models.UpdateInterview
-	if err := c.BindJSON(&interviewUpdate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&interviewUpdate); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	before, err := h.InterviewRepository.GetInterviewByID(c.Request.Context(), interviewID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if _, ok := h.saveInterview(c, before, interviewID, interviewUpdate); ok {
+		c.JSON(http.StatusOK, gin.H{"status": "Interview updated successfully"})
+	}
+}
+
+// PatchInterview (PATCH) joriy intervyuga JSON Merge Patch qo'llaydi va yangilangan yozuvni qaytaradi
+func (h *InterviewHandler) PatchInterview(c *gin.Context) {
+	interviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid interview ID"))
+		return
+	}
+
+	before, err := h.InterviewRepository.GetInterviewByID(c.Request.Context(), interviewID)
+	if err != nil {
+		c.Error(err)
 		return
 	}
-	interviewUpdate.ID = interviewID
 
-	if err := h.InterviewRepository.UpdateInterview(interviewUpdate); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	interviewUpdate := before.ToUpdate()
+	if err := bindMergePatch(c, &interviewUpdate); err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"status": "Interview updated successfully"})
+	if after, ok := h.saveInterview(c, before, interviewID, interviewUpdate); ok {
+		c.JSON(http.StatusOK, after)
+	}
+}
+
+// saveInterview PUT va PATCH uchun umumiy: If-Match ni tekshiradi, yozadi, auditga qayd qiladi,
+// webhook va bildirishnomalarni yuboradi
+func (h *InterviewHandler) saveInterview(c *gin.Context, before models.Interview, interviewID uuid.UUID, interviewUpdate models.UpdateInterview) (models.Interview, bool) {
+	if err := checkIfMatch(c, before.Version); err != nil {
+		c.Error(err)
+		return models.Interview{}, false
+	}
+	interviewUpdate.Version = before.Version
+	interviewUpdate.ID = interviewID
+	if err := h.Service.Update(c.Request.Context(), before, interviewUpdate); err != nil {
+		c.Error(err)
+		return models.Interview{}, false
+	}
+
+	interview, err := h.InterviewRepository.GetInterviewByID(c.Request.Context(), interviewID)
+	if err != nil {
+		c.Error(err)
+		return models.Interview{}, false
+	}
+	h.Audit.Record(c, audit.EntityInterview, interviewID.String(), audit.ActionUpdate, before, interview)
+	h.dispatchEvent(c.Request.Context(), interview.VacancyID, webhook.EventApplicationStatusChanged, interview)
+	h.Notifier.NotifyInterview(c.Request.Context(), notification.KindInterviewRescheduled, interview)
+	setETag(c, interview.Version)
+	return interview, true
 }
-// http://localhost:8080/interviews/17db725a-6627-4226-b564-90a75f3a0f11
+// http://localhost:8080/api/v1/interviews/17db725a-6627-4226-b564-90a75f3a0f11
 // {
 //     "user_id": "ac7b5e32-9f18-445c-81cf-a2422457964c",
 //     "vacancy_id": "17db725a-6627-4226-b564-90a75f3a0f11",
@@ -201,15 +288,29 @@ func (h *InterviewHandler) DeleteInterview(c *gin.Context) {
 	id := c.Param("id")
 	interviewID, err := uuid.Parse(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interview ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid interview ID"))
 		return
 	}
 
-	if err := h.InterviewRepository.DeleteInterview(interviewID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	interview, err := h.InterviewRepository.GetInterviewByID(c.Request.Context(), interviewID)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
+	if err := checkIfMatch(c, interview.Version); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.InterviewRepository.DeleteInterview(c.Request.Context(), interviewID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.Audit.Record(c, audit.EntityInterview, id, audit.ActionDelete, interview, nil)
+	h.Notifier.NotifyInterview(c.Request.Context(), notification.KindInterviewCancelled, interview)
+
 	c.JSON(http.StatusOK, gin.H{"status": "Interview deleted successfully"})
 }
 
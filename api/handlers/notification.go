@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/models"
+	"hrplatform/notification"
+	"hrplatform/postgres"
+	"hrplatform/utils"
+	"hrplatform/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type NotificationHandler struct {
+	PreferenceRepository  postgres.NotificationPreferenceRepository
+	UserRepository        postgres.UserRepository
+	TelegramBotName       string // t.me/<bot>?start=<user_id> havolasi uchun
+	TelegramWebhookSecret string // X-Telegram-Bot-Api-Secret-Token bilan solishtiriladi
+	Audit                 *audit.Recorder
+}
+
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid user ID"))
+		return
+	}
+
+	if _, err := h.UserRepository.GetUserByID(c.Request.Context(), userID.String()); err != nil {
+		c.Error(err)
+		return
+	}
+
+	preference, err := h.PreferenceRepository.GetPreference(c.Request.Context(), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preference)
+}
+
+// http://localhost:8080/api/v1/users/41cf99a7-16f9-4256-98fc-9bb495a455e8/notification-preferences
+// {
+//     "user_id": "41cf99a7-16f9-4256-98fc-9bb495a455e8",
+//     "locale": "uz",
+//     "preferred_channel": "email",
+//     "email_enabled": true,
+//     "sms_enabled": false,
+//     "telegram_enabled": false,
+//     "telegram_chat_id": "",
+//     "muted_kinds": [],
+//     "updated_at": "0001-01-01T00:00:00Z"
+// }
+
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid user ID"))
+		return
+	}
+
+	var preferenceUpdate models.UpdateNotificationPreference
+	if err := c.ShouldBindJSON(&preferenceUpdate); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	if _, err := h.UserRepository.GetUserByID(c.Request.Context(), userID.String()); err != nil {
+		c.Error(err)
+		return
+	}
+
+	preference, err := h.PreferenceRepository.GetPreference(c.Request.Context(), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	before := preference
+
+	if preferenceUpdate.Locale != nil {
+		if !notification.IsSupportedLocale(*preferenceUpdate.Locale) {
+			c.Error(apperrors.Validation("unsupported_locale", "Unsupported locale").WithField("locale", "must be one of "+strings.Join(notification.Locales, ", ")))
+			return
+		}
+		preference.Locale = *preferenceUpdate.Locale
+	}
+	if preferenceUpdate.PreferredChannel != nil {
+		if !notification.IsValidChannel(*preferenceUpdate.PreferredChannel) {
+			c.Error(apperrors.Validation("unknown_channel", "Unknown channel").WithField("preferred_channel", "must be one of email, sms, telegram"))
+			return
+		}
+		preference.PreferredChannel = *preferenceUpdate.PreferredChannel
+	}
+	if preferenceUpdate.EmailEnabled != nil {
+		preference.EmailEnabled = *preferenceUpdate.EmailEnabled
+	}
+	if preferenceUpdate.MutedKinds != nil {
+		for _, kind := range preferenceUpdate.MutedKinds {
+			if !notification.IsValidKind(kind) {
+				c.Error(apperrors.Validation("unknown_notification_kind", "Unknown notification kind: "+kind).WithArg("Kind", kind).WithField("muted_kinds", "unknown kind "+kind))
+				return
+			}
+		}
+		preference.MutedKinds = preferenceUpdate.MutedKinds
+	}
+
+	preference, err = h.PreferenceRepository.UpsertPreference(c.Request.Context(), preference)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.Audit.Record(c, audit.EntityNotificationPreference, userID.String(), audit.ActionUpdate, before, preference)
+
+	c.JSON(http.StatusOK, preference)
+}
+
+// http://localhost:8080/api/v1/users/41cf99a7-16f9-4256-98fc-9bb495a455e8/notification-preferences
+// {
+//     "locale": "ru",
+//     "preferred_channel": "telegram",
+//     "muted_kinds": ["interview_reminder"]
+// }
+
+// OptIn foydalanuvchini sms yoki telegram kanaliga obuna qiladi.
+// Telegram uchun avval bot bilan chat bog'langan bo'lishi kerak.
+func (h *NotificationHandler) OptIn(c *gin.Context) {
+	h.setChannel(c, true)
+}
+
+// OptOut kanal orqali xabar yuborishni to'xtatadi
+func (h *NotificationHandler) OptOut(c *gin.Context) {
+	h.setChannel(c, false)
+}
+
+func (h *NotificationHandler) setChannel(c *gin.Context, enabled bool) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid user ID"))
+		return
+	}
+
+	var optIn models.ChannelOptIn
+	if err := c.ShouldBindJSON(&optIn); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+	if !notification.IsValidChannel(optIn.Channel) {
+		c.Error(apperrors.Validation("unknown_channel", "Unknown channel").WithField("channel", "must be one of email, sms, telegram"))
+		return
+	}
+
+	user, err := h.UserRepository.GetUserByID(c.Request.Context(), userID.String())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	preference, err := h.PreferenceRepository.GetPreference(c.Request.Context(), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	before := preference
+
+	switch notification.Channel(optIn.Channel) {
+	case notification.ChannelEmail:
+		preference.EmailEnabled = enabled
+	case notification.ChannelSMS:
+		if _, ok := utils.NormalizeUzPhone(user.PhoneNumber); enabled && !ok {
+			c.Error(apperrors.Validation("invalid_phone", "User phone number is not a valid Uzbek number").WithField("phone_number", "expected +998XXXXXXXXX"))
+			return
+		}
+		preference.SMSEnabled = enabled
+	case notification.ChannelTelegram:
+		if enabled && preference.TelegramChatID == "" {
+			linkURL := "https://t.me/" + h.TelegramBotName + "?start=" + userID.String()
+			c.Error(apperrors.Conflict("telegram_not_linked", "Telegram chat is not linked yet, open "+linkURL+" first").WithArg("LinkURL", linkURL))
+			return
+		}
+		preference.TelegramEnabled = enabled
+	}
+
+	preference, err = h.PreferenceRepository.UpsertPreference(c.Request.Context(), preference)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.Audit.Record(c, audit.EntityNotificationPreference, userID.String(), audit.ActionUpdate, before, preference)
+
+	c.JSON(http.StatusOK, preference)
+}
+
+// http://localhost:8080/api/v1/users/41cf99a7-16f9-4256-98fc-9bb495a455e8/notification-preferences/opt-in
+// {
+//     "channel": "sms"
+// }
+
+// TelegramWebhook bot updatelarini qabul qiladi:
+// "/start <user_id>" chatni foydalanuvchiga bog'laydi va telegram kanalini yoqadi,
+// "/stop" esa shu chat uchun telegram xabarlarini o'chiradi.
+func (h *NotificationHandler) TelegramWebhook(c *gin.Context) {
+	if h.TelegramWebhookSecret != "" && c.GetHeader("X-Telegram-Bot-Api-Secret-Token") != h.TelegramWebhookSecret {
+		c.Error(apperrors.Forbidden("invalid_secret_token", "Invalid secret token"))
+		return
+	}
+
+	var update notification.TelegramUpdate
+	if err := c.ShouldBindJSON(&update); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+	if update.Message == nil {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+		return
+	}
+
+	chatID := strconv.FormatInt(update.Message.Chat.ID, 10)
+	fields := strings.Fields(update.Message.Text)
+	if len(fields) == 0 {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+		return
+	}
+
+	var preference models.NotificationPreference
+	switch fields[0] {
+	case "/start":
+		if len(fields) < 2 {
+			break
+		}
+		userID, err := uuid.Parse(fields[1])
+		if err != nil {
+			break
+		}
+		if _, err := h.UserRepository.GetUserByID(c.Request.Context(), userID.String()); err != nil {
+			break
+		}
+		preference, err = h.PreferenceRepository.GetPreference(c.Request.Context(), userID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		preference.TelegramChatID = chatID
+		preference.TelegramEnabled = true
+	case "/stop":
+		var err error
+		preference, err = h.PreferenceRepository.GetPreferenceByTelegramChatID(c.Request.Context(), chatID)
+		if err != nil {
+			break
+		}
+		preference.TelegramEnabled = false
+	}
+
+	if preference.UserID != uuid.Nil {
+		if _, err := h.PreferenceRepository.UpsertPreference(c.Request.Context(), preference); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	// Telegram har doim 200 kutadi, aks holda updateni qayta yuboradi
+	c.JSON(http.StatusOK, gin.H{"ok": true})
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+
+	"hrplatform/apperrors"
+	"hrplatform/mergepatch"
+	"hrplatform/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// bindMergePatch so'rov tanasidagi JSON Merge Patch (RFC 7396) ni dst ning joriy
+// qiymati ustiga qo'llaydi va natijani PUT bilan bir xil `binding` qoidalari bo'yicha
+// tekshiradi. Patchda yo'q maydonlar o'zgarmaydi, null berilgan maydon nol qiymatga
+// tushadi (majburiy maydonlar uchun bu validatsiya xatoligi). Content-Type sifatida
+// application/merge-patch+json ham, application/json ham qabul qilinadi.
+func bindMergePatch(c *gin.Context, dst interface{}) error {
+	current, err := json.Marshal(dst)
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return apperrors.InvalidJSON(err)
+	}
+
+	merged, err := mergepatch.Apply(current, patch)
+	if errors.Is(err, mergepatch.ErrInvalidPatch) {
+		return apperrors.InvalidJSON(err)
+	}
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	// o'chirilgan kalitlar nol qiymatga tushishi uchun dst avval tozalanadi
+	value := reflect.ValueOf(dst).Elem()
+	value.Set(reflect.Zero(value.Type()))
+	if err := json.Unmarshal(merged, dst); err != nil {
+		return apperrors.InvalidJSON(err)
+	}
+
+	if err := binding.Validator.ValidateStruct(dst); err != nil {
+		return validation.FromBindError(err)
+	}
+	return nil
+}
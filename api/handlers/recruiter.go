@@ -2,15 +2,18 @@ package handlers
 
 import ( 
     // Kerakli kutubxonalarni import qilish
-    "fmt"
-    "log"
     "net/http"
     "strconv"
-    "strings"
     "time"
 
+    "hrplatform/api/middleware" // Til va javob yordamchilari
+    "hrplatform/apperrors"   // Domen xatoliklari
+    "hrplatform/audit"       // O'zgarishlarni audit jurnaliga yozish
+    "hrplatform/expand"      // ?expand= va ?fields= javoblari
     "hrplatform/models"      // Loyiha ichidagi modellar
     "hrplatform/postgres"   // Ma'lumotlar bazasi bilan ishlash uchun obyektlar
+    "hrplatform/service"    // Biznes qoidalari
+    "hrplatform/validation" // So'rov DTOlarini tekshirish
 
     "github.com/gin-gonic/gin"   // HTTP so'rovlarni ishlash uchun framework
     "github.com/google/uuid"     // Yagona IDlar generatsiya qilish uchun kutubxona
@@ -18,23 +21,27 @@ import (
 
 type RecruiterHandler struct {
     RecruiterRepository postgres.RecruiterRepository // Ma'lumotlar bazasi bilan ishlash uchun repository
+    Service             *service.RecruiterService    // Kompaniya tekshiruvi va kaskadli o'chirish
+    Audit               *audit.Recorder              // Audit jurnali
+    Expand              *expand.Expander             // ?expand= va ?fields= uchun
 }
 
 func (h *RecruiterHandler) GetRecruiterByID(c *gin.Context) {
     id := c.Param("id") // URLdan IDni olish
 
     // Repository orqali yollanma xodimni topish
-    recruiter, err := h.RecruiterRepository.GetRecruiterByID(id)
+    recruiter, err := h.RecruiterRepository.GetRecruiterByID(c.Request.Context(), id)
     if err != nil {
         // Agar topilmasa, xatolik qaytarish
-        c.JSON(http.StatusNotFound, gin.H{"error": "Yollanma xodim topilmadi"})
+        c.Error(err)
         return
     }
 
     // Topilgan yollanma xodimni JSON formatida qaytarish
-    c.JSON(http.StatusOK, recruiter)
+    setETag(c, recruiter.Version)
+    writeExpanded(c, h.Expand, audit.EntityRecruiter, recruiter)
 }
-// http://localhost:8080/recruiters
+// http://localhost:8080/api/v1/recruiters
 // {
 //     "name": "Husan MUsa",
 //     "email": "Husan99@example.com",
@@ -63,65 +70,43 @@ func (h *RecruiterHandler) GetAllRecruiters(c *gin.Context) {
     companyID := c.DefaultQuery("company_id", "")
 
     // Repository orqali filtrlangan yollanma xodimlarni olish
-    recruiters, err := h.RecruiterRepository.GetAllRecruiters(age, gender, companyID)
+    recruiters, err := h.RecruiterRepository.GetAllRecruiters(c.Request.Context(), age, gender, companyID)
     if err != nil {
         // Agar xatolik bo'lsa, xato qaytarish
-        c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+        c.Error(err)
         return
     }
 
     // Topilgan yollanma xodimlarni JSON formatida qaytarish
-    c.JSON(http.StatusOK, recruiters)
+    writeExpanded(c, h.Expand, audit.EntityRecruiter, recruiters)
 }
 func (h *RecruiterHandler) CreateRecruiter(c *gin.Context) {
     var recruiterCreate models.CreateRecruiter // Yangi yollanma xodim ma'lumotlari uchun model
 
     // JSON so'rovdan ma'lumotlarni o'qish va modelga yuklash
-    if err := c.BindJSON(&recruiterCreate); err != nil {
-        c.JSON(http.StatusBadRequest, gin.H{"error": "Noto'g'ri JSON ma'lumot: " + err.Error()})
+    if err := c.ShouldBindJSON(&recruiterCreate); err != nil {
+        c.Error(validation.FromBindError(err))
         return
     }
 
-    // Majburiy maydonlarni tekshirish
-    if recruiterCreate.Name == "" || recruiterCreate.Email == "" || recruiterCreate.PhoneNumber == "" || recruiterCreate.Gender == "" {
-        c.JSON(http.StatusBadRequest, gin.H{"error": "Majburiy maydonlar to'ldirilmagan"})
-        return
-    }
-
-    // Tug'ilgan sanani tekshirish va to'g'ri formatlash
-    birthday, err := time.Parse("2006-01-02", recruiterCreate.Birthday)
-    if err != nil {
-        c.JSON(http.StatusBadRequest, gin.H{"error": "Noto'g'ri sana formati. YYYY-MM-DD shaklida kiriting"})
-        return
-    }
+    // Tug'ilgan sanani formatlash (format `binding` tegida tekshirilgan)
+    birthday, _ := time.Parse(validation.DateLayout, recruiterCreate.Birthday)
     recruiterCreate.Birthday = birthday.Format(time.RFC3339)
 
-    // Kompaniya mavjudligini tekshirish
-    exists, err := h.RecruiterRepository.CheckCompanyExists(recruiterCreate.CompanyID)
-    if err != nil || !exists {
-        c.JSON(http.StatusBadRequest, gin.H{"error": "Berilgan IDga ega kompaniya mavjud emas"})
-        return
-    }
-
-    // Repository orqali yangi yollanma xodimni yaratish
-    recruiter, err := h.RecruiterRepository.CreateRecruiter(recruiterCreate)
+    // Service kompaniya mavjudligini tekshiradi va yangi yollanma xodimni yaratadi
+    recruiter, err := h.Service.Create(c.Request.Context(), recruiterCreate)
     if err != nil {
-        // Xatoliklarni tekshirish va tegishli xabarlarni qaytarish
-        if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-            c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("'%s' elektron pochtali yollanma xodim allaqachon mavjud", recruiterCreate.Email)})
-        } else if strings.Contains(err.Error(), "violates foreign key constraint") {
-            c.JSON(http.StatusBadRequest, gin.H{"error": "Berilgan IDga ega kompaniya mavjud emas"})
-        } else {
-            c.JSON(http.StatusInternalServerError, gin.H{"error": "Yollanma xodimni yaratishda xatolik"})
-            log.Println(err)
-        }
+        // Email yoki telefon band bo'lsa repository 409 qaytaradi
+        c.Error(err)
         return
     }
 
+    h.Audit.Record(c, audit.EntityRecruiter, recruiter.ID.String(), audit.ActionCreate, nil, recruiter)
+
     // Yaratilgan yollanma xodimni JSON formatida qaytarish
     c.JSON(http.StatusCreated, recruiter)
 }
-// http://localhost:8080/recruiters
+// http://localhost:8080/api/v1/recruiters
 // {
 //     "name": "Husan MUsayev",
 //     "email": "Husan99@example.com",
@@ -143,49 +128,94 @@ func (h *RecruiterHandler) CreateRecruiter(c *gin.Context) {
 //     "deleted_at": 0
 // }
 
+// UpdateRecruiter (PUT) yollanma xodimni to'liq almashtiradi
 func (h *RecruiterHandler) UpdateRecruiter(c *gin.Context) {
     id := c.Param("id") // URLdan IDni olish
 
+    recruiterID, err := uuid.Parse(id) // IDni UUID formatiga o'tkazish
+    if err != nil {
+        c.Error(apperrors.Validation("invalid_id", "Invalid recruiter ID"))
+        return
+    }
+
     var recruiterUpdate models.UpdateRecruiter // Yangilanish uchun ma'lumotlar modeli
 
     // JSON so'rovdan ma'lumotlarni o'qish
-    if err := c.BindJSON(&recruiterUpdate); err != nil {
-        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+    if err := c.ShouldBindJSON(&recruiterUpdate); err != nil {
+        c.Error(validation.FromBindError(err))
         return
     }
 
-    recruiterUpdate.ID = uuid.MustParse(id) // IDni UUID formatiga o'tkazish
+    // O'zgartirishdan oldingi holat (audit uchun)
+    before, err := h.RecruiterRepository.GetRecruiterByID(c.Request.Context(), id)
+    if err != nil {
+        c.Error(err)
+        return
+    }
 
-    // Tug'ilgan sanani tekshirish va formatlash (agar o'zgartirilgan bo'lsa)
-    if recruiterUpdate.Birthday != nil {
-        birthday, err := time.Parse("2006-01-02", *recruiterUpdate.Birthday)
-        if err != nil {
-            c.JSON(http.StatusBadRequest, gin.H{"error": "Noto'g'ri sana formati"})
-            return
-        }
-        formattedBirthday := birthday.Format(time.RFC3339)
-        recruiterUpdate.Birthday = &formattedBirthday
+    // Muvaffaqiyatli yangilanganligi haqida xabar qaytarish
+    if _, ok := h.saveRecruiter(c, before, recruiterID, recruiterUpdate); ok {
+        c.JSON(http.StatusOK, middleware.Message(c, "recruiter_updated"))
     }
+}
+
+// PatchRecruiter (PATCH) joriy yollanma xodimga JSON Merge Patch qo'llaydi va yangilangan yozuvni qaytaradi
+func (h *RecruiterHandler) PatchRecruiter(c *gin.Context) {
+    id := c.Param("id")
 
-    // Kompaniya IDsi o'zgartirilgan bo'lsa, uning mavjudligini tekshirish
-    if recruiterUpdate.CompanyID != nil {
-        exists, err := h.RecruiterRepository.CheckCompanyExists(*recruiterUpdate.CompanyID)
-        if err != nil || !exists {
-            c.JSON(http.StatusBadRequest, gin.H{"error": "Berilgan IDga ega kompaniya mavjud emas"})
-            return
-        }
+    recruiterID, err := uuid.Parse(id)
+    if err != nil {
+        c.Error(apperrors.Validation("invalid_id", "Invalid recruiter ID"))
+        return
     }
 
-    // Repository orqali yollanma xodimni yangilash
-    if err := h.RecruiterRepository.UpdateRecruiter(recruiterUpdate); err != nil {
-        c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+    before, err := h.RecruiterRepository.GetRecruiterByID(c.Request.Context(), id)
+    if err != nil {
+        c.Error(err)
         return
     }
 
-    // Muvaffaqiyatli yangilanganligi haqida xabar qaytarish
-    c.JSON(http.StatusOK, gin.H{"message": "Yollanma xodim muvaffaqiyatli yangilandi"})
+    // Joriy holat ustiga patchni qo'llash
+    recruiterUpdate := before.ToUpdate()
+    if err := bindMergePatch(c, &recruiterUpdate); err != nil {
+        c.Error(err)
+        return
+    }
+
+    if after, ok := h.saveRecruiter(c, before, recruiterID, recruiterUpdate); ok {
+        c.JSON(http.StatusOK, after)
+    }
+}
+
+// saveRecruiter PUT va PATCH uchun umumiy: If-Match ni tekshiradi, service orqali yozadi va auditga qayd qiladi
+func (h *RecruiterHandler) saveRecruiter(c *gin.Context, before models.Recruiter, recruiterID uuid.UUID, recruiterUpdate models.UpdateRecruiter) (models.Recruiter, bool) {
+    if err := checkIfMatch(c, before.Version); err != nil {
+        c.Error(err)
+        return models.Recruiter{}, false
+    }
+    recruiterUpdate.Version = before.Version
+    recruiterUpdate.ID = recruiterID
+
+    // Tug'ilgan sanani formatlash (format `binding` tegida tekshirilgan)
+    birthday, _ := time.Parse(validation.DateLayout, recruiterUpdate.Birthday)
+    recruiterUpdate.Birthday = birthday.Format(time.RFC3339)
+
+    // Kompaniya IDsi o'zgartirilgan bo'lsa service uning mavjudligini tekshiradi
+    if err := h.Service.Update(c.Request.Context(), before, recruiterUpdate); err != nil {
+        c.Error(err)
+        return models.Recruiter{}, false
+    }
+
+    after, err := h.RecruiterRepository.GetRecruiterByID(c.Request.Context(), recruiterID.String())
+    if err != nil {
+        c.Error(err)
+        return models.Recruiter{}, false
+    }
+    h.Audit.Record(c, audit.EntityRecruiter, recruiterID.String(), audit.ActionUpdate, before, after)
+    setETag(c, after.Version)
+    return after, true
 }
-// http://localhost:8080/recruiters/d83f27ea-c5fe-485b-b9f9-58e07be915ac
+// PATCH http://localhost:8080/api/v1/recruiters/d83f27ea-c5fe-485b-b9f9-58e07be915ac
 // {
 //     "name": "Husan MUsayev",
 //     "email": "Husan99@example.com"
@@ -197,13 +227,26 @@ func (h *RecruiterHandler) UpdateRecruiter(c *gin.Context) {
 func (h *RecruiterHandler) DeleteRecruiter(c *gin.Context) {
     id := c.Param("id") // URLdan IDni olish
 
-    // Repository orqali yollanma xodimni o'chirish
-    if err := h.RecruiterRepository.DeleteRecruiter(id); err != nil {
-        c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+    before, err := h.RecruiterRepository.GetRecruiterByID(c.Request.Context(), id)
+    if err != nil {
+        c.Error(err)
         return
     }
 
+    if err := checkIfMatch(c, before.Version); err != nil {
+        c.Error(err)
+        return
+    }
+
+    // Yollanma xodimni uning intervyulari bilan birga o'chirish
+    if err := h.Service.Delete(c.Request.Context(), before.ID); err != nil {
+        c.Error(err)
+        return
+    }
+
+    h.Audit.Record(c, audit.EntityRecruiter, id, audit.ActionDelete, before, nil)
+
     // Muvaffaqiyatli o'chirilganligi haqida xabar qaytarish
-    c.JSON(http.StatusOK, gin.H{"message": "Recruiter deleted successfully"})
+    c.JSON(http.StatusOK, middleware.Message(c, "recruiter_deleted"))
 }
 
@@ -1,8 +1,13 @@
 package handlers
 
 import (
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/expand"
 	"hrplatform/models"
 	"hrplatform/postgres"
+	"hrplatform/validation"
 	"log"
 	"net/http"
 	"strconv"
@@ -13,25 +18,29 @@ import (
 
 type ResumeHandler struct {
 	ResumeRepository postgres.ResumeRepository
+	Audit            *audit.Recorder
+	Expand           *expand.Expander // ?expand= va ?fields= uchun
 }
 
 func (h *ResumeHandler) CreateResume(c *gin.Context) {
 	var resumeCreate models.CreateResume
-	if err := c.BindJSON(&resumeCreate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&resumeCreate); err != nil {
+		c.Error(validation.FromBindError(err))
 		return
 	}
 
-	resume, err := h.ResumeRepository.CreateResume(resumeCreate)
+	resume, err := h.ResumeRepository.CreateResume(c.Request.Context(), resumeCreate)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
+	h.Audit.Record(c, audit.EntityResume, resume.ID.String(), audit.ActionCreate, nil, resume)
+
 	c.JSON(http.StatusCreated, resume)
 }
 
-// http://localhost:8080/resumes
+// http://localhost:8080/api/v1/resumes
 // {
 // 	"position": "Software Engineer",
 // 	"experience": 5,
@@ -53,20 +62,21 @@ func (h *ResumeHandler) GetResumeByID(c *gin.Context) {
 	id := c.Param("id")
 	resumeID, err := uuid.Parse(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid resume ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid resume ID"))
 		return
 	}
 
-	resume, err := h.ResumeRepository.GetResumeByID(resumeID)
+	resume, err := h.ResumeRepository.GetResumeByID(c.Request.Context(), resumeID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Resume not found"})
+		c.Error(err)
 		return
 	}
+	setETag(c, resume.Version)
 
-	c.JSON(http.StatusOK, resume)
+	writeExpanded(c, h.Expand, audit.EntityResume, resume)
 }
 
-// http://localhost:8080/resumes/12b00aef-1db6-4779-96ff-9ec9db55c1b1
+// http://localhost:8080/api/v1/resumes/12b00aef-1db6-4779-96ff-9ec9db55c1b1
 // {
 //     "id": "12b00aef-1db6-4779-96ff-9ec9db55c1b1",
 //     "position": "Software Engineer",
@@ -82,20 +92,20 @@ func (h *ResumeHandler) GetResumesByUserID(c *gin.Context) {
 	userID := c.Param("user_id")
 	parsedUserID, err := uuid.Parse(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid user ID"))
 		return
 	}
 
-	resumes, err := h.ResumeRepository.GetResumesByUserID(parsedUserID)
+	resumes, err := h.ResumeRepository.GetResumesByUserID(c.Request.Context(), parsedUserID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "No resumes found for the specified user"})
+		c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, resumes)
 }
 
-// http://localhost:8080/resumes/6c3fd2cc-a684-49a1-8de8-5761438a7655
+// http://localhost:8080/api/v1/resumes/6c3fd2cc-a684-49a1-8de8-5761438a7655
 // [
 //
 //	{
@@ -123,24 +133,24 @@ func (h *ResumeHandler) GetAllResumes(c *gin.Context) {
 	if minExpStr := c.Query("min_exp"); minExpStr != "" {
 		minExp, err := strconv.Atoi(minExpStr)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid min_exp value"})
+			c.Error(apperrors.Validation("invalid_query", "Invalid min_exp value").WithField("min_exp", "must be an integer"))
 			return
 		}
 		filter["min_exp"] = minExp
 	}
 
 	// Fetch resumes using the repository method
-	resumes, err := h.ResumeRepository.GetAllResumes(filter)
+	resumes, err := h.ResumeRepository.GetAllResumes(c.Request.Context(), filter)
 	if err != nil {
 		log.Printf("Error fetching resumes: %v\n", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch resumes"})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, resumes)
+	writeExpanded(c, h.Expand, audit.EntityResume, resumes)
 }
 
-// http://localhost:8080/resumes
+// http://localhost:8080/api/v1/resumes
 
 // [
 //     {
@@ -181,7 +191,7 @@ func (h *ResumeHandler) GetAllResumes(c *gin.Context) {
 //     }
 // ]
 
-// http://localhost:8080/resumes?position=Software&min_exp=3[
+// http://localhost:8080/api/v1/resumes?position=Software&min_exp=3[
 	// [
 	// 	{
 	// 		"id": "12b00aef-1db6-4779-96ff-9ec9db55c1b1",
@@ -196,30 +206,84 @@ func (h *ResumeHandler) GetAllResumes(c *gin.Context) {
 	// 		"deleted_at": 0
 	// 	}
 	// ]
+// UpdateResume (PUT) rezyumeni to'liq almashtiradi
 func (h *ResumeHandler) UpdateResume(c *gin.Context) {
-	id := c.Param("id")
+	resumeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid resume ID"))
+		return
+	}
 
 	var resumeUpdate models.UpdateResume
-	if err := c.BindJSON(&resumeUpdate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&resumeUpdate); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	before, err := h.ResumeRepository.GetResumeByID(c.Request.Context(), resumeID)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
-	resumeUpdate.ID = id
+	if _, ok := h.saveResume(c, before, resumeID, resumeUpdate); ok {
+		c.JSON(http.StatusOK, middleware.Message(c, "resume_updated"))
+	}
+}
+
+// PatchResume (PATCH) joriy rezyumega JSON Merge Patch qo'llaydi va yangilangan yozuvni qaytaradi
+func (h *ResumeHandler) PatchResume(c *gin.Context) {
+	resumeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid resume ID"))
+		return
+	}
+
+	before, err := h.ResumeRepository.GetResumeByID(c.Request.Context(), resumeID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
-	if err := h.ResumeRepository.UpdateResume(resumeUpdate); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	resumeUpdate := before.ToUpdate()
+	if err := bindMergePatch(c, &resumeUpdate); err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Resume updated successfully"})
+	if after, ok := h.saveResume(c, before, resumeID, resumeUpdate); ok {
+		c.JSON(http.StatusOK, after)
+	}
+}
+
+// saveResume PUT va PATCH uchun umumiy: If-Match ni tekshiradi, yozadi va auditga qayd qiladi
+func (h *ResumeHandler) saveResume(c *gin.Context, before models.Resume, resumeID uuid.UUID, resumeUpdate models.UpdateResume) (models.Resume, bool) {
+	if err := checkIfMatch(c, before.Version); err != nil {
+		c.Error(err)
+		return models.Resume{}, false
+	}
+	resumeUpdate.Version = before.Version
+	resumeUpdate.ID = resumeID.String()
+	if err := h.ResumeRepository.UpdateResume(c.Request.Context(), resumeUpdate); err != nil {
+		c.Error(err)
+		return models.Resume{}, false
+	}
+
+	after, err := h.ResumeRepository.GetResumeByID(c.Request.Context(), resumeID)
+	if err != nil {
+		c.Error(err)
+		return models.Resume{}, false
+	}
+	h.Audit.Record(c, audit.EntityResume, resumeID.String(), audit.ActionUpdate, before, after)
+	setETag(c, after.Version)
+	return after, true
 }
-// http://localhost:8080/resumes/12b00aef-1db6-4779-96ff-9ec9db55c1b1
+// http://localhost:8080/api/v1/resumes/12b00aef-1db6-4779-96ff-9ec9db55c1b1
 // {
 //     "message": "Resume updated successfully"
 // }
 
-// http://localhost:8080/resumes/6c3fd2cc-a684-49a1-8de8-5761438a7655
+// http://localhost:8080/api/v1/resumes/6c3fd2cc-a684-49a1-8de8-5761438a7655
 // {
 //     "position": "Software Engineer",
 //     "experience": 2,
@@ -232,11 +296,29 @@ func (h *ResumeHandler) UpdateResume(c *gin.Context) {
 
 func (h *ResumeHandler) DeleteResume(c *gin.Context) {
 	id := c.Param("id")
+	resumeID, err := uuid.Parse(id)
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid resume ID"))
+		return
+	}
+
+	before, err := h.ResumeRepository.GetResumeByID(c.Request.Context(), resumeID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
-	if err := h.ResumeRepository.DeleteResume(id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := checkIfMatch(c, before.Version); err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Resume deleted successfully"})
+	if err := h.ResumeRepository.DeleteResume(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.Audit.Record(c, audit.EntityResume, id, audit.ActionDelete, before, nil)
+
+	c.JSON(http.StatusOK, middleware.Message(c, "resume_deleted"))
 }
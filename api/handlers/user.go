@@ -3,10 +3,14 @@ package handlers
 import (
 	"net/http"
 	"strconv"
-	"time"
 
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/expand"
 	"hrplatform/models"
 	"hrplatform/postgres"
+	"hrplatform/validation"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -14,33 +18,29 @@ import (
 
 type UserHandler struct {
 	UserRepository postgres.UserRepository 
+	Audit          *audit.Recorder
+	Expand         *expand.Expander // ?expand= va ?fields= uchun
 }
 
 func (h *UserHandler) CreateUser(c *gin.Context) {
 	var userCreate models.UserCreate
-	if err := c.BindJSON(&userCreate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&userCreate); err != nil {
+		c.Error(validation.FromBindError(err))
 		return
 	}
 
-	birthday, err := time.Parse("2006-01-02", userCreate.Birthday)
+	user, err := h.UserRepository.CreateUser(c.Request.Context(), userCreate)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format"})
+		c.Error(err)
 		return
 	}
 
-	userCreate.Birthday = birthday.Format("2006-01-02")
-
-	user, err := h.UserRepository.CreateUser(userCreate)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
+	h.Audit.Record(c, audit.EntityUser, user.ID.String(), audit.ActionCreate, nil, user)
 
 	c.JSON(http.StatusCreated, user)
 }
 
-// http://localhost:8080/users/
+// http://localhost:8080/api/v1/users/
 // {
 //     "name": "Test10",
 //     "email": "test@example.com",
@@ -52,15 +52,16 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 func (h *UserHandler) GetUserByID(c *gin.Context) {
 	id := c.Param("id")
 
-	user, err := h.UserRepository.GetUserByID(id)
+	user, err := h.UserRepository.GetUserByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, user)
+	setETag(c, user.Version)
+	writeExpanded(c, h.Expand, audit.EntityUser, user)
 }
 
-// http://localhost:8080/users/41cf99a7-16f9-4256-98fc-9bb495a455e8
+// http://localhost:8080/api/v1/users/41cf99a7-16f9-4256-98fc-9bb495a455e8
 
 func (h *UserHandler) GetAllUsers(c *gin.Context) {
 	filters := make(map[string]interface{})
@@ -76,46 +77,94 @@ func (h *UserHandler) GetAllUsers(c *gin.Context) {
 		filters["gender"] = gender
 	}
 
-	users, err := h.UserRepository.GetAllUsers(filters)
+	users, err := h.UserRepository.GetAllUsers(c.Request.Context(), filters)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, users)
+	writeExpanded(c, h.Expand, audit.EntityUser, users)
 }
 
-// http://localhost:8080/users
-// http://localhost:8080/users?age=30&gender=m
+// http://localhost:8080/api/v1/users
+// http://localhost:8080/api/v1/users?age=30&gender=m
 
+// UpdateUser (PUT) foydalanuvchini to'liq almashtiradi
 func (h *UserHandler) UpdateUser(c *gin.Context) {
 	id := c.Param("id")
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid user ID"))
+		return
+	}
 
 	var userUpdate models.UserUpdate
-	if err := c.BindJSON(&userUpdate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&userUpdate); err != nil {
+		c.Error(validation.FromBindError(err))
 		return
 	}
 
-	if userUpdate.Birthday != "" {
-		_, err := time.Parse("2006-01-02", userUpdate.Birthday)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format"})
-			return
-		}
+	before, err := h.UserRepository.GetUserByID(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
 	}
 
-	userUpdate.ID = uuid.MustParse(id)
+	if _, ok := h.saveUser(c, before, userID, userUpdate); ok {
+		c.JSON(http.StatusOK, middleware.Message(c, "user_updated"))
+	}
+}
 
-	if err := h.UserRepository.UpdateUser(userUpdate); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+// PatchUser (PATCH) joriy foydalanuvchiga JSON Merge Patch qo'llaydi va yangilangan yozuvni qaytaradi
+func (h *UserHandler) PatchUser(c *gin.Context) {
+	id := c.Param("id")
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid user ID"))
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "User updated successfully"})
+	before, err := h.UserRepository.GetUserByID(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	userUpdate := before.ToUpdate()
+	if err := bindMergePatch(c, &userUpdate); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if after, ok := h.saveUser(c, before, userID, userUpdate); ok {
+		c.JSON(http.StatusOK, after)
+	}
 }
 
-// http://localhost:8080/users/41cf99a7-16f9-4256-98fc-9bb495a455e8
+// saveUser PUT va PATCH uchun umumiy: If-Match ni tekshiradi, yozadi va auditga qayd qiladi
+func (h *UserHandler) saveUser(c *gin.Context, before models.User, userID uuid.UUID, userUpdate models.UserUpdate) (models.User, bool) {
+	if err := checkIfMatch(c, before.Version); err != nil {
+		c.Error(err)
+		return models.User{}, false
+	}
+	userUpdate.Version = before.Version
+	userUpdate.ID = userID
+	if err := h.UserRepository.UpdateUser(c.Request.Context(), userUpdate); err != nil {
+		c.Error(err)
+		return models.User{}, false
+	}
+
+	after, err := h.UserRepository.GetUserByID(c.Request.Context(), userID.String())
+	if err != nil {
+		c.Error(err)
+		return models.User{}, false
+	}
+	h.Audit.Record(c, audit.EntityUser, userID.String(), audit.ActionUpdate, before, after)
+	setETag(c, after.Version)
+	return after, true
+}
+
+// http://localhost:8080/api/v1/users/41cf99a7-16f9-4256-98fc-9bb495a455e8
 // {
 // 	"name": "Update name",
 // 	"email": "updatedemail@example.com",
@@ -124,7 +173,7 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 // 	"gender": "f"
 // }
 
-// yoki faqat 1 ta field uchun
+// yoki faqat 1 ta field uchun PATCH (application/merge-patch+json) bilan
 // {
 // 	"name": "Update  Name"
 // }
@@ -132,48 +181,130 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 func (h *UserHandler) DeleteUser(c *gin.Context) {
 	id := c.Param("id")
 
-	if err := h.UserRepository.DeleteUser(id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	before, err := h.UserRepository.GetUserByID(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := checkIfMatch(c, before.Version); err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "User deleted successfully"})
+	if err := h.UserRepository.DeleteUser(c.Request.Context(), id); err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.Audit.Record(c, audit.EntityUser, id, audit.ActionDelete, before, nil)
+
+	c.JSON(http.StatusOK, middleware.Message(c, "user_deleted"))
 }
 
 func (h *UserHandler) GetUserInterviews(c *gin.Context) {
 	id := c.Param("id")
 	userID, err := uuid.Parse(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid user ID"))
 		return
 	}
 
-	interviews, err := h.UserRepository.GetUserInterviews(userID)
+	interviews, err := h.UserRepository.GetUserInterviews(c.Request.Context(), userID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Interviews not found"})
+		c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, interviews)
 }
 
-// http://localhost:8080/users/41cf99a7-16f9-4256-98fc-9bb495a455e8/myInterview
+// http://localhost:8080/api/v1/users/41cf99a7-16f9-4256-98fc-9bb495a455e8/myInterview
 
 func (h *UserHandler) GetUserResume(c *gin.Context) {
 	id := c.Param("id")
 	userID, err := uuid.Parse(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid user ID"))
 		return
 	}
 
-	resumes, err := h.UserRepository.GetUserResume(userID)
+	resumes, err := h.UserRepository.GetUserResume(c.Request.Context(), userID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Resumes not found"})
+		c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, resumes)
 }
 
-// http://localhost:8080/users/41cf99a7-16f9-4256-98fc-9bb495a455e8/myresume
+// http://localhost:8080/api/v1/users/41cf99a7-16f9-4256-98fc-9bb495a455e8/myresume
+
+// GetDuplicateUsers ehtimoliy dublikat foydalanuvchilar hisobotini qaytaradi
+func (h *UserHandler) GetDuplicateUsers(c *gin.Context) {
+	groups, err := h.UserRepository.FindDuplicates(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, groups)
+}
+
+// http://localhost:8080/api/v1/users/duplicates
+// [
+//     {
+//         "reason": "email",
+//         "key": "abu@example.com",
+//         "users": [ {...}, {...} ]
+//     }
+// ]
+
+// MergeUsers source foydalanuvchini target ga birlashtiradi: rezyume va intervyular
+// target ga o'tadi, source esa o'chiriladi
+func (h *UserHandler) MergeUsers(c *gin.Context) {
+	var merge models.MergeUsers
+	if err := c.ShouldBindJSON(&merge); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+	if merge.SourceID == merge.TargetID {
+		c.Error(apperrors.Validation("merge_same_user", "Cannot merge a user into itself").WithField("target_id", "must differ from source_id"))
+		return
+	}
+
+	source, err := h.UserRepository.GetUserByID(c.Request.Context(), merge.SourceID.String())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	target, err := h.UserRepository.GetUserByID(c.Request.Context(), merge.TargetID.String())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.UserRepository.MergeUsers(c.Request.Context(), merge.SourceID, merge.TargetID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.Audit.Record(c, audit.EntityUser, source.ID.String(), audit.ActionDelete, source, nil)
+	h.Audit.Record(c, audit.EntityUser, target.ID.String(), audit.ActionUpdate, target, result.User)
+	setETag(c, result.User.Version)
+
+	c.JSON(http.StatusOK, result)
+}
+
+// http://localhost:8080/api/v1/users/merge
+// {
+//     "source_id": "7d1c9a52-3f0e-4b7a-9c1d-2e8f4a6b0c13",
+//     "target_id": "41cf99a7-16f9-4256-98fc-9bb495a455e8"
+// }
+// {
+//     "user": { "id": "41cf99a7-16f9-4256-98fc-9bb495a455e8", ... },
+//     "merged_user_id": "7d1c9a52-3f0e-4b7a-9c1d-2e8f4a6b0c13",
+//     "moved_resumes": 1,
+//     "moved_interviews": 2
+// }
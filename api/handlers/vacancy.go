@@ -1,9 +1,17 @@
 package handlers
 
 import (
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/expand"
 	"hrplatform/models"
 	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/webhook"
+	"hrplatform/validation"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -11,45 +19,31 @@ import (
 
 type VacancyHandler struct {
 	VacancyRepository postgres.VacancyRepository
+	Service           *service.VacancyService
+	Webhooks          *webhook.Dispatcher
+	Audit             *audit.Recorder
+	Expand            *expand.Expander // ?expand= va ?fields= uchun
 }
 
 func (h *VacancyHandler) CreateVacancy(c *gin.Context) {
 	var vacancyCreate models.CreateVacancy
-	if err := c.BindJSON(&vacancyCreate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON data"})
+	if err := c.ShouldBindJSON(&vacancyCreate); err != nil {
+		c.Error(validation.FromBindError(err))
 		return
 	}
 
-	if vacancyCreate.Name == "" || vacancyCreate.Position == "" || vacancyCreate.Description == "" || vacancyCreate.CompanyID == uuid.Nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Majburiy maydonlar yetishmayapti"})
-		return
-	}
-
-	if vacancyCreate.MinExp < 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Minimal tajriba noldan kam bo'lishi mumkin emas"})
-		return
-	}
-
-	exists, err := h.VacancyRepository.CheckCompanyExists(vacancyCreate.CompanyID)
+	vacancy, err := h.Service.Create(c.Request.Context(), vacancyCreate)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Kompaniya mavjudligini tekshirib bolmadi"})
-		return
-	}
-	if !exists {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Berilgan ID ga ega kompaniya mavjud emas"})
+		c.Error(err)
 		return
 	}
 
-	vacancy, err := h.VacancyRepository.CreateVacancy(vacancyCreate)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create vacancy"})
-		return
-	}
+	h.Audit.Record(c, audit.EntityVacancy, vacancy.ID.String(), audit.ActionCreate, nil, vacancy)
 
 	c.JSON(http.StatusCreated, vacancy)
 }
 
-// http://localhost:8080/vacancies
+// http://localhost:8080/api/v1/vacancies
 //
 //	{
 //	    "name": "Senior Backend Engineer",
@@ -74,20 +68,21 @@ func (h *VacancyHandler) GetVacancyByID(c *gin.Context) {
 	id := c.Param("id")
 	vacancyID, err := uuid.Parse(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid vacancy ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid vacancy ID"))
 		return
 	}
 
-	vacancy, err := h.VacancyRepository.GetVacancyByID(vacancyID)
+	vacancy, err := h.VacancyRepository.GetVacancyByID(c.Request.Context(), vacancyID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Vacancy not found"})
+		c.Error(err)
 		return
 	}
+	setETag(c, vacancy.Version)
 
-	c.JSON(http.StatusOK, vacancy)
+	writeExpanded(c, h.Expand, audit.EntityVacancy, vacancy)
 }
 
-// http://localhost:8080/vacancies/9a95ebb5-4ef1-422b-b2a0-a8336d611f8a
+// http://localhost:8080/api/v1/vacancies/9a95ebb5-4ef1-422b-b2a0-a8336d611f8a
 // {
 //     "id": "9a95ebb5-4ef1-422b-b2a0-a8336d611f8a",
 //     "name": "Senior Backend Engineer",
@@ -106,28 +101,33 @@ func (h *VacancyHandler) GetAllVacancies(c *gin.Context) {
 	if position := c.Query("position"); position != "" {
 		filter["position"] = position
 	}
-	if minExp := c.Query("min_exp"); minExp != "" {
+	if minExpStr := c.Query("min_exp"); minExpStr != "" {
+		minExp, err := strconv.Atoi(minExpStr)
+		if err != nil {
+			c.Error(apperrors.Validation("invalid_query", "Invalid min_exp value").WithField("min_exp", "must be an integer"))
+			return
+		}
 		filter["min_exp"] = minExp
 	}
 	if companyID := c.Query("company_id"); companyID != "" {
 		parsedCompanyID, err := uuid.Parse(companyID)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+			c.Error(apperrors.Validation("invalid_id", "Invalid company ID"))
 			return
 		}
 		filter["company_id"] = parsedCompanyID
 	}
 
-	vacancies, err := h.VacancyRepository.GetAllVacancies(filter)
+	vacancies, err := h.VacancyRepository.GetAllVacancies(c.Request.Context(), filter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, vacancies)
+	writeExpanded(c, h.Expand, audit.EntityVacancy, vacancies)
 }
 
-// http://localhost:8080/vacancies/
+// http://localhost:8080/api/v1/vacancies/
 // [
 //     {
 //         "id": "9a95ebb5-4ef1-422b-b2a0-a8336d611f8a",
@@ -142,29 +142,85 @@ func (h *VacancyHandler) GetAllVacancies(c *gin.Context) {
 //     }
 // ]
 
+// UpdateVacancy (PUT) vakansiyani to'liq almashtiradi
 func (h *VacancyHandler) UpdateVacancy(c *gin.Context) {
-	id := c.Param("id")
+	vacancyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid vacancy ID"))
+		return
+	}
 
 	var vacancyUpdate models.UpdateVacancy
-	if err := c.BindJSON(&vacancyUpdate); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := c.ShouldBindJSON(&vacancyUpdate); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	before, err := h.VacancyRepository.GetVacancyByID(c.Request.Context(), vacancyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if _, ok := h.saveVacancy(c, before, vacancyID, vacancyUpdate); ok {
+		c.JSON(http.StatusOK, middleware.Message(c, "vacancy_updated"))
+	}
+}
+
+// PatchVacancy (PATCH) joriy vakansiyaga JSON Merge Patch qo'llaydi va yangilangan yozuvni qaytaradi
+func (h *VacancyHandler) PatchVacancy(c *gin.Context) {
+	vacancyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid vacancy ID"))
 		return
 	}
 
-	vacancyUpdate.ID, _ = uuid.Parse(id)
+	before, err := h.VacancyRepository.GetVacancyByID(c.Request.Context(), vacancyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
-	if err := h.VacancyRepository.UpdateVacancy(vacancyUpdate); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	vacancyUpdate := before.ToUpdate()
+	if err := bindMergePatch(c, &vacancyUpdate); err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Vacancy updated successfully"})
+	if after, ok := h.saveVacancy(c, before, vacancyID, vacancyUpdate); ok {
+		c.JSON(http.StatusOK, after)
+	}
 }
 
-// http://localhost:8080/vacancies/9a95ebb5-4ef1-422b-b2a0-a8336d611f8a
-/// {
+// saveVacancy PUT va PATCH uchun umumiy: If-Match ni tekshiradi, service orqali yozadi va auditga qayd qiladi
+func (h *VacancyHandler) saveVacancy(c *gin.Context, before models.Vacancy, vacancyID uuid.UUID, vacancyUpdate models.UpdateVacancy) (models.Vacancy, bool) {
+	if err := checkIfMatch(c, before.Version); err != nil {
+		c.Error(err)
+		return models.Vacancy{}, false
+	}
+	vacancyUpdate.Version = before.Version
+	vacancyUpdate.ID = vacancyID
+
+	if err := h.Service.Update(c.Request.Context(), before, vacancyUpdate); err != nil {
+		c.Error(err)
+		return models.Vacancy{}, false
+	}
+
+	after, err := h.VacancyRepository.GetVacancyByID(c.Request.Context(), vacancyID)
+	if err != nil {
+		c.Error(err)
+		return models.Vacancy{}, false
+	}
+	h.Audit.Record(c, audit.EntityVacancy, vacancyID.String(), audit.ActionUpdate, before, after)
+	setETag(c, after.Version)
+	return after, true
+}
+
+// PATCH http://localhost:8080/api/v1/vacancies/9a95ebb5-4ef1-422b-b2a0-a8336d611f8a
+// {
 //     "position": "junior engineer",
-//     "min_exp": 6
+//     "min_exp": 0,
+//     "description": null
 // }
 // {
 //     "id": "9a95ebb5-4ef1-422b-b2a0-a8336d611f8a",
@@ -182,13 +238,28 @@ func (h *VacancyHandler) DeleteVacancy(c *gin.Context) {
 	id := c.Param("id")
 	vacancyID, err := uuid.Parse(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid vacancy ID"})
+		c.Error(apperrors.Validation("invalid_id", "Invalid vacancy ID"))
+		return
+	}
+
+	vacancy, err := h.VacancyRepository.GetVacancyByID(c.Request.Context(), vacancyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := checkIfMatch(c, vacancy.Version); err != nil {
+		c.Error(err)
 		return
 	}
 
-	if err := h.VacancyRepository.DeleteVacancy(vacancyID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	// Vakansiya intervyulari bilan birga o'chiriladi
+	if err := h.Service.Delete(c.Request.Context(), vacancyID); err != nil {
+		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"message": "Vacancy deleted successfully"})
+
+	h.Audit.Record(c, audit.EntityVacancy, id, audit.ActionDelete, vacancy, nil)
+	h.Webhooks.Dispatch(c.Request.Context(), vacancy.CompanyID, webhook.EventVacancyClosed, vacancy)
+	c.JSON(http.StatusOK, middleware.Message(c, "vacancy_deleted"))
 }
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/models"
+	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/webhook"
+	"hrplatform/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WebhookHandler struct {
+	WebhookRepository postgres.WebhookRepository
+	Companies         *service.CompanyService
+	Audit             *audit.Recorder
+}
+
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	var webhookCreate models.CreateWebhook
+	if err := c.ShouldBindJSON(&webhookCreate); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	for _, event := range webhookCreate.Events {
+		if !webhook.IsValidEvent(event) {
+			c.Error(apperrors.Validation("unknown_event", "Unknown event: "+event).WithArg("Event", event).WithField("events", "must be one of "+strings.Join(webhook.Events, ", ")))
+			return
+		}
+	}
+
+	if err := h.Companies.RequireCompany(c.Request.Context(), webhookCreate.CompanyID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if webhookCreate.Secret == "" {
+		secret, err := webhook.GenerateSecret()
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		webhookCreate.Secret = secret
+	}
+
+	created, err := h.WebhookRepository.CreateWebhook(c.Request.Context(), webhookCreate)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	logged := created
+	logged.Secret = ""
+	h.Audit.Record(c, audit.EntityWebhook, created.ID.String(), audit.ActionCreate, nil, logged)
+
+	// secret faqat yaratilganda bir marta qaytariladi
+	c.JSON(http.StatusCreated, created)
+}
+
+// http://localhost:8080/api/v1/webhooks/
+// {
+//     "company_id": "ad277609-f698-489a-a744-ec3cb9e812ce",
+//     "url": "https://ats.example.com/hooks/hrplatform",
+//     "events": ["interview.scheduled", "vacancy.closed"]
+// }
+
+func (h *WebhookHandler) GetWebhookByID(c *gin.Context) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid webhook ID"))
+		return
+	}
+
+	wh, err := h.WebhookRepository.GetWebhookByID(c.Request.Context(), webhookID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	wh.Secret = ""
+	setETag(c, wh.Version)
+
+	c.JSON(http.StatusOK, wh)
+}
+
+func (h *WebhookHandler) GetAllWebhooks(c *gin.Context) {
+	companyID := c.Query("company_id")
+	if companyID != "" {
+		if _, err := uuid.Parse(companyID); err != nil {
+			c.Error(apperrors.Validation("invalid_id", "Invalid company ID"))
+			return
+		}
+	}
+
+	webhooks, err := h.WebhookRepository.GetAllWebhooks(c.Request.Context(), companyID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+
+	c.JSON(http.StatusOK, webhooks)
+}
+
+// http://localhost:8080/api/v1/webhooks?company_id=ad277609-f698-489a-a744-ec3cb9e812ce
+
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid webhook ID"))
+		return
+	}
+
+	before, err := h.WebhookRepository.GetWebhookByID(c.Request.Context(), webhookID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := checkIfMatch(c, before.Version); err != nil {
+		c.Error(err)
+		return
+	}
+
+	before.Secret = ""
+
+	if err := h.WebhookRepository.DeleteWebhook(c.Request.Context(), webhookID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.Audit.Record(c, audit.EntityWebhook, webhookID.String(), audit.ActionDelete, before, nil)
+
+	c.JSON(http.StatusOK, middleware.Message(c, "webhook_deleted"))
+}
+
+func (h *WebhookHandler) GetWebhookDeliveries(c *gin.Context) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Error(apperrors.Validation("invalid_id", "Invalid webhook ID"))
+		return
+	}
+
+	if _, err := h.WebhookRepository.GetWebhookByID(c.Request.Context(), webhookID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	deliveries, err := h.WebhookRepository.GetDeliveries(c.Request.Context(), webhookID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// http://localhost:8080/api/v1/webhooks/0b6a4f7e-3c1d-4e55-9d7c-2f1f7a9c6b10/deliveries
+// [
+//     {
+//         "id": "5f0c2d8e-8a4b-4d1e-9d4e-7a1b2c3d4e5f",
+//         "webhook_id": "0b6a4f7e-3c1d-4e55-9d7c-2f1f7a9c6b10",
+//         "event_id": "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f",
+//         "event": "interview.scheduled",
+//         "payload": "{\"id\":\"c1d2e3f4-...\",\"event\":\"interview.scheduled\",...}",
+//         "attempt": 1,
+//         "status_code": 200,
+//         "response_body": "ok",
+//         "error": "",
+//         "success": true,
+//         "duration_ms": 84,
+//         "created_at": "2024-05-21T09:55:05.127182Z"
+//     }
+// ]
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deprecation - olib tashlanishi rejalashtirilgan route guruhi haqida ma'lumot
+type Deprecation struct {
+	Since     time.Time // eskirgan deb e'lon qilingan sana (Deprecation sarlavhasi, RFC 9745)
+	Sunset    time.Time // shu sanadan keyin route ishlamaydi (Sunset sarlavhasi, RFC 8594), nol bo'lsa yuborilmaydi
+	Prefix    string    // eskirgan guruhning prefiksi: "" yoki "/api/v1"
+	Successor string    // o'rnini bosuvchi prefiks: "/api/v1" yoki "/api/v2", bo'sh bo'lsa Link yuborilmaydi
+}
+
+// Deprecated guruhdagi har bir javobga Deprecation va Sunset sarlavhalarini, Successor
+// berilgan bo'lsa yangi versiyadagi shu yo'lga Link qo'shadi. Sarlavhalar handlerdan
+// oldin yoziladi, shuning uchun xatolik javoblarida ham bor.
+func Deprecated(d Deprecation) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.Writer.Header()
+		header.Set("Deprecation", fmt.Sprintf("@%d", d.Since.Unix()))
+		if !d.Sunset.IsZero() {
+			header.Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+		}
+		if d.Successor != "" {
+			successor := d.Successor + strings.TrimPrefix(c.Request.URL.Path, d.Prefix)
+			header.Add("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", successor))
+		}
+		c.Next()
+	}
+}
+
+// unversioned yo'ldan /api/vN prefiksini olib tashlaydi: /api/v1/users/:id -> /users/:id
+func unversioned(path string) string {
+	rest, ok := strings.CutPrefix(path, "/api/v")
+	if !ok {
+		return path
+	}
+	i := 0
+	for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+		i++
+	}
+	if i == 0 || (i < len(rest) && rest[i] != '/') {
+		return path
+	}
+	return rest[i:]
+}
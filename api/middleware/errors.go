@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+
+	"hrplatform/apperrors"
+	"hrplatform/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorResponse - barcha xatoliklar uchun yagona JSON javob
+type ErrorResponse struct {
+	Code      string                 `json:"code"`
+	Message   string                 `json:"message"`
+	Details   []apperrors.FieldError `json:"details,omitempty"`
+	RequestID string                 `json:"request_id"`
+}
+
+// StatusCode xatolik turiga mos HTTP statusini qaytaradi
+func StatusCode(kind apperrors.Kind) int {
+	switch kind {
+	case apperrors.KindNotFound:
+		return http.StatusNotFound
+	case apperrors.KindConflict:
+		return http.StatusConflict
+	case apperrors.KindValidation:
+		return http.StatusBadRequest
+	case apperrors.KindForbidden:
+		return http.StatusForbidden
+	case apperrors.KindTimeout:
+		return http.StatusGatewayTimeout
+	case apperrors.KindUnprocessable:
+		return http.StatusUnprocessableEntity
+	case apperrors.KindPreconditionFailed:
+		return http.StatusPreconditionFailed
+	case apperrors.KindPreconditionRequired:
+		return http.StatusPreconditionRequired
+	}
+	return http.StatusInternalServerError
+}
+
+// localizeFields qoidasi ma'lum maydon xatoliklari matnini "validation.<rule>"
+// kaliti bo'yicha tarjima qiladi
+func localizeFields(locale string, fields []apperrors.FieldError) []apperrors.FieldError {
+	if len(fields) == 0 {
+		return nil
+	}
+	localized := make([]apperrors.FieldError, len(fields))
+	for i, field := range fields {
+		localized[i] = field
+		if field.Rule == "" {
+			continue
+		}
+		data := map[string]string{"Param": field.Param}
+		if text, ok, err := i18n.Render(locale, "validation."+field.Rule, data); ok && err == nil {
+			localized[i].Message = text
+		}
+	}
+	return localized
+}
+
+// LocalizeFields maydon xatoliklarini so'rov tiliga tarjima qiladi. Xatolik sifatida
+// emas, oddiy javob ichida qaytariladigan tafsilotlar uchun (masalan mos kelmaslik sabablari).
+func LocalizeFields(c *gin.Context, fields []apperrors.FieldError) []apperrors.FieldError {
+	localized := localizeFields(GetLocale(c), fields)
+	if localized == nil {
+		return []apperrors.FieldError{}
+	}
+	return localized
+}
+
+// Errors handlerlar c.Error() orqali qo'shgan oxirgi xatolikni HTTP statusi va
+// ErrorResponse ga aylantiradi. Xabar "error.<code>" kaliti bo'yicha so'rov
+// tiliga tarjima qilinadi. Handler javob yozib bo'lgan bo'lsa hech narsa qilmaydi.
+func Errors() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		WriteError(c, c.Errors.Last().Err)
+	}
+}
+
+// WriteError xatolikni so'rov tilidagi ErrorResponse sifatida yozadi. Errors
+// zanjiridan tashqarida ishlaydigan middlewarelar ham shundan foydalanadi.
+func WriteError(c *gin.Context, err error) {
+	appErr := apperrors.From(err)
+	if appErr.Kind == apperrors.KindInternal {
+		log.Printf("request %s: %v", c.GetString(RequestIDKey), appErr.Err)
+	}
+
+	message, details := Localize(GetLocale(c), appErr)
+	c.AbortWithStatusJSON(StatusCode(appErr.Kind), ErrorResponse{
+		Code:      appErr.Code,
+		Message:   message,
+		Details:   details,
+		RequestID: c.GetString(RequestIDKey),
+	})
+}
+
+// Localize xatolik matnini "error.<code>" kaliti bo'yicha va maydon xatoliklarini
+// locale tiliga tarjima qiladi. gRPC server ham xuddi shu matnlarni qaytaradi.
+func Localize(locale string, appErr *apperrors.Error) (string, []apperrors.FieldError) {
+	message := appErr.Message
+	if text, ok, err := i18n.Render(locale, "error."+appErr.Code, appErr.Args); ok && err == nil {
+		message = text
+	}
+	return message, localizeFields(locale, appErr.Fields)
+}
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// Idempotency POST so'rovlarini Idempotency-Key sarlavhasi bo'yicha takrorlanishdan himoya qiladi.
+// Birinchi so'rovning javobi saqlanadi va shu kalit bilan kelgan keyingi so'rovlarga ttl davomida
+// qayta yuboriladi. Kalit boshqa tana bilan qayta ishlatilsa 422, birinchi so'rov hali
+// tugamagan bo'lsa 409 qaytariladi. 5xx javoblar saqlanmaydi, mijoz qayta urinishi mumkin.
+func Idempotency(repo postgres.IdempotencyRepository, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			WriteError(c, apperrors.Validation("invalid_idempotency_key", "Idempotency-Key must be at most 255 characters").WithField(IdempotencyKeyHeader, "must be at most 255 characters"))
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			WriteError(c, apperrors.Validation("invalid_body", "Failed to read request body"))
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		hash := sha256.Sum256(body)
+
+		ctx := c.Request.Context()
+		now := time.Now()
+		record := models.IdempotencyKey{
+			Key:         key,
+			Method:      c.Request.Method,
+			Path:        c.Request.URL.Path,
+			RequestHash: hex.EncodeToString(hash[:]),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(ttl),
+		}
+
+		stored, reserved, err := repo.Reserve(ctx, record)
+		if err != nil {
+			WriteError(c, err)
+			return
+		}
+		if !reserved {
+			replay(c, record, stored)
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		// Javob yozib bo'lingan, natijani so'rov timeoutidan qat'i nazar saqlash kerak
+		ctx = context.WithoutCancel(ctx)
+		status := recorder.Status()
+		if status >= http.StatusInternalServerError {
+			if err := repo.Release(ctx, record.Key, record.Method, record.Path); err != nil {
+				log.Printf("idempotency: kalitni bo'shatishda xatolik (%s): %v", record.Key, err)
+			}
+			return
+		}
+
+		record.StatusCode = status
+		record.ContentType = recorder.Header().Get("Content-Type")
+		record.ResponseBody = recorder.body.Bytes()
+		if err := repo.Complete(ctx, record); err != nil {
+			log.Printf("idempotency: javobni saqlashda xatolik (%s): %v", record.Key, err)
+		}
+	}
+}
+
+// replay mavjud kalit uchun javob beradi: tana mos kelmasa 422, hali bajarilayotgan bo'lsa 409,
+// aks holda saqlangan javobni o'zgarishsiz qaytaradi
+func replay(c *gin.Context, record, stored models.IdempotencyKey) {
+	if stored.RequestHash != record.RequestHash {
+		WriteError(c, apperrors.Unprocessable("idempotency_key_reused", "Idempotency-Key was already used with a different request body"))
+		return
+	}
+	if stored.StatusCode == 0 {
+		WriteError(c, apperrors.Conflict("idempotency_key_in_use", "A request with this Idempotency-Key is still being processed"))
+		return
+	}
+
+	c.Header(IdempotencyReplayedHeader, "true")
+	c.Data(stored.StatusCode, stored.ContentType, stored.ResponseBody)
+	c.Abort()
+}
+
+// responseRecorder javobni mijozga yozish bilan birga nusxasini ham saqlaydi
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
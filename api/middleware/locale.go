@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"hrplatform/i18n"
+	"hrplatform/postgres"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// LocaleKey - gin.Context da so'rov tili saqlanadigan kalit
+const LocaleKey = "locale"
+
+// Locale so'rov tilini aniqlaydi va Content-Language sarlavhasini qo'yadi.
+// Tartib: Accept-Language sarlavhasi, so'ng X-Actor-ID foydalanuvchisining
+// bildirishnoma sozlamalaridagi til, oxirida i18n.DefaultLocale.
+func Locale(preferences postgres.NotificationPreferenceRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale, ok := i18n.Negotiate(c.GetHeader("Accept-Language"))
+		if !ok {
+			locale = PreferredLocale(c.Request.Context(), preferences, c.GetHeader(ActorHeader))
+		}
+
+		c.Set(LocaleKey, locale)
+		c.Header("Content-Language", locale)
+		c.Next()
+	}
+}
+
+// PreferredLocale actor foydalanuvchining bildirishnoma sozlamalaridagi tilni qaytaradi.
+// Actor yoki sozlama bo'lmasa i18n.DefaultLocale.
+func PreferredLocale(ctx context.Context, preferences postgres.NotificationPreferenceRepository, actor string) string {
+	if preferences == nil || actor == "" {
+		return i18n.DefaultLocale
+	}
+	userID, err := uuid.Parse(actor)
+	if err != nil {
+		return i18n.DefaultLocale
+	}
+	preference, err := preferences.GetPreference(ctx, userID)
+	if err != nil || !i18n.IsSupported(preference.Locale) {
+		return i18n.DefaultLocale
+	}
+	return preference.Locale
+}
+
+// GetLocale so'rov uchun aniqlangan tilni qaytaradi
+func GetLocale(c *gin.Context) string {
+	if locale := c.GetString(LocaleKey); locale != "" {
+		return locale
+	}
+	return i18n.DefaultLocale
+}
+
+// Message i18n katalogidagi "message.<key>" matnini so'rov tilida qaytaradi
+func Message(c *gin.Context, key string) gin.H {
+	return gin.H{"message": i18n.T(GetLocale(c), "message."+key, nil)}
+}
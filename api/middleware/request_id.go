@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	RequestIDKey    = "request_id"
+
+	// ActorHeader - so'rovni yuborgan foydalanuvchi IDsi
+	ActorHeader = "X-Actor-ID"
+)
+
+// RequestID har bir so'rovga ID biriktiradi. Mijoz X-Request-ID yuborgan bo'lsa shu ishlatiladi,
+// aks holda yangisi yaratiladi. ID javob sarlavhasida ham qaytariladi.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" || len(requestID) > 128 {
+			requestID = uuid.NewString()
+		}
+		c.Set(RequestIDKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+		c.Next()
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Timeout so'rov contextiga muddat qo'yadi. Repositorylar shu contextni SQL drayverga uzatadi,
+// shuning uchun muddat tugasa yoki mijoz ulanishni uzsa so'rov bazada ham bekor qilinadi.
+// routes kalitlari "METHOD /route/:param" ko'rinishida (gin FullPath), topilmasa defaultTimeout ishlatiladi.
+// Kalit /api/vN prefiksisiz yozilsa barcha versiyalarga va prefikssiz eski yo'llarga tegishli.
+// 0 yoki manfiy qiymat muddatni o'chiradi.
+func Timeout(defaultTimeout time.Duration, routes map[string]time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout := defaultTimeout
+		if routeTimeout, ok := routes[c.Request.Method+" "+c.FullPath()]; ok {
+			timeout = routeTimeout
+		} else if routeTimeout, ok := routes[c.Request.Method+" "+unversioned(c.FullPath())]; ok {
+			timeout = routeTimeout
+		}
+		if timeout <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
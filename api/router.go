@@ -2,6 +2,13 @@ package api
 
 import (
 	"hrplatform/api/handlers"
+	"hrplatform/api/middleware"
+	"hrplatform/audit"
+	"hrplatform/graphqlapi"
+	"hrplatform/openapi"
+	"hrplatform/postgres"
+	"path"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -11,66 +18,144 @@ func SetupRouter(userHandler *handlers.UserHandler,
     recruiterHandler *handlers.RecruiterHandler, 
     companyHandler *handlers.CompanyHandler, 
     interviewHandler *handlers.InterviewHandler,
-    vacancyHandler *handlers.VacancyHandler) *gin.Engine {
+    vacancyHandler *handlers.VacancyHandler,
+    webhookHandler *handlers.WebhookHandler,
+    notificationHandler *handlers.NotificationHandler,
+    auditHandler *handlers.AuditHandler,
+    eligibilityHandler *handlers.EligibilityHandler,
+    importHandler *handlers.ImportHandler,
+    graphqlHandler *graphqlapi.Handler,
+    legacyDeprecation middleware.Deprecation,
+    idempotencyRepository postgres.IdempotencyRepository,
+    idempotencyTTL time.Duration,
+    requestTimeout time.Duration,
+    routeTimeouts map[string]time.Duration) *gin.Engine {
 	router := gin.Default()
+	router.Use(middleware.RequestID())
+	router.Use(middleware.Timeout(requestTimeout, routeTimeouts))
+	router.Use(middleware.Locale(notificationHandler.PreferenceRepository))
+	// Errors dan oldin turadi, shunda xatolik javoblari ham saqlanadi va qayta yuboriladi
+	router.Use(middleware.Idempotency(idempotencyRepository, idempotencyTTL))
+	router.Use(middleware.Errors())
 
-	userGroup := router.Group("/users")
-	{
-		userGroup.POST("/", userHandler.CreateUser)
-		userGroup.GET("/:id", userHandler.GetUserByID)
-		userGroup.GET("/", userHandler.GetAllUsers)
-		userGroup.PUT("/:id", userHandler.UpdateUser)
-		userGroup.DELETE("/:id", userHandler.DeleteUser)
-		userGroup.GET("/:id/myInterview", userHandler.GetUserInterviews)
-		userGroup.GET("/:id/myresume", userHandler.GetUserResume)
+	// v1 - joriy API. Javob shakli o'zgarsa u tegmaydi: yangi shakldagi route lar
+	// alohida funksiyada yoziladi va router.Group("/api/v2") ga yonma-yon ulanadi.
+	document := openapi.Build()
+	v1 := func(api *gin.RouterGroup) {
+		userGroup := api.Group("/users")
+		{
+			userGroup.POST("/", userHandler.CreateUser)
+			userGroup.GET("/:id", userHandler.GetUserByID)
+			userGroup.GET("/", userHandler.GetAllUsers)
+			userGroup.GET("/duplicates", userHandler.GetDuplicateUsers)
+			userGroup.POST("/merge", userHandler.MergeUsers)
+			userGroup.PUT("/:id", userHandler.UpdateUser)
+			userGroup.PATCH("/:id", userHandler.PatchUser)
+			userGroup.DELETE("/:id", userHandler.DeleteUser)
+			userGroup.GET("/:id/myInterview", userHandler.GetUserInterviews)
+			userGroup.GET("/:id/myresume", userHandler.GetUserResume)
+			userGroup.GET("/:id/history", auditHandler.History(audit.EntityUser))
+			userGroup.GET("/:id/notification-preferences", notificationHandler.GetPreferences)
+			userGroup.PUT("/:id/notification-preferences", notificationHandler.UpdatePreferences)
+			userGroup.POST("/:id/notification-preferences/opt-in", notificationHandler.OptIn)
+			userGroup.POST("/:id/notification-preferences/opt-out", notificationHandler.OptOut)
 
-	}
+		}
 
-	resumeGroup := router.Group("/resumes")
-	{
-		resumeGroup.POST("/", resumeHandler.CreateResume)
-		resumeGroup.GET("/:id", resumeHandler.GetResumeByID)
-		resumeGroup.GET("/", resumeHandler.GetAllResumes)
-		resumeGroup.PUT("/:id", resumeHandler.UpdateResume)
-		resumeGroup.DELETE("/:id", resumeHandler.DeleteResume)
-		// resumeGroup.GET("/user/:user_id", resumeHandler.GetResumesByUserID)
-	}
+		resumeGroup := api.Group("/resumes")
+		{
+			resumeGroup.POST("/", resumeHandler.CreateResume)
+			resumeGroup.GET("/:id", resumeHandler.GetResumeByID)
+			resumeGroup.GET("/", resumeHandler.GetAllResumes)
+			resumeGroup.PUT("/:id", resumeHandler.UpdateResume)
+			resumeGroup.PATCH("/:id", resumeHandler.PatchResume)
+			resumeGroup.DELETE("/:id", resumeHandler.DeleteResume)
+			resumeGroup.GET("/:id/history", auditHandler.History(audit.EntityResume))
+			// resumeGroup.GET("/user/:user_id", resumeHandler.GetResumesByUserID)
+		}
 
-	companyGroup := router.Group("/companies")
-	{
-		companyGroup.POST("/", companyHandler.CreateCompany)
-		companyGroup.GET("/:id", companyHandler.GetCompanyByID)
-		companyGroup.GET("/", companyHandler.GetAllCompanies)
-		companyGroup.PUT("/:id", companyHandler.UpdateCompany)
-		companyGroup.DELETE("/:id", companyHandler.DeleteCompany)
-	}
-	recruiterGroup := router.Group("/recruiters")
-	{
-		recruiterGroup.POST("/", recruiterHandler.CreateRecruiter)
-		recruiterGroup.GET("/:id", recruiterHandler.GetRecruiterByID)
-		recruiterGroup.GET("/", recruiterHandler.GetAllRecruiters)
-		recruiterGroup.PUT("/:id", recruiterHandler.UpdateRecruiter)
-		recruiterGroup.DELETE("/:id", recruiterHandler.DeleteRecruiter)
-	}
+		companyGroup := api.Group("/companies")
+		{
+			companyGroup.POST("/", companyHandler.CreateCompany)
+			companyGroup.GET("/:id", companyHandler.GetCompanyByID)
+			companyGroup.GET("/", companyHandler.GetAllCompanies)
+			companyGroup.PUT("/:id", companyHandler.UpdateCompany)
+			companyGroup.PATCH("/:id", companyHandler.PatchCompany)
+			companyGroup.DELETE("/:id", companyHandler.DeleteCompany)
+			companyGroup.GET("/:id/history", auditHandler.History(audit.EntityCompany))
+			companyGroup.GET("/:id/eligibility-rules", eligibilityHandler.GetCompanyRules)
+			companyGroup.PUT("/:id/eligibility-rules", eligibilityHandler.ReplaceCompanyRules)
+		}
+		recruiterGroup := api.Group("/recruiters")
+		{
+			recruiterGroup.POST("/", recruiterHandler.CreateRecruiter)
+			recruiterGroup.GET("/:id", recruiterHandler.GetRecruiterByID)
+			recruiterGroup.GET("/", recruiterHandler.GetAllRecruiters)
+			recruiterGroup.PUT("/:id", recruiterHandler.UpdateRecruiter)
+			recruiterGroup.PATCH("/:id", recruiterHandler.PatchRecruiter)
+			recruiterGroup.DELETE("/:id", recruiterHandler.DeleteRecruiter)
+			recruiterGroup.GET("/:id/history", auditHandler.History(audit.EntityRecruiter))
+		}
 
-	vacancyGroup := router.Group("/vacancies") 
-	{
-		vacancyGroup.POST("/", vacancyHandler.CreateVacancy)
-		vacancyGroup.GET("/:id", vacancyHandler.GetVacancyByID)
-		vacancyGroup.GET("/", vacancyHandler.GetAllVacancies)
-		vacancyGroup.PUT("/:id", vacancyHandler.UpdateVacancy)
-		vacancyGroup.DELETE("/:id", vacancyHandler.DeleteVacancy)
-	}
+		vacancyGroup := api.Group("/vacancies") 
+		{
+			vacancyGroup.POST("/", vacancyHandler.CreateVacancy)
+			vacancyGroup.GET("/:id", vacancyHandler.GetVacancyByID)
+			vacancyGroup.GET("/", vacancyHandler.GetAllVacancies)
+			vacancyGroup.PUT("/:id", vacancyHandler.UpdateVacancy)
+			vacancyGroup.PATCH("/:id", vacancyHandler.PatchVacancy)
+			vacancyGroup.DELETE("/:id", vacancyHandler.DeleteVacancy)
+			vacancyGroup.GET("/:id/history", auditHandler.History(audit.EntityVacancy))
+			vacancyGroup.GET("/:id/eligibility-rules", eligibilityHandler.GetVacancyRules)
+			vacancyGroup.PUT("/:id/eligibility-rules", eligibilityHandler.ReplaceVacancyRules)
+			vacancyGroup.POST("/:id/eligibility-check", eligibilityHandler.CheckEligibility)
+		}
+
+		interviewGroup := api.Group("/interviews")
+		{
+			interviewGroup.POST("/", interviewHandler.CreateInterview)
+			interviewGroup.GET("/:id", interviewHandler.GetInterviewByID)
+			interviewGroup.GET("/", interviewHandler.GetAllInterviews)
+			interviewGroup.PUT("/:id", interviewHandler.UpdateInterview)
+			interviewGroup.PATCH("/:id", interviewHandler.PatchInterview)
+			interviewGroup.DELETE("/:id", interviewHandler.DeleteInterview)
+			interviewGroup.GET("/:id/history", auditHandler.History(audit.EntityInterview))
+			// interviewGroup.GET("/user/:user_id", interviewHandler.GetInterviewsByUserID)
+		}
+
+		api.POST("/telegram/webhook", notificationHandler.TelegramWebhook)
 
-	interviewGroup := router.Group("/interviews")
-	{
-		interviewGroup.POST("/", interviewHandler.CreateInterview)
-		interviewGroup.GET("/:id", interviewHandler.GetInterviewByID)
-		interviewGroup.GET("/", interviewHandler.GetAllInterviews)
-		interviewGroup.PUT("/:id", interviewHandler.UpdateInterview)
-		interviewGroup.DELETE("/:id", interviewHandler.DeleteInterview)
-		// interviewGroup.GET("/user/:user_id", interviewHandler.GetInterviewsByUserID)
+		webhookGroup := api.Group("/webhooks")
+		{
+			webhookGroup.POST("/", webhookHandler.CreateWebhook)
+			webhookGroup.GET("/:id", webhookHandler.GetWebhookByID)
+			webhookGroup.GET("/", webhookHandler.GetAllWebhooks)
+			webhookGroup.DELETE("/:id", webhookHandler.DeleteWebhook)
+			webhookGroup.GET("/:id/deliveries", webhookHandler.GetWebhookDeliveries)
+			webhookGroup.GET("/:id/history", auditHandler.History(audit.EntityWebhook))
+		}
+
+		// CSV/XLSX dan ommaviy yaratish
+		importGroup := api.Group("/imports")
+		{
+			importGroup.POST("/users", importHandler.Import(audit.EntityUser))
+			importGroup.POST("/resumes", importHandler.Import(audit.EntityResume))
+			importGroup.POST("/vacancies", importHandler.Import(audit.EntityVacancy))
+		}
+
+		api.GET("/audit", auditHandler.GetAuditEntries)
+
+		api.POST("/graphql", graphqlHandler.Serve)
+
+		// OpenAPI hujjati va Swagger UI; yangi route openapi.Routes ga ham yoziladi
+		api.GET("/openapi.json", openapi.Handler(document))
+		api.GET("/docs", openapi.UIHandler(path.Join(api.BasePath(), "openapi.json")))
 	}
-	
+
+	v1(router.Group("/api/v1"))
+	// Prefikssiz eski yo'llar /api/v1 bilan bir xil ishlaydi, lekin olib tashlanadi:
+	// har bir javobda Deprecation, Sunset va /api/v1 dagi o'rnini ko'rsatuvchi Link bor
+	v1(router.Group("/", middleware.Deprecated(legacyDeprecation)))
+
 	return router
 }
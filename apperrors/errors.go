@@ -0,0 +1,138 @@
+package apperrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Kind - xatolik turi, HTTP statusini aynan shu belgilaydi
+type Kind string
+
+const (
+	KindNotFound   Kind = "not_found"
+	KindConflict   Kind = "conflict"
+	KindValidation Kind = "validation"
+	KindForbidden  Kind = "forbidden"
+	KindInternal   Kind = "internal"
+
+	// So'rov to'g'ri tuzilgan, lekin bajarib bo'lmaydi (masalan Idempotency-Key boshqa tana bilan)
+	KindUnprocessable Kind = "unprocessable"
+
+	// If-Match bilan optimistik blokirovka
+	KindPreconditionFailed   Kind = "precondition_failed"
+	KindPreconditionRequired Kind = "precondition_required"
+
+	// So'rov vaqti tugadi yoki mijoz ulanishni uzdi, SQL so'rov bekor qilindi
+	KindTimeout Kind = "timeout"
+)
+
+// FieldError - bitta maydon bo'yicha xatolik. Rule va Param deklarativ
+// validatsiya qoidasidan keladi (masalan "max" va "100") va tarjima uchun ishlatiladi.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Rule    string `json:"rule,omitempty"`
+	Param   string `json:"param,omitempty"`
+}
+
+// Error - domen xatoligi. Code mijozlar uchun barqaror identifikator
+// (masalan "user_not_found") va tarjimalar katalogidagi kalit, Message esa
+// katalogda tarjima topilmaganda ko'rsatiladigan inglizcha matn.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Fields  []FieldError
+	Args    map[string]interface{} // tarjima shablonidagi o'rinlar uchun qiymatlar
+	Err     error                  // asl sabab (loglar uchun), mijozga ko'rsatilmaydi
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+	return e.Code + ": " + e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// WithArg tarjima shabloni uchun qiymat qo'shadi, masalan {{.Email}}
+func (e *Error) WithArg(name string, value interface{}) *Error {
+	if e.Args == nil {
+		e.Args = map[string]interface{}{}
+	}
+	e.Args[name] = value
+	return e
+}
+
+// WithField xatolikka maydon bo'yicha tafsilot qo'shadi
+func (e *Error) WithField(field, message string) *Error {
+	e.Fields = append(e.Fields, FieldError{Field: field, Message: message})
+	return e
+}
+
+func NotFound(code, message string) *Error {
+	return &Error{Kind: KindNotFound, Code: code, Message: message}
+}
+
+func Conflict(code, message string) *Error {
+	return &Error{Kind: KindConflict, Code: code, Message: message}
+}
+
+func Validation(code, message string, fields ...FieldError) *Error {
+	return &Error{Kind: KindValidation, Code: code, Message: message, Fields: fields}
+}
+
+func Forbidden(code, message string) *Error {
+	return &Error{Kind: KindForbidden, Code: code, Message: message}
+}
+
+func Unprocessable(code, message string) *Error {
+	return &Error{Kind: KindUnprocessable, Code: code, Message: message}
+}
+
+// PreconditionFailed yozuv versiyasi If-Match dagi ETag ga mos kelmaganda qaytariladi
+func PreconditionFailed(code, message string) *Error {
+	return &Error{Kind: KindPreconditionFailed, Code: code, Message: message}
+}
+
+// PreconditionRequired o'zgartirish so'rovida If-Match bo'lmaganda qaytariladi
+func PreconditionRequired(code, message string) *Error {
+	return &Error{Kind: KindPreconditionRequired, Code: code, Message: message}
+}
+
+// InvalidJSON so'rov tanasini o'qib bo'lmaganda qaytariladi
+func InvalidJSON(err error) *Error {
+	return &Error{Kind: KindValidation, Code: "invalid_json", Message: "Invalid JSON body: " + err.Error(), Err: err}
+}
+
+// Internal kutilmagan xatolikni o'raydi. Asl matn mijozga chiqmaydi.
+func Internal(err error) *Error {
+	return &Error{Kind: KindInternal, Code: "internal_error", Message: "Internal server error", Err: err}
+}
+
+// Timeout context muddati tugashi yoki bekor qilinishi natijasidagi xatolikni o'raydi
+func Timeout(err error) *Error {
+	return &Error{Kind: KindTimeout, Code: "request_timeout", Message: "Request timed out", Err: err}
+}
+
+// From istalgan xatolikni *Error ga aylantiradi. Domen xatoligi bo'lmasa Internal bo'ladi.
+func From(err error) *Error {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return Timeout(err)
+	}
+	return Internal(err)
+}
+
+// Is xatolik berilgan turga tegishliligini tekshiradi
+func Is(err error, kind Kind) bool {
+	var appErr *Error
+	return errors.As(err, &appErr) && appErr.Kind == kind
+}
@@ -0,0 +1,156 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"reflect"
+	"time"
+
+	"hrplatform/api/middleware"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx/types"
+)
+
+// ActorHeader - o'zgartirishni kim qilganini bildiruvchi sarlavha
+const ActorHeader = middleware.ActorHeader
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+const (
+	EntityUser                   = "user"
+	EntityResume                 = "resume"
+	EntityCompany                = "company"
+	EntityRecruiter              = "recruiter"
+	EntityVacancy                = "vacancy"
+	EntityInterview              = "interview"
+	EntityWebhook                = "webhook"
+	EntityNotificationPreference = "notification_preference"
+	EntityEligibilityRules       = "eligibility_rules" // entity_id - kompaniya yoki vakansiya ID si
+)
+
+// Entities - audit qilinadigan barcha obyekt turlari
+var Entities = []string{
+	EntityUser, EntityResume, EntityCompany, EntityRecruiter, EntityVacancy,
+	EntityInterview, EntityWebhook, EntityNotificationPreference, EntityEligibilityRules,
+}
+
+// Change - bitta maydondagi o'zgarish
+type Change struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// Recorder o'zgartirishlarni audit jadvaliga yozadi. Yozishdagi xatolik so'rovni
+// to'xtatmaydi, faqat logga chiqadi. nil Recorder hech narsa yozmaydi.
+type Recorder struct {
+	Repository postgres.AuditRepository
+}
+
+// Source - o'zgartirish manbai: kim, qaysi so'rov va qaysi manzildan
+type Source struct {
+	Actor     string
+	RequestID string
+	IP        string
+}
+
+// Record so'rov kontekstidan actor, request id va IP ni olib audit yozuvini saqlaydi.
+// before yoki after nil bo'lishi mumkin (create va delete uchun).
+func (r *Recorder) Record(c *gin.Context, entityType, entityID, action string, before, after interface{}) {
+	if r == nil {
+		return
+	}
+	r.RecordContext(c.Request.Context(), SourceOf(c), entityType, entityID, action, before, after)
+}
+
+// SourceOf so'rovdagi X-Actor-ID, request id va mijoz IP si
+func SourceOf(c *gin.Context) Source {
+	return Source{Actor: c.GetHeader(ActorHeader), RequestID: c.GetString(middleware.RequestIDKey), IP: c.ClientIP()}
+}
+
+// RecordContext Record ning HTTP ga bog'liq bo'lmagan ko'rinishi (masalan gRPC uchun):
+// manba ma'lumotlarini chaqiruvchi beradi
+func (r *Recorder) RecordContext(ctx context.Context, source Source, entityType, entityID, action string, before, after interface{}) {
+	if r == nil {
+		return
+	}
+
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		log.Printf("audit: before ni serializatsiya qilishda xatolik: %v", err)
+		return
+	}
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		log.Printf("audit: after ni serializatsiya qilishda xatolik: %v", err)
+		return
+	}
+	diffJSON, err := json.Marshal(Diff(beforeJSON, afterJSON))
+	if err != nil {
+		log.Printf("audit: diff ni serializatsiya qilishda xatolik: %v", err)
+		return
+	}
+
+	actor := source.Actor
+	if actor == "" {
+		actor = "anonymous"
+	}
+
+	entry := models.AuditEntry{
+		ID:         uuid.New(),
+		Actor:      actor,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Before:     types.JSONText(beforeJSON),
+		After:      types.JSONText(afterJSON),
+		Diff:       types.JSONText(diffJSON),
+		RequestID:  source.RequestID,
+		IP:         source.IP,
+		CreatedAt:  time.Now(),
+	}
+
+	// O'zgarish allaqachon bajarilgan, shuning uchun yozuv so'rov bekor qilinsa ham saqlanadi
+	if err := r.Repository.CreateEntry(context.WithoutCancel(ctx), entry); err != nil {
+		log.Printf("audit: %s %s %s yozuvini saqlashda xatolik: %v", action, entityType, entityID, err)
+	}
+}
+
+// Diff ikki JSON obyektni solishtirib, o'zgargan maydonlarni qaytaradi
+func Diff(before, after []byte) map[string]Change {
+	var beforeMap, afterMap map[string]interface{}
+	_ = json.Unmarshal(before, &beforeMap)
+	_ = json.Unmarshal(after, &afterMap)
+
+	changes := map[string]Change{}
+	for key, from := range beforeMap {
+		to, ok := afterMap[key]
+		if !ok || !reflect.DeepEqual(from, to) {
+			changes[key] = Change{From: from, To: to}
+		}
+	}
+	for key, to := range afterMap {
+		if _, ok := beforeMap[key]; !ok {
+			changes[key] = Change{From: nil, To: to}
+		}
+	}
+	return changes
+}
+
+// IsValidEntity obyekt turini tekshiradi
+func IsValidEntity(entityType string) bool {
+	for _, e := range Entities {
+		if e == entityType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+// Package client - HR platform API uchun tipli Go mijoz. Har bir endpoint uchun metod
+// (CreateUser, GetAllVacancies, CreateInterview ...) endpoints.go da bo'lib, u
+// openapi.Routes dan generatsiya qilinadi:
+//
+//	go generate ./client
+//
+// Metodlar /api/v1 dagi yo'llarni chaqiradi, New ga faqat server manzili beriladi.
+// So'rov va javoblar models turlaridan foydalanadi. Mijoz tarmoq xatoliklari, 429 va
+// 502/503/504 javoblarida qayta urinadi. POST so'rovlariga avtomatik Idempotency-Key
+// qo'shiladi, shuning uchun qayta urinish yozuvni ikki marta yaratmaydi. Server
+// xatoliklari *Error ko'rinishida qaytadi va apperrors.Is bilan tekshiriladi.
+//
+//	api := client.New("http://localhost:8080", client.WithToken(token))
+//	user, err := api.GetUserByID(ctx, id)
+//	if apperrors.Is(err, apperrors.KindNotFound) { ... }
+package client
+
+//go:generate go run ../cmd/clientgen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Client - API mijozi. Bir nechta goroutine dan bir vaqtda ishlatish mumkin.
+type Client struct {
+	baseURL     string
+	httpClient  *http.Client
+	token       func(ctx context.Context) (string, error)
+	actor       string
+	locale      string
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+// Option - New uchun sozlama
+type Option func(*Client)
+
+// WithHTTPClient o'z http.Client ini (transport, timeout) ishlatadi
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithToken har bir so'rovga "Authorization: Bearer <token>" qo'shadi
+func WithToken(token string) Option {
+	return WithTokenSource(func(context.Context) (string, error) { return token, nil })
+}
+
+// WithTokenSource tokenni har bir urinishdan oldin oladi (masalan yangilanadigan tokenlar uchun)
+func WithTokenSource(source func(ctx context.Context) (string, error)) Option {
+	return func(c *Client) { c.token = source }
+}
+
+// WithActor so'rovlarni shu foydalanuvchi nomidan yuboradi (X-Actor-ID, audit uchun)
+func WithActor(userID uuid.UUID) Option {
+	return func(c *Client) { c.actor = userID.String() }
+}
+
+// WithLocale xatolik va xabarlar tilini tanlaydi (Accept-Language): uz, ru yoki en
+func WithLocale(locale string) Option {
+	return func(c *Client) { c.locale = locale }
+}
+
+// WithRetries urinishlar sonini va birinchi kutish vaqtini belgilaydi. Har keyingi
+// kutish ikki baravar uzayadi. maxAttempts 1 bo'lsa qayta urinilmaydi.
+func WithRetries(maxAttempts int, baseDelay time.Duration) Option {
+	return func(c *Client) {
+		if maxAttempts < 1 {
+			maxAttempts = 1
+		}
+		c.maxAttempts = maxAttempts
+		c.baseDelay = baseDelay
+	}
+}
+
+// New baseURL dagi API uchun mijoz yaratadi, masalan "http://localhost:8080"
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+		maxAttempts: 3,
+		baseDelay:   200 * time.Millisecond,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// request - generatsiya qilingan metodlar yasaydigan so'rov
+type request struct {
+	method  string
+	path    string
+	query   url.Values
+	body    interface{}
+	patch   bool  // tana JSON Merge Patch
+	version int64 // 0 dan katta bo'lsa If-Match sifatida yuboriladi
+
+	// tayyor tana va uning turi (masalan multipart/form-data), body o'rniga yuboriladi
+	payload     []byte
+	contentType string
+}
+
+// do so'rovni yuboradi, kerak bo'lsa qayta urinadi va muvaffaqiyatli javobni out ga o'qiydi
+func (c *Client) do(ctx context.Context, req request, out interface{}) error {
+	payload := req.payload
+	if req.body != nil {
+		var err error
+		if payload, err = json.Marshal(req.body); err != nil {
+			return fmt.Errorf("client: marshal %s %s body: %w", req.method, req.path, err)
+		}
+	}
+	// barcha urinishlar bitta kalit bilan yuboriladi: server birinchi javobni qaytaradi
+	var idempotencyKey string
+	if req.method == http.MethodPost {
+		idempotencyKey = uuid.NewString()
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, req, payload, idempotencyKey)
+		if err != nil {
+			if ctx.Err() != nil || attempt >= c.maxAttempts {
+				return err
+			}
+			if err := c.wait(ctx, attempt, 0); err != nil {
+				return err
+			}
+			continue
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("client: read %s %s response: %w", req.method, req.path, err)
+		}
+
+		if resp.StatusCode >= 400 {
+			apiErr := decodeError(resp, body)
+			if !apiErr.Temporary() || attempt >= c.maxAttempts {
+				return apiErr
+			}
+			if err := c.wait(ctx, attempt, retryAfter(resp)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if out == nil || len(body) == 0 {
+			return nil
+		}
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("client: decode %s %s response: %w", req.method, req.path, err)
+		}
+		return nil
+	}
+}
+
+// send bitta urinish
+func (c *Client) send(ctx context.Context, req request, payload []byte, idempotencyKey string) (*http.Response, error) {
+	target := c.baseURL + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, target, body)
+	if err != nil {
+		return nil, fmt.Errorf("client: %s %s: %w", req.method, req.path, err)
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+	if payload != nil {
+		contentType := "application/json"
+		switch {
+		case req.contentType != "":
+			contentType = req.contentType
+		case req.patch:
+			contentType = "application/merge-patch+json"
+		}
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	if req.version > 0 {
+		httpReq.Header.Set("If-Match", `"`+strconv.FormatInt(req.version, 10)+`"`)
+	}
+	if idempotencyKey != "" {
+		httpReq.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if c.actor != "" {
+		httpReq.Header.Set("X-Actor-ID", c.actor)
+	}
+	if c.locale != "" {
+		httpReq.Header.Set("Accept-Language", c.locale)
+	}
+	if c.token != nil {
+		token, err := c.token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("client: get token: %w", err)
+		}
+		if token != "" {
+			httpReq.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("client: %s %s: %w", req.method, req.path, err)
+	}
+	return resp, nil
+}
+
+// wait attempt-urinishdan keyin kutadi: server Retry-After bergan bo'lsa shuncha,
+// aks holda baseDelay * 2^(attempt-1) va biroz tasodifiy qo'shimcha
+func (c *Client) wait(ctx context.Context, attempt int, after time.Duration) error {
+	delay := after
+	if delay == 0 {
+		delay = c.baseDelay << (attempt - 1)
+		if delay > 0 {
+			delay += time.Duration(rand.Int63n(int64(delay)/2 + 1))
+		}
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryAfter Retry-After sarlavhasidagi soniyalar
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,842 @@
+// Code generated by cmd/clientgen from openapi.Routes; DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"hrplatform/eligibility"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+// CreateUser - Create a user (POST /users/)
+func (c *Client) CreateUser(ctx context.Context, body models.UserCreate) (models.User, error) {
+	var out models.User
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/users/",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// GetUserByID - Get a user (GET /users/:id)
+func (c *Client) GetUserByID(ctx context.Context, id uuid.UUID) (models.User, error) {
+	var out models.User
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/users/" + id.String(),
+	}, &out)
+	return out, err
+}
+
+// GetAllUsersParams - GetAllUsers filtrlari. Nol qiymatli maydonlar yuborilmaydi.
+type GetAllUsersParams struct {
+	Age    int    // Exact age in full years
+	Gender string // m or f
+}
+
+func (p GetAllUsersParams) values() url.Values {
+	values := url.Values{}
+	if p.Age != 0 {
+		values.Set("age", strconv.Itoa(p.Age))
+	}
+	if p.Gender != "" {
+		values.Set("gender", p.Gender)
+	}
+	return values
+}
+
+// GetAllUsers - List users (GET /users/)
+func (c *Client) GetAllUsers(ctx context.Context, params GetAllUsersParams) ([]models.User, error) {
+	var out []models.User
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/users/",
+		query:  params.values(),
+	}, &out)
+	return out, err
+}
+
+// GetDuplicateUsers - Find users sharing an email, phone or name and birthday (GET /users/duplicates)
+func (c *Client) GetDuplicateUsers(ctx context.Context) ([]models.DuplicateGroup, error) {
+	var out []models.DuplicateGroup
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/users/duplicates",
+	}, &out)
+	return out, err
+}
+
+// MergeUsers - Merge a duplicate user into another one (POST /users/merge)
+func (c *Client) MergeUsers(ctx context.Context, body models.MergeUsers) (models.MergeResult, error) {
+	var out models.MergeResult
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/users/merge",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// UpdateUser - Replace a user (PUT /users/:id)
+func (c *Client) UpdateUser(ctx context.Context, id uuid.UUID, version int64, body models.UserUpdate) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodPut,
+		path:    "/api/v1/users/" + id.String(),
+		body:    body,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// PatchUser - Partially update a user (PATCH /users/:id)
+func (c *Client) PatchUser(ctx context.Context, id uuid.UUID, version int64, patch interface{}) (models.User, error) {
+	var out models.User
+	err := c.do(ctx, request{
+		method:  http.MethodPatch,
+		path:    "/api/v1/users/" + id.String(),
+		body:    patch,
+		patch:   true,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// DeleteUser - Delete a user (DELETE /users/:id)
+func (c *Client) DeleteUser(ctx context.Context, id uuid.UUID, version int64) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodDelete,
+		path:    "/api/v1/users/" + id.String(),
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// GetUserInterviews - Interviews of a user (GET /users/:id/myInterview)
+func (c *Client) GetUserInterviews(ctx context.Context, id uuid.UUID) ([]models.Interview, error) {
+	var out []models.Interview
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/users/" + id.String() + "/myInterview",
+	}, &out)
+	return out, err
+}
+
+// GetUserResumes - Resumes of a user (GET /users/:id/myresume)
+func (c *Client) GetUserResumes(ctx context.Context, id uuid.UUID) ([]models.Resume, error) {
+	var out []models.Resume
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/users/" + id.String() + "/myresume",
+	}, &out)
+	return out, err
+}
+
+// GetUserHistory - Audit history of a user (GET /users/:id/history)
+func (c *Client) GetUserHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEntry, error) {
+	var out []models.AuditEntry
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/users/" + id.String() + "/history",
+	}, &out)
+	return out, err
+}
+
+// GetNotificationPreferences - Notification preferences of a user (GET /users/:id/notification-preferences)
+func (c *Client) GetNotificationPreferences(ctx context.Context, id uuid.UUID) (models.NotificationPreference, error) {
+	var out models.NotificationPreference
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/users/" + id.String() + "/notification-preferences",
+	}, &out)
+	return out, err
+}
+
+// UpdateNotificationPreferences - Update notification preferences (PUT /users/:id/notification-preferences)
+func (c *Client) UpdateNotificationPreferences(ctx context.Context, id uuid.UUID, body models.UpdateNotificationPreference) (models.NotificationPreference, error) {
+	var out models.NotificationPreference
+	err := c.do(ctx, request{
+		method: http.MethodPut,
+		path:   "/api/v1/users/" + id.String() + "/notification-preferences",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// OptIn - Enable a notification channel (POST /users/:id/notification-preferences/opt-in)
+func (c *Client) OptIn(ctx context.Context, id uuid.UUID, body models.ChannelOptIn) (models.NotificationPreference, error) {
+	var out models.NotificationPreference
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/users/" + id.String() + "/notification-preferences/opt-in",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// OptOut - Disable a notification channel (POST /users/:id/notification-preferences/opt-out)
+func (c *Client) OptOut(ctx context.Context, id uuid.UUID, body models.ChannelOptIn) (models.NotificationPreference, error) {
+	var out models.NotificationPreference
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/users/" + id.String() + "/notification-preferences/opt-out",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// CreateResume - Create a resume (POST /resumes/)
+func (c *Client) CreateResume(ctx context.Context, body models.CreateResume) (models.Resume, error) {
+	var out models.Resume
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/resumes/",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// GetResumeByID - Get a resume (GET /resumes/:id)
+func (c *Client) GetResumeByID(ctx context.Context, id uuid.UUID) (models.Resume, error) {
+	var out models.Resume
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/resumes/" + id.String(),
+	}, &out)
+	return out, err
+}
+
+// GetAllResumesParams - GetAllResumes filtrlari. Nol qiymatli maydonlar yuborilmaydi.
+type GetAllResumesParams struct {
+	Position string // Case-insensitive substring of the position
+	MinExp   int    // Minimum years of experience
+}
+
+func (p GetAllResumesParams) values() url.Values {
+	values := url.Values{}
+	if p.Position != "" {
+		values.Set("position", p.Position)
+	}
+	if p.MinExp != 0 {
+		values.Set("min_exp", strconv.Itoa(p.MinExp))
+	}
+	return values
+}
+
+// GetAllResumes - List resumes with their owners (GET /resumes/)
+func (c *Client) GetAllResumes(ctx context.Context, params GetAllResumesParams) ([]models.ResumeWithUser, error) {
+	var out []models.ResumeWithUser
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/resumes/",
+		query:  params.values(),
+	}, &out)
+	return out, err
+}
+
+// UpdateResume - Replace a resume (PUT /resumes/:id)
+func (c *Client) UpdateResume(ctx context.Context, id uuid.UUID, version int64, body models.UpdateResume) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodPut,
+		path:    "/api/v1/resumes/" + id.String(),
+		body:    body,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// PatchResume - Partially update a resume (PATCH /resumes/:id)
+func (c *Client) PatchResume(ctx context.Context, id uuid.UUID, version int64, patch interface{}) (models.Resume, error) {
+	var out models.Resume
+	err := c.do(ctx, request{
+		method:  http.MethodPatch,
+		path:    "/api/v1/resumes/" + id.String(),
+		body:    patch,
+		patch:   true,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// DeleteResume - Delete a resume (DELETE /resumes/:id)
+func (c *Client) DeleteResume(ctx context.Context, id uuid.UUID, version int64) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodDelete,
+		path:    "/api/v1/resumes/" + id.String(),
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// GetResumeHistory - Audit history of a resume (GET /resumes/:id/history)
+func (c *Client) GetResumeHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEntry, error) {
+	var out []models.AuditEntry
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/resumes/" + id.String() + "/history",
+	}, &out)
+	return out, err
+}
+
+// CreateCompany - Create a company (POST /companies/)
+func (c *Client) CreateCompany(ctx context.Context, body models.CreateCompany) (models.Company, error) {
+	var out models.Company
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/companies/",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// GetCompanyByID - Get a company (GET /companies/:id)
+func (c *Client) GetCompanyByID(ctx context.Context, id uuid.UUID) (models.Company, error) {
+	var out models.Company
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/companies/" + id.String(),
+	}, &out)
+	return out, err
+}
+
+// GetAllCompanies - List companies (GET /companies/)
+func (c *Client) GetAllCompanies(ctx context.Context) ([]models.Company, error) {
+	var out []models.Company
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/companies/",
+	}, &out)
+	return out, err
+}
+
+// UpdateCompany - Replace a company (PUT /companies/:id)
+func (c *Client) UpdateCompany(ctx context.Context, id uuid.UUID, version int64, body models.UpdateCompany) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodPut,
+		path:    "/api/v1/companies/" + id.String(),
+		body:    body,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// PatchCompany - Partially update a company (PATCH /companies/:id)
+func (c *Client) PatchCompany(ctx context.Context, id uuid.UUID, version int64, patch interface{}) (models.Company, error) {
+	var out models.Company
+	err := c.do(ctx, request{
+		method:  http.MethodPatch,
+		path:    "/api/v1/companies/" + id.String(),
+		body:    patch,
+		patch:   true,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// DeleteCompany - Delete a company with its vacancies, recruiters and interviews (DELETE /companies/:id)
+func (c *Client) DeleteCompany(ctx context.Context, id uuid.UUID, version int64) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodDelete,
+		path:    "/api/v1/companies/" + id.String(),
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// GetCompanyHistory - Audit history of a company (GET /companies/:id/history)
+func (c *Client) GetCompanyHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEntry, error) {
+	var out []models.AuditEntry
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/companies/" + id.String() + "/history",
+	}, &out)
+	return out, err
+}
+
+// GetCompanyRules - Eligibility rules of a company (GET /companies/:id/eligibility-rules)
+func (c *Client) GetCompanyRules(ctx context.Context, id uuid.UUID) (CompanyRules, error) {
+	var out CompanyRules
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/companies/" + id.String() + "/eligibility-rules",
+	}, &out)
+	return out, err
+}
+
+// ReplaceCompanyRules - Replace eligibility rules of a company (PUT /companies/:id/eligibility-rules)
+func (c *Client) ReplaceCompanyRules(ctx context.Context, id uuid.UUID, body eligibility.RuleSet) (CompanyRules, error) {
+	var out CompanyRules
+	err := c.do(ctx, request{
+		method: http.MethodPut,
+		path:   "/api/v1/companies/" + id.String() + "/eligibility-rules",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// CreateRecruiter - Create a recruiter (POST /recruiters/)
+func (c *Client) CreateRecruiter(ctx context.Context, body models.CreateRecruiter) (models.Recruiter, error) {
+	var out models.Recruiter
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/recruiters/",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// GetRecruiterByID - Get a recruiter (GET /recruiters/:id)
+func (c *Client) GetRecruiterByID(ctx context.Context, id uuid.UUID) (models.Recruiter, error) {
+	var out models.Recruiter
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/recruiters/" + id.String(),
+	}, &out)
+	return out, err
+}
+
+// GetAllRecruitersParams - GetAllRecruiters filtrlari. Nol qiymatli maydonlar yuborilmaydi.
+type GetAllRecruitersParams struct {
+	Age       int       // Exact age in full years
+	Gender    string    // m or f
+	CompanyID uuid.UUID // Only records of this company
+}
+
+func (p GetAllRecruitersParams) values() url.Values {
+	values := url.Values{}
+	if p.Age != 0 {
+		values.Set("age", strconv.Itoa(p.Age))
+	}
+	if p.Gender != "" {
+		values.Set("gender", p.Gender)
+	}
+	if p.CompanyID != uuid.Nil {
+		values.Set("company_id", p.CompanyID.String())
+	}
+	return values
+}
+
+// GetAllRecruiters - List recruiters (GET /recruiters/)
+func (c *Client) GetAllRecruiters(ctx context.Context, params GetAllRecruitersParams) ([]models.Recruiter, error) {
+	var out []models.Recruiter
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/recruiters/",
+		query:  params.values(),
+	}, &out)
+	return out, err
+}
+
+// UpdateRecruiter - Replace a recruiter (PUT /recruiters/:id)
+func (c *Client) UpdateRecruiter(ctx context.Context, id uuid.UUID, version int64, body models.UpdateRecruiter) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodPut,
+		path:    "/api/v1/recruiters/" + id.String(),
+		body:    body,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// PatchRecruiter - Partially update a recruiter (PATCH /recruiters/:id)
+func (c *Client) PatchRecruiter(ctx context.Context, id uuid.UUID, version int64, patch interface{}) (models.Recruiter, error) {
+	var out models.Recruiter
+	err := c.do(ctx, request{
+		method:  http.MethodPatch,
+		path:    "/api/v1/recruiters/" + id.String(),
+		body:    patch,
+		patch:   true,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// DeleteRecruiter - Delete a recruiter with their interviews (DELETE /recruiters/:id)
+func (c *Client) DeleteRecruiter(ctx context.Context, id uuid.UUID, version int64) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodDelete,
+		path:    "/api/v1/recruiters/" + id.String(),
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// GetRecruiterHistory - Audit history of a recruiter (GET /recruiters/:id/history)
+func (c *Client) GetRecruiterHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEntry, error) {
+	var out []models.AuditEntry
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/recruiters/" + id.String() + "/history",
+	}, &out)
+	return out, err
+}
+
+// CreateVacancy - Create a vacancy (POST /vacancies/)
+func (c *Client) CreateVacancy(ctx context.Context, body models.CreateVacancy) (models.Vacancy, error) {
+	var out models.Vacancy
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/vacancies/",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// GetVacancyByID - Get a vacancy (GET /vacancies/:id)
+func (c *Client) GetVacancyByID(ctx context.Context, id uuid.UUID) (models.Vacancy, error) {
+	var out models.Vacancy
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/vacancies/" + id.String(),
+	}, &out)
+	return out, err
+}
+
+// GetAllVacanciesParams - GetAllVacancies filtrlari. Nol qiymatli maydonlar yuborilmaydi.
+type GetAllVacanciesParams struct {
+	Position  string    // Case-insensitive substring of the position
+	MinExp    int       // Vacancies requiring at least this many years
+	CompanyID uuid.UUID // Only records of this company
+}
+
+func (p GetAllVacanciesParams) values() url.Values {
+	values := url.Values{}
+	if p.Position != "" {
+		values.Set("position", p.Position)
+	}
+	if p.MinExp != 0 {
+		values.Set("min_exp", strconv.Itoa(p.MinExp))
+	}
+	if p.CompanyID != uuid.Nil {
+		values.Set("company_id", p.CompanyID.String())
+	}
+	return values
+}
+
+// GetAllVacancies - List vacancies (GET /vacancies/)
+func (c *Client) GetAllVacancies(ctx context.Context, params GetAllVacanciesParams) ([]models.Vacancy, error) {
+	var out []models.Vacancy
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/vacancies/",
+		query:  params.values(),
+	}, &out)
+	return out, err
+}
+
+// UpdateVacancy - Replace a vacancy (PUT /vacancies/:id)
+func (c *Client) UpdateVacancy(ctx context.Context, id uuid.UUID, version int64, body models.UpdateVacancy) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodPut,
+		path:    "/api/v1/vacancies/" + id.String(),
+		body:    body,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// PatchVacancy - Partially update a vacancy (PATCH /vacancies/:id)
+func (c *Client) PatchVacancy(ctx context.Context, id uuid.UUID, version int64, patch interface{}) (models.Vacancy, error) {
+	var out models.Vacancy
+	err := c.do(ctx, request{
+		method:  http.MethodPatch,
+		path:    "/api/v1/vacancies/" + id.String(),
+		body:    patch,
+		patch:   true,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// DeleteVacancy - Delete a vacancy with its interviews (DELETE /vacancies/:id)
+func (c *Client) DeleteVacancy(ctx context.Context, id uuid.UUID, version int64) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodDelete,
+		path:    "/api/v1/vacancies/" + id.String(),
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// GetVacancyHistory - Audit history of a vacancy (GET /vacancies/:id/history)
+func (c *Client) GetVacancyHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEntry, error) {
+	var out []models.AuditEntry
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/vacancies/" + id.String() + "/history",
+	}, &out)
+	return out, err
+}
+
+// GetVacancyRules - Own and effective eligibility rules of a vacancy (GET /vacancies/:id/eligibility-rules)
+func (c *Client) GetVacancyRules(ctx context.Context, id uuid.UUID) (VacancyRules, error) {
+	var out VacancyRules
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/vacancies/" + id.String() + "/eligibility-rules",
+	}, &out)
+	return out, err
+}
+
+// ReplaceVacancyRules - Replace eligibility rules of a vacancy (PUT /vacancies/:id/eligibility-rules)
+func (c *Client) ReplaceVacancyRules(ctx context.Context, id uuid.UUID, body eligibility.RuleSet) (VacancyRules, error) {
+	var out VacancyRules
+	err := c.do(ctx, request{
+		method: http.MethodPut,
+		path:   "/api/v1/vacancies/" + id.String() + "/eligibility-rules",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// CheckEligibility - Check a candidate against the vacancy rules without creating an interview (POST /vacancies/:id/eligibility-check)
+func (c *Client) CheckEligibility(ctx context.Context, id uuid.UUID, body EligibilityCheckRequest) (EligibilityCheck, error) {
+	var out EligibilityCheck
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/vacancies/" + id.String() + "/eligibility-check",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// CreateInterview - Schedule an interview for an eligible candidate (POST /interviews/)
+func (c *Client) CreateInterview(ctx context.Context, body models.CreateInterview) (models.Interview, error) {
+	var out models.Interview
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/interviews/",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// GetInterviewByID - Get an interview (GET /interviews/:id)
+func (c *Client) GetInterviewByID(ctx context.Context, id uuid.UUID) (models.Interview, error) {
+	var out models.Interview
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/interviews/" + id.String(),
+	}, &out)
+	return out, err
+}
+
+// GetAllInterviewsParams - GetAllInterviews filtrlari. Nol qiymatli maydonlar yuborilmaydi.
+type GetAllInterviewsParams struct {
+	CompanyID  uuid.UUID // Interviews held by recruiters of this company
+	Position   string    // Case-insensitive substring of the vacancy position
+	Experience int       // Candidates with a resume of at least this many years
+}
+
+func (p GetAllInterviewsParams) values() url.Values {
+	values := url.Values{}
+	if p.CompanyID != uuid.Nil {
+		values.Set("company_id", p.CompanyID.String())
+	}
+	if p.Position != "" {
+		values.Set("position", p.Position)
+	}
+	if p.Experience != 0 {
+		values.Set("experience", strconv.Itoa(p.Experience))
+	}
+	return values
+}
+
+// GetAllInterviews - List interviews (GET /interviews/)
+func (c *Client) GetAllInterviews(ctx context.Context, params GetAllInterviewsParams) ([]models.Interview, error) {
+	var out []models.Interview
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/interviews/",
+		query:  params.values(),
+	}, &out)
+	return out, err
+}
+
+// UpdateInterview - Replace an interview (PUT /interviews/:id)
+func (c *Client) UpdateInterview(ctx context.Context, id uuid.UUID, version int64, body models.UpdateInterview) (InterviewStatus, error) {
+	var out InterviewStatus
+	err := c.do(ctx, request{
+		method:  http.MethodPut,
+		path:    "/api/v1/interviews/" + id.String(),
+		body:    body,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// PatchInterview - Partially update an interview (PATCH /interviews/:id)
+func (c *Client) PatchInterview(ctx context.Context, id uuid.UUID, version int64, patch interface{}) (models.Interview, error) {
+	var out models.Interview
+	err := c.do(ctx, request{
+		method:  http.MethodPatch,
+		path:    "/api/v1/interviews/" + id.String(),
+		body:    patch,
+		patch:   true,
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// DeleteInterview - Cancel an interview (DELETE /interviews/:id)
+func (c *Client) DeleteInterview(ctx context.Context, id uuid.UUID, version int64) (InterviewStatus, error) {
+	var out InterviewStatus
+	err := c.do(ctx, request{
+		method:  http.MethodDelete,
+		path:    "/api/v1/interviews/" + id.String(),
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// GetInterviewHistory - Audit history of an interview (GET /interviews/:id/history)
+func (c *Client) GetInterviewHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEntry, error) {
+	var out []models.AuditEntry
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/interviews/" + id.String() + "/history",
+	}, &out)
+	return out, err
+}
+
+// TelegramWebhook - Telegram bot updates (/start <user_id>, /stop) (POST /telegram/webhook)
+func (c *Client) TelegramWebhook(ctx context.Context, body TelegramUpdate) (TelegramAck, error) {
+	var out TelegramAck
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/telegram/webhook",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// CreateWebhook - Subscribe a URL to company events (POST /webhooks/)
+func (c *Client) CreateWebhook(ctx context.Context, body models.CreateWebhook) (models.Webhook, error) {
+	var out models.Webhook
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/webhooks/",
+		body:   body,
+	}, &out)
+	return out, err
+}
+
+// GetWebhookByID - Get a webhook (GET /webhooks/:id)
+func (c *Client) GetWebhookByID(ctx context.Context, id uuid.UUID) (models.Webhook, error) {
+	var out models.Webhook
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/webhooks/" + id.String(),
+	}, &out)
+	return out, err
+}
+
+// GetAllWebhooksParams - GetAllWebhooks filtrlari. Nol qiymatli maydonlar yuborilmaydi.
+type GetAllWebhooksParams struct {
+	CompanyID uuid.UUID // Only records of this company
+}
+
+func (p GetAllWebhooksParams) values() url.Values {
+	values := url.Values{}
+	if p.CompanyID != uuid.Nil {
+		values.Set("company_id", p.CompanyID.String())
+	}
+	return values
+}
+
+// GetAllWebhooks - List webhooks (GET /webhooks/)
+func (c *Client) GetAllWebhooks(ctx context.Context, params GetAllWebhooksParams) ([]models.Webhook, error) {
+	var out []models.Webhook
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/webhooks/",
+		query:  params.values(),
+	}, &out)
+	return out, err
+}
+
+// DeleteWebhook - Delete a webhook (DELETE /webhooks/:id)
+func (c *Client) DeleteWebhook(ctx context.Context, id uuid.UUID, version int64) (Message, error) {
+	var out Message
+	err := c.do(ctx, request{
+		method:  http.MethodDelete,
+		path:    "/api/v1/webhooks/" + id.String(),
+		version: version,
+	}, &out)
+	return out, err
+}
+
+// GetWebhookDeliveries - Delivery attempts of a webhook (GET /webhooks/:id/deliveries)
+func (c *Client) GetWebhookDeliveries(ctx context.Context, id uuid.UUID) ([]models.WebhookDelivery, error) {
+	var out []models.WebhookDelivery
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/webhooks/" + id.String() + "/deliveries",
+	}, &out)
+	return out, err
+}
+
+// GetWebhookHistory - Audit history of a webhook (GET /webhooks/:id/history)
+func (c *Client) GetWebhookHistory(ctx context.Context, id uuid.UUID) ([]models.AuditEntry, error) {
+	var out []models.AuditEntry
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/webhooks/" + id.String() + "/history",
+	}, &out)
+	return out, err
+}
+
+// GetAuditEntriesParams - GetAuditEntries filtrlari. Nol qiymatli maydonlar yuborilmaydi.
+type GetAuditEntriesParams struct {
+	Entity string // Entity type, e.g. user or vacancy
+	ID     string // Entity ID
+}
+
+func (p GetAuditEntriesParams) values() url.Values {
+	values := url.Values{}
+	if p.Entity != "" {
+		values.Set("entity", p.Entity)
+	}
+	if p.ID != "" {
+		values.Set("id", p.ID)
+	}
+	return values
+}
+
+// GetAuditEntries - Audit log entries (GET /audit)
+func (c *Client) GetAuditEntries(ctx context.Context, params GetAuditEntriesParams) ([]models.AuditEntry, error) {
+	var out []models.AuditEntry
+	err := c.do(ctx, request{
+		method: http.MethodGet,
+		path:   "/api/v1/audit",
+		query:  params.values(),
+	}, &out)
+	return out, err
+}
+
+// GraphQL - GraphQL queries over companies, vacancies, interviews, users and resumes (batched, cost-limited) (POST /graphql)
+func (c *Client) GraphQL(ctx context.Context, body GraphQLRequest) (GraphQLResponse, error) {
+	var out GraphQLResponse
+	err := c.do(ctx, request{
+		method: http.MethodPost,
+		path:   "/api/v1/graphql",
+		body:   body,
+	}, &out)
+	return out, err
+}
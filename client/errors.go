@@ -0,0 +1,102 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"hrplatform/apperrors"
+)
+
+// Error - server qaytargan xatolik (middleware.ErrorResponse) va uning HTTP statusi.
+// Unwrap orqali *apperrors.Error beradi, shuning uchun serverdagi kabi tekshiriladi:
+//
+//	if apperrors.Is(err, apperrors.KindConflict) { ... }
+//	if client.IsCode(err, "user_email_taken") { ... }
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Details    []apperrors.FieldError
+	RequestID  string
+}
+
+func (e *Error) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("%d %s: %s (request %s)", e.StatusCode, e.Code, e.Message, e.RequestID)
+	}
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// Unwrap xatolikni server tomonidagi domen xatoligi ko'rinishida qaytaradi
+func (e *Error) Unwrap() error {
+	return &apperrors.Error{Kind: e.Kind(), Code: e.Code, Message: e.Message, Fields: e.Details}
+}
+
+// Kind HTTP statusga mos xatolik turi (middleware.StatusCode ning teskarisi)
+func (e *Error) Kind() apperrors.Kind {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return apperrors.KindNotFound
+	case http.StatusConflict:
+		return apperrors.KindConflict
+	case http.StatusBadRequest:
+		return apperrors.KindValidation
+	case http.StatusForbidden:
+		return apperrors.KindForbidden
+	case http.StatusGatewayTimeout:
+		return apperrors.KindTimeout
+	case http.StatusUnprocessableEntity:
+		return apperrors.KindUnprocessable
+	case http.StatusPreconditionFailed:
+		return apperrors.KindPreconditionFailed
+	case http.StatusPreconditionRequired:
+		return apperrors.KindPreconditionRequired
+	}
+	return apperrors.KindInternal
+}
+
+// Temporary so'rovni keyinroq o'zgarishsiz qayta yuborish mumkinligini bildiradi:
+// yuklama, vaqtinchalik ishlamaslik yoki shu Idempotency-Key bilan birinchi so'rov hali tugamagan
+func (e *Error) Temporary() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return e.Code == "idempotency_key_in_use"
+}
+
+// IsCode err server qaytargan code li xatolik ekanini tekshiradi
+func IsCode(err error, code string) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// decodeError javob tanasini Error ga o'qiydi. Tana ErrorResponse bo'lmasa (masalan
+// proksi yoki routerning matnli 404 sahifasi) code "http_<status>" bo'ladi.
+func decodeError(resp *http.Response, body []byte) *Error {
+	apiErr := &Error{StatusCode: resp.StatusCode, RequestID: resp.Header.Get("X-Request-ID")}
+	var payload struct {
+		Code      string                 `json:"code"`
+		Message   string                 `json:"message"`
+		Details   []apperrors.FieldError `json:"details"`
+		RequestID string                 `json:"request_id"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Code != "" {
+		apiErr.Code = payload.Code
+		apiErr.Message = payload.Message
+		apiErr.Details = payload.Details
+		if payload.RequestID != "" {
+			apiErr.RequestID = payload.RequestID
+		}
+		return apiErr
+	}
+	apiErr.Code = fmt.Sprintf("http_%d", resp.StatusCode)
+	apiErr.Message = strings.TrimSpace(string(body))
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}
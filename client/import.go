@@ -0,0 +1,88 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"hrplatform/models"
+)
+
+// ImportMapping - ustunlar xaritasi: Columns maydonni fayldagi sarlavhaga bog'laydi,
+// Defaults bo'sh kataklar uchun qiymat beradi (masalan barcha vakansiyalar uchun company_id)
+type ImportMapping struct {
+	Columns  map[string]string `json:"columns,omitempty"`
+	Defaults map[string]string `json:"defaults,omitempty"`
+}
+
+// ImportOptions - import so'rovi sozlamalari. Nol qiymatlar yuborilmaydi.
+type ImportOptions struct {
+	Filename     string // .csv yoki .xlsx, server formatni shundan aniqlaydi
+	Mapping      *ImportMapping
+	BatchSize    int  // bitta tranzaksiyadagi qatorlar (server standarti 100)
+	AllOrNothing bool // birorta xato qator bo'lsa hech narsa yozilmaydi
+}
+
+// ImportUsers - Create users from a CSV or XLSX file (POST /imports/users)
+func (c *Client) ImportUsers(ctx context.Context, file io.Reader, opts ImportOptions) (models.ImportReport, error) {
+	return c.upload(ctx, "/api/v1/imports/users", file, opts)
+}
+
+// ImportResumes - Create resumes from a CSV or XLSX file (POST /imports/resumes)
+func (c *Client) ImportResumes(ctx context.Context, file io.Reader, opts ImportOptions) (models.ImportReport, error) {
+	return c.upload(ctx, "/api/v1/imports/resumes", file, opts)
+}
+
+// ImportVacancies - Create vacancies from a CSV or XLSX file (POST /imports/vacancies)
+func (c *Client) ImportVacancies(ctx context.Context, file io.Reader, opts ImportOptions) (models.ImportReport, error) {
+	return c.upload(ctx, "/api/v1/imports/vacancies", file, opts)
+}
+
+// upload faylni multipart/form-data ga bir marta yig'adi, shunda qayta urinishlar bir
+// xil tana va Idempotency-Key bilan ketadi
+func (c *Client) upload(ctx context.Context, path string, file io.Reader, opts ImportOptions) (models.ImportReport, error) {
+	var out models.ImportReport
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+
+	filename := opts.Filename
+	if filename == "" {
+		filename = "import.csv"
+	}
+	part, err := form.CreateFormFile("file", filename)
+	if err != nil {
+		return out, fmt.Errorf("client: POST %s: %w", path, err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return out, fmt.Errorf("client: read import file: %w", err)
+	}
+	if opts.Mapping != nil {
+		mapping, err := json.Marshal(opts.Mapping)
+		if err != nil {
+			return out, fmt.Errorf("client: marshal import mapping: %w", err)
+		}
+		form.WriteField("mapping", string(mapping))
+	}
+	if opts.BatchSize != 0 {
+		form.WriteField("batch_size", strconv.Itoa(opts.BatchSize))
+	}
+	if opts.AllOrNothing {
+		form.WriteField("all_or_nothing", "true")
+	}
+	if err := form.Close(); err != nil {
+		return out, fmt.Errorf("client: POST %s: %w", path, err)
+	}
+
+	err = c.do(ctx, request{
+		method:      http.MethodPost,
+		path:        path,
+		payload:     buf.Bytes(),
+		contentType: form.FormDataContentType(),
+	}, &out)
+	return out, err
+}
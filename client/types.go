@@ -0,0 +1,109 @@
+package client
+
+import (
+	"encoding/json"
+
+	"hrplatform/apperrors"
+	"hrplatform/eligibility"
+
+	"github.com/google/uuid"
+)
+
+// Quyidagi turlar handlerlardagi gin.H javoblarining va handler ichidagi so'rov
+// turlarining nusxasi (openapi paketidagi sxemalar bilan bir xil). Mijoz gin va
+// server paketlarini import qilmasligi uchun ular shu yerda.
+
+// Message - PUT va DELETE muvaffaqiyat javobi (so'rov tilida)
+type Message struct {
+	Message string `json:"message"`
+}
+
+// InterviewStatus - intervyuning PUT va DELETE javobi
+type InterviewStatus struct {
+	Status string `json:"status"`
+}
+
+// TelegramAck - Telegram webhookiga qaytadigan javob
+type TelegramAck struct {
+	OK bool `json:"ok"`
+}
+
+// TelegramUpdate - Telegram bot updatesi (faqat server o'qiydigan maydonlar)
+type TelegramUpdate struct {
+	UpdateID int64 `json:"update_id"`
+	Message  *struct {
+		Text string `json:"text"`
+		Chat struct {
+			ID int64 `json:"id"`
+		} `json:"chat"`
+	} `json:"message"`
+}
+
+// CompanyRules - kompaniya darajasidagi muvofiqlik qoidalari
+type CompanyRules struct {
+	CompanyID uuid.UUID          `json:"company_id"`
+	Rules     []eligibility.Rule `json:"rules"`
+}
+
+// VacancyRules - vakansiyaning o'z qoidalari va standart hamda kompaniya qoidalari bilan
+// birlashtirilgan amaldagi qoidalar
+type VacancyRules struct {
+	VacancyID      uuid.UUID          `json:"vacancy_id"`
+	Rules          []eligibility.Rule `json:"rules"`
+	EffectiveRules []eligibility.Rule `json:"effective_rules"`
+}
+
+// EligibilityCheckRequest - CheckEligibility so'rovi
+type EligibilityCheckRequest struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// EligibilityCheck - nomzodni vakansiya qoidalari bo'yicha sinash natijasi
+type EligibilityCheck struct {
+	VacancyID uuid.UUID              `json:"vacancy_id"`
+	UserID    uuid.UUID              `json:"user_id"`
+	Eligible  bool                   `json:"eligible"`
+	Rules     []eligibility.Rule     `json:"rules"`
+	Reasons   []apperrors.FieldError `json:"reasons"`
+}
+
+// GraphQLRequest - POST /graphql tanasi
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLResponse - POST /graphql javobi. Data ni so'rovga mos turga json.Unmarshal qiling.
+type GraphQLResponse struct {
+	Data       json.RawMessage   `json:"data"`
+	Errors     []GraphQLError    `json:"errors,omitempty"`
+	Extensions GraphQLExtensions `json:"extensions"`
+}
+
+// GraphQLError - errors[] elementi
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions GraphQLErrorExtensions `json:"extensions"`
+}
+
+// GraphQLErrorExtensions - xatolikning barqaror kodi va maydon xatoliklari
+type GraphQLErrorExtensions struct {
+	Code      string                 `json:"code"`
+	RequestID string                 `json:"request_id"`
+	Details   []apperrors.FieldError `json:"details,omitempty"`
+}
+
+// GraphQLExtensions - javobning extensions qismi
+type GraphQLExtensions struct {
+	Cost GraphQLCost `json:"cost"`
+}
+
+// GraphQLCost - so'rovning narxi, chuqurligi va chegaralari
+type GraphQLCost struct {
+	Cost     int `json:"cost"`
+	Depth    int `json:"depth"`
+	MaxCost  int `json:"max_cost"`
+	MaxDepth int `json:"max_depth"`
+}
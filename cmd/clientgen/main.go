@@ -0,0 +1,281 @@
+// clientgen - openapi.Routes dan client paketining endpoint metodlarini generatsiya qiladi.
+// client/client.go dagi go:generate orqali ishga tushadi:
+//
+//	go generate ./client
+//	go run ./cmd/clientgen -o client/endpoints.go -check   # fayl eskirgan bo'lsa 1 bilan chiqadi
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"hrplatform/openapi"
+)
+
+// sharedPackages - mijoz to'g'ridan-to'g'ri import qiladigan paketlar. Boshqa server
+// paketlaridagi turlar (openapi, handlers, notification) client/types.go da shu nom
+// bilan takrorlanadi, shunda mijoz gin va bazaga bog'liq bo'lmaydi.
+var sharedPackages = map[string]bool{
+	"hrplatform/models":             true,
+	"hrplatform/eligibility":        true,
+	"hrplatform/apperrors":          true,
+	"github.com/google/uuid":        true,
+	"github.com/lib/pq":             true,
+	"github.com/jmoiron/sqlx/types": true,
+}
+
+// endpoint - shablon uchun tayyorlangan bitta metod
+type endpoint struct {
+	Name       string
+	Summary    string
+	Method     string // "http.MethodGet"
+	Verb       string // "GET"
+	Route      string
+	PathParams []string
+	PathExpr   string
+	IfMatch    bool
+	Patch      bool
+	BodyType   string
+	Query      []queryField
+	Out        string
+}
+
+type queryField struct {
+	Name  string // json/query nomi: company_id
+	Field string // Go maydoni: CompanyID
+	Type  string // string, int, uuid.UUID
+	Doc   string
+}
+
+type generator struct {
+	imports map[string]bool
+}
+
+func main() {
+	output := flag.String("o", "endpoints.go", "yoziladigan fayl")
+	check := flag.Bool("check", false, "faylni yozmasdan, u eskirgan bo'lsa xatolik bilan chiqish")
+	flag.Parse()
+
+	source, err := generate()
+	if err != nil {
+		log.Fatalf("clientgen: %v", err)
+	}
+
+	if *check {
+		current, err := os.ReadFile(*output)
+		if err != nil || !bytes.Equal(current, source) {
+			log.Fatalf("clientgen: %s is out of date, run go generate ./client", *output)
+		}
+		return
+	}
+	if err := os.WriteFile(*output, source, 0o644); err != nil {
+		log.Fatalf("clientgen: %v", err)
+	}
+}
+
+func generate() ([]byte, error) {
+	g := &generator{imports: map[string]bool{"context": true, "net/http": true}}
+	var endpoints []endpoint
+	for _, route := range openapi.Routes {
+		// hujjat sahifalari API emas, multipart yuklashlar esa client/import.go da qo'lda yozilgan
+		if route.Tag == "docs" || route.Form != nil {
+			continue
+		}
+		endpoints = append(endpoints, g.endpoint(route))
+	}
+
+	// importlar repodagi kabi uch guruhda: standart, hrplatform, tashqi
+	groups := make([][]string, 3)
+	for imp := range g.imports {
+		group := 2
+		if strings.HasPrefix(imp, "hrplatform/") {
+			group = 1
+		} else if !strings.Contains(strings.SplitN(imp, "/", 2)[0], ".") {
+			group = 0
+		}
+		groups[group] = append(groups[group], imp)
+	}
+	for _, group := range groups {
+		sort.Strings(group)
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, map[string]interface{}{"Imports": groups, "Endpoints": endpoints}); err != nil {
+		return nil, err
+	}
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w\n%s", err, buf.Bytes())
+	}
+	return source, nil
+}
+
+func (g *generator) endpoint(route openapi.Route) endpoint {
+	e := endpoint{
+		Name:    route.Name,
+		Summary: route.Summary,
+		Method:  "http.Method" + strings.ToUpper(route.Method[:1]) + strings.ToLower(route.Method[1:]),
+		Verb:    route.Method,
+		Route:   route.Path,
+		IfMatch: route.IfMatch,
+		Patch:   route.Patch,
+		Out:     g.typeName(reflect.TypeOf(route.Response)),
+	}
+
+	// "/users/:id/history" -> "/api/v1/users/" + id.String() + "/history"
+	var parts []string
+	literal := openapi.BasePath
+	for _, segment := range strings.SplitAfter(route.Path, "/") {
+		if strings.HasPrefix(segment, ":") {
+			name := strings.TrimSuffix(segment[1:], "/")
+			e.PathParams = append(e.PathParams, name)
+			parts = append(parts, fmt.Sprintf("%q", literal), name+".String()")
+			g.imports["github.com/google/uuid"] = true
+			literal = strings.TrimPrefix(segment, ":"+name)
+			continue
+		}
+		literal += segment
+	}
+	if literal != "" {
+		parts = append(parts, fmt.Sprintf("%q", literal))
+	}
+	e.PathExpr = strings.Join(parts, " + ")
+
+	switch {
+	case route.Patch:
+		e.BodyType = "interface{}"
+	case route.Body != nil:
+		e.BodyType = g.typeName(reflect.TypeOf(route.Body))
+	}
+
+	for _, query := range route.Query {
+		field := queryField{Name: query.Name, Field: goName(query.Name), Doc: query.Description}
+		switch query.Type {
+		case "integer":
+			field.Type = "int"
+			g.imports["strconv"] = true
+		case "uuid":
+			field.Type = "uuid.UUID"
+			g.imports["github.com/google/uuid"] = true
+		default:
+			field.Type = "string"
+		}
+		e.Query = append(e.Query, field)
+	}
+	if len(e.Query) > 0 {
+		g.imports["net/url"] = true
+	}
+	return e
+}
+
+// typeName Go turining mijoz kodidagi yozilishi: []models.User, CompanyRules
+func (g *generator) typeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Slice:
+		return "[]" + g.typeName(t.Elem())
+	case reflect.Map:
+		return "map[" + g.typeName(t.Key()) + "]" + g.typeName(t.Elem())
+	case reflect.Ptr:
+		return "*" + g.typeName(t.Elem())
+	case reflect.Interface:
+		return "interface{}"
+	}
+	if t.PkgPath() == "" {
+		return t.Name()
+	}
+	if sharedPackages[t.PkgPath()] {
+		g.imports[t.PkgPath()] = true
+		return path.Base(t.PkgPath()) + "." + t.Name()
+	}
+	return t.Name()
+}
+
+// goName snake_case ni Go maydon nomiga aylantiradi: company_id -> CompanyID
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		if word == "id" {
+			b.WriteString("ID")
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+var fileTemplate = template.Must(template.New("endpoints").Parse(`// Code generated by cmd/clientgen from openapi.Routes; DO NOT EDIT.
+
+package client
+
+import (
+{{- range $i, $group := .Imports}}{{if and $i $group}}
+{{end}}
+{{- range $group}}
+	"{{.}}"
+{{- end}}
+{{- end}}
+)
+{{range .Endpoints}}
+{{- if .Query}}
+// {{.Name}}Params - {{.Name}} filtrlari. Nol qiymatli maydonlar yuborilmaydi.
+type {{.Name}}Params struct {
+{{- range .Query}}
+	{{.Field}} {{.Type}} // {{.Doc}}
+{{- end}}
+}
+
+func (p {{.Name}}Params) values() url.Values {
+	values := url.Values{}
+{{- range .Query}}
+{{- if eq .Type "int"}}
+	if p.{{.Field}} != 0 {
+		values.Set("{{.Name}}", strconv.Itoa(p.{{.Field}}))
+	}
+{{- else if eq .Type "uuid.UUID"}}
+	if p.{{.Field}} != uuid.Nil {
+		values.Set("{{.Name}}", p.{{.Field}}.String())
+	}
+{{- else}}
+	if p.{{.Field}} != "" {
+		values.Set("{{.Name}}", p.{{.Field}})
+	}
+{{- end}}
+{{- end}}
+	return values
+}
+{{end}}
+// {{.Name}} - {{.Summary}} ({{.Verb}} {{.Route}})
+func (c *Client) {{.Name}}(ctx context.Context
+{{- range .PathParams}}, {{.}} uuid.UUID{{end}}
+{{- if .IfMatch}}, version int64{{end}}
+{{- if .Patch}}, patch {{.BodyType}}{{else if .BodyType}}, body {{.BodyType}}{{end}}
+{{- if .Query}}, params {{.Name}}Params{{end}}) ({{.Out}}, error) {
+	var out {{.Out}}
+	err := c.do(ctx, request{
+		method: {{.Method}},
+		path:   {{.PathExpr}},
+{{- if .Query}}
+		query:  params.values(),
+{{- end}}
+{{- if .Patch}}
+		body:   patch,
+		patch:  true,
+{{- else if .BodyType}}
+		body:   body,
+{{- end}}
+{{- if .IfMatch}}
+		version: version,
+{{- end}}
+	}, &out)
+	return out, err
+}
+{{end}}`))
@@ -0,0 +1,58 @@
+// contract - repository contract tekshiruvlarini tanlangan omborga qarshi ishga tushiradi.
+//
+//	go run ./cmd/contract --storage=memory
+//	go run ./cmd/contract --storage=postgres   # config.Load dagi DB_* muhit o'zgaruvchilari bilan
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"hrplatform/config"
+	"hrplatform/contract"
+	"hrplatform/memory"
+	"hrplatform/postgres"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	cfg := config.Load()
+	storage := flag.String("storage", "memory", "tekshiriladigan ombor: postgres yoki memory")
+	run := flag.String("run", "", "faqat nomida shu qism-satr bor holatlarni ishga tushirish")
+	flag.Parse()
+
+	var newRepos func() postgres.Repositories
+	switch *storage {
+	case "postgres":
+		db, err := sqlx.Connect("postgres", cfg.DatabaseURL)
+		if err != nil {
+			log.Fatalf("Ma'lumotlar bazasiga ulanishda xatolik: %v", err)
+		}
+		defer db.Close()
+		repos := postgres.NewRepositories(db, cfg.TxMaxAttempts)
+		newRepos = func() postgres.Repositories { return repos }
+	case "memory":
+		// har bir holat toza Store bilan boshlanadi
+		newRepos = memory.NewRepositories
+	default:
+		log.Fatalf("Noma'lum storage %q: postgres yoki memory bo'lishi kerak", *storage)
+	}
+
+	var cases []contract.Case
+	for _, c := range contract.Cases() {
+		if strings.Contains(c.Name, *run) {
+			cases = append(cases, c)
+		}
+	}
+
+	results := contract.Run(context.Background(), cases, newRepos)
+	failed := contract.Report(os.Stdout, *storage, results)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,110 @@
+// import - CSV yoki XLSX faylidagi foydalanuvchi, rezyume va vakansiyalarni ishlab turgan
+// serverga /api/v1/imports/* orqali yuklaydi va qatorlar bo'yicha hisobotni chiqaradi.
+// Birorta qator yozilmasa chiqish kodi 1 bo'ladi.
+//
+//	go run ./cmd/import -entity users -file candidates.xlsx
+//	go run ./cmd/import -entity vacancies -file vacancies.csv -mapping @mapping.json -all-or-nothing
+//	go run ./cmd/import -entity resumes -file resumes.csv -mapping '{"columns": {"user_email": "Email"}}'
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"hrplatform/client"
+	"hrplatform/models"
+)
+
+func main() {
+	baseURL := flag.String("url", "http://localhost:8080", "server manzili")
+	entity := flag.String("entity", "", "yozuv turi: users, resumes yoki vacancies")
+	path := flag.String("file", "", "CSV yoki XLSX fayl")
+	mapping := flag.String("mapping", "", `ustunlar xaritasi JSON i yoki @fayl: {"columns": {"name": "Full name"}, "defaults": {"gender": "f"}}`)
+	batchSize := flag.Int("batch-size", 0, "bitta tranzaksiyadagi qatorlar (0 - server standarti)")
+	allOrNothing := flag.Bool("all-or-nothing", false, "birorta xato qator bo'lsa hech narsa yozilmaydi")
+	locale := flag.String("locale", "", "xatolik matnlari tili: uz, ru yoki en")
+	timeout := flag.Duration("timeout", 5*time.Minute, "so'rov uchun muddat")
+	asJSON := flag.Bool("json", false, "hisobotni JSON ko'rinishida chiqarish")
+	flag.Parse()
+
+	api := client.New(*baseURL, client.WithLocale(*locale), client.WithHTTPClient(&http.Client{Timeout: *timeout}))
+	imports := map[string]func(context.Context, io.Reader, client.ImportOptions) (models.ImportReport, error){
+		"users":     api.ImportUsers,
+		"resumes":   api.ImportResumes,
+		"vacancies": api.ImportVacancies,
+	}
+	upload, ok := imports[*entity]
+	if !ok || *path == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := client.ImportOptions{Filename: filepath.Base(*path), BatchSize: *batchSize, AllOrNothing: *allOrNothing}
+	if *mapping != "" {
+		spec, err := readMapping(*mapping)
+		if err != nil {
+			log.Fatalf("import: mapping: %v", err)
+		}
+		opts.Mapping = spec
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		log.Fatalf("import: %v", err)
+	}
+	defer file.Close()
+
+	report, err := upload(context.Background(), file, opts)
+	if err != nil {
+		log.Fatalf("import: %v", err)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(report)
+	} else {
+		printReport(report)
+	}
+	if report.Failed > 0 || report.RolledBack {
+		os.Exit(1)
+	}
+}
+
+// readMapping JSON ni to'g'ridan-to'g'ri yoki "@fayl" dan o'qiydi
+func readMapping(value string) (*client.ImportMapping, error) {
+	data := []byte(value)
+	if name, ok := strings.CutPrefix(value, "@"); ok {
+		var err error
+		if data, err = os.ReadFile(name); err != nil {
+			return nil, err
+		}
+	}
+	var mapping client.ImportMapping
+	if err := json.Unmarshal(data, &mapping); err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+func printReport(report models.ImportReport) {
+	fmt.Printf("%s: %d rows, %d created, %d failed, %d batches\n", report.Entity, report.Total, report.Created, report.Failed, report.Batches)
+	if report.RolledBack {
+		fmt.Println("all-or-nothing: nothing was written")
+	}
+	for _, rowErr := range report.Errors {
+		fmt.Printf("row %d: %s (%s)\n", rowErr.Row, rowErr.Message, rowErr.Code)
+		for _, detail := range rowErr.Details {
+			fmt.Printf("  %s: %s\n", detail.Field, detail.Message)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// integration - butun HTTP API ni haqiqiy router orqali uchidan-uchigacha tekshiradi.
+// Postgres rejimida vaqtinchalik baza ko'tariladi (INTEGRATION_DATABASE_URL yoki PG_BIN),
+// migratsiyalar qo'llanadi va oxirida baza o'chiriladi.
+//
+//	go run ./cmd/integration
+//	go run ./cmd/integration --storage=memory --run=users/
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"hrplatform/contract"
+	"hrplatform/integration"
+	"hrplatform/memory"
+	"hrplatform/postgres"
+
+	"github.com/gin-gonic/gin"
+)
+
+func main() {
+	storage := flag.String("storage", "postgres", "ombor: postgres (vaqtinchalik baza) yoki memory")
+	run := flag.String("run", "", "faqat nomida shu qism-satr bor stsenariylarni ishga tushirish")
+	verbose := flag.Bool("v", false, "gin so'rov loglarini chiqarish")
+	flag.Parse()
+
+	if !*verbose {
+		gin.SetMode(gin.ReleaseMode)
+		gin.DefaultWriter = io.Discard
+	}
+	os.Exit(runCases(*storage, *run))
+}
+
+// runCases stsenariylarni bajaradi va muvaffaqiyatsizlar sonini qaytaradi. os.Exit dan oldin
+// deferlar (server va bazani yopish) ishlashi uchun alohida funksiyada.
+func runCases(storage, run string) int {
+	var repos postgres.Repositories
+	switch storage {
+	case "postgres":
+		db, err := integration.StartPostgres(context.Background())
+		if err != nil {
+			log.Fatalf("Vaqtinchalik Postgres ni ishga tushirishda xatolik: %v", err)
+		}
+		defer db.Close()
+		repos = postgres.NewRepositories(db.DB, 5)
+	case "memory":
+		repos = memory.NewRepositories()
+	default:
+		log.Fatalf("Noma'lum storage %q: postgres yoki memory bo'lishi kerak", storage)
+	}
+
+	server, err := integration.NewServer(repos)
+	if err != nil {
+		log.Fatalf("Serverni ishga tushirishda xatolik: %v", err)
+	}
+	defer server.Close()
+
+	var cases []integration.Case
+	all := append(integration.Cases(), integration.DocsCases(server.Routes)...)
+	all = append(all, integration.GRPCCases(server.GRPCAddr)...)
+	all = append(all, integration.GraphQLCases(server.Batches)...)
+	all = append(all, integration.ExpandCases(server.Batches)...)
+	for _, c := range all {
+		if strings.Contains(c.Name, run) {
+			cases = append(cases, c)
+		}
+	}
+
+	results := integration.Run(server.URL, cases)
+	if contract.Report(os.Stdout, storage, results) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -3,6 +3,9 @@ package config
 import (
     "log"  
     "os"   
+    "strconv"
+    "strings"
+    "time"
 
     "github.com/joho/godotenv" // .env fayllarini o'qish uchun
 )
@@ -10,7 +13,49 @@ import (
 // Konfiguratsiya uchun struct (tuzilma)
 type Config struct {
     HTTPPort    string 
+    GRPCPort    string // bo'sh bo'lsa gRPC server ishga tushirilmaydi
     DatabaseURL string 
+    Storage     string // "postgres" yoki "memory", --storage flagi ustun turadi
+
+    WebhookMaxAttempts int           // webhookni yuborish uchun maksimal urinishlar soni
+    WebhookBaseDelay   time.Duration // qayta urinishlar orasidagi boshlang'ich kutish vaqti
+    WebhookTimeout     time.Duration // bitta so'rov uchun timeout
+
+    NotifyDriver      string // "smtp", "file" yoki "log"
+    NotifyOutboxDir   string // file driver uchun papka
+    NotifyMaxAttempts int
+    NotifyWorkers     int
+    NotifyQueueSize   int
+    SMTPHost          string
+    SMTPPort          string
+    SMTPUser          string
+    SMTPPassword      string
+    SMTPFrom          string
+
+    SMSDriver             string // "eskiz", "log" yoki bo'sh (o'chirilgan)
+    EskizBaseURL          string
+    EskizToken            string
+    EskizFrom             string
+    TelegramDriver        string // "bot", "log" yoki bo'sh (o'chirilgan)
+    TelegramBotToken      string
+    TelegramBotName       string
+    TelegramWebhookSecret string
+
+    RemindersEnabled bool          // eslatma workerini shu jarayonda ishga tushirish
+    ReminderInterval time.Duration // yaqinlashayotgan intervyularni tekshirish oralig'i
+
+    IdempotencyTTL time.Duration // Idempotency-Key javoblari qancha vaqt saqlanadi
+
+    RequestTimeout time.Duration            // bitta so'rov (va uning SQL so'rovlari) uchun standart muddat
+    RouteTimeouts  map[string]time.Duration // "METHOD /route" bo'yicha alohida muddatlar
+
+    TxMaxAttempts int // serialization failure bo'lganda tranzaksiyani necha marta bajarish
+
+    GraphQLMaxCost  int // /graphql so'rovining ruxsat etilgan eng katta narxi
+    GraphQLMaxDepth int // /graphql so'rovidagi ichma-ich yozuvlarning eng katta soni
+
+    LegacyAPIDeprecated time.Time // prefikssiz yo'llar eskirgan deb e'lon qilingan sana (Deprecation)
+    LegacyAPISunset     time.Time // prefikssiz yo'llar olib tashlanadigan sana (Sunset)
 }
 
 // Konfiguratsiyani yuklaydigan funksiya
@@ -23,7 +68,50 @@ func Load() *Config {
     // Konfiguratsiyani qaytaradi
     return &Config{
         HTTPPort:    getEnv("HTTP_PORT", "8080"), // HTTP_PORT ozgaruvchisini oladi, agar bo'lmasa 8080 qaytaradi
+        GRPCPort:    getEnv("GRPC_PORT", "9090"),
         DatabaseURL: getDatabaseURL(), // malumotlar bazasi URLini yaratadi
+        Storage:     getEnv("STORAGE", "postgres"),
+
+        WebhookMaxAttempts: getEnvInt("WEBHOOK_MAX_ATTEMPTS", 5),
+        WebhookBaseDelay:   getEnvDuration("WEBHOOK_BASE_DELAY", 2*time.Second),
+        WebhookTimeout:     getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+
+        NotifyDriver:      getEnv("NOTIFY_DRIVER", "log"),
+        NotifyOutboxDir:   getEnv("NOTIFY_OUTBOX_DIR", "outbox"),
+        NotifyMaxAttempts: getEnvInt("NOTIFY_MAX_ATTEMPTS", 3),
+        NotifyWorkers:     getEnvInt("NOTIFY_WORKERS", 2),
+        NotifyQueueSize:   getEnvInt("NOTIFY_QUEUE_SIZE", 1000),
+        SMTPHost:          getEnv("SMTP_HOST", "localhost"),
+        SMTPPort:          getEnv("SMTP_PORT", "25"),
+        SMTPUser:          getEnv("SMTP_USER", ""),
+        SMTPPassword:      getEnv("SMTP_PASSWORD", ""),
+        SMTPFrom:          getEnv("SMTP_FROM", "no-reply@hrplatform.uz"),
+
+        SMSDriver:             getEnv("SMS_DRIVER", ""),
+        EskizBaseURL:          getEnv("ESKIZ_BASE_URL", "https://notify.eskiz.uz"),
+        EskizToken:            getEnv("ESKIZ_TOKEN", ""),
+        EskizFrom:             getEnv("ESKIZ_FROM", "4546"),
+        TelegramDriver:        getEnv("TELEGRAM_DRIVER", ""),
+        TelegramBotToken:      getEnv("TELEGRAM_BOT_TOKEN", ""),
+        TelegramBotName:       getEnv("TELEGRAM_BOT_NAME", ""),
+        TelegramWebhookSecret: getEnv("TELEGRAM_WEBHOOK_SECRET", ""),
+
+        RemindersEnabled: getEnv("REMINDERS_ENABLED", "true") == "true",
+        ReminderInterval: getEnvDuration("REMINDER_INTERVAL", time.Minute),
+
+        IdempotencyTTL: getEnvDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+
+        RequestTimeout: getEnvDuration("REQUEST_TIMEOUT", 15*time.Second),
+        // masalan ROUTE_TIMEOUTS="GET /users/duplicates=1m,POST /users/merge=30s"
+        RouteTimeouts: getEnvDurations("ROUTE_TIMEOUTS", "GET /users/duplicates=1m,POST /users/merge=30s,POST /imports/users=2m,POST /imports/resumes=2m,POST /imports/vacancies=2m"),
+
+        TxMaxAttempts: getEnvInt("TX_MAX_ATTEMPTS", 3),
+
+        GraphQLMaxCost:  getEnvInt("GRAPHQL_MAX_COST", 1000),
+        GraphQLMaxDepth: getEnvInt("GRAPHQL_MAX_DEPTH", 6),
+
+        LegacyAPIDeprecated: getEnvDate("LEGACY_API_DEPRECATED", "2026-10-19"),
+        LegacyAPISunset:     getEnvDate("LEGACY_API_SUNSET", "2027-04-19"),
     }
 }
 
@@ -35,6 +123,53 @@ func getEnv(key, defaultValue string) string {
     return defaultValue // yoqsa, standart qiymatni qaytaradi
 }
 
+// envdagi butun sonli o'zgaruvchini oladi, noto'g'ri bo'lsa standart qiymatni qaytaradi
+func getEnvInt(key string, defaultValue int) int {
+    value, err := strconv.Atoi(getEnv(key, ""))
+    if err != nil {
+        return defaultValue
+    }
+    return value
+}
+
+// envdagi davomiylikni (masalan "5s", "1m") oladi
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+    value, err := time.ParseDuration(getEnv(key, ""))
+    if err != nil {
+        return defaultValue
+    }
+    return value
+}
+
+// envdagi sanani (YYYY-MM-DD, UTC) oladi, noto'g'ri bo'lsa standart qiymatni qaytaradi
+func getEnvDate(key, defaultValue string) time.Time {
+    date, err := time.Parse(time.DateOnly, getEnv(key, defaultValue))
+    if err != nil {
+        log.Printf("%s: noto'g'ri sana, %s ishlatiladi: %v", key, defaultValue, err)
+        date, _ = time.Parse(time.DateOnly, defaultValue)
+    }
+    return date
+}
+
+// envdagi "kalit=davomiylik" juftliklarini vergul bilan ajratilgan ro'yxatdan o'qiydi.
+// Noto'g'ri yozilgan juftliklar tashlab ketiladi.
+func getEnvDurations(key, defaultValue string) map[string]time.Duration {
+    durations := make(map[string]time.Duration)
+    for _, pair := range strings.Split(getEnv(key, defaultValue), ",") {
+        name, value, ok := strings.Cut(pair, "=")
+        if !ok {
+            continue
+        }
+        duration, err := time.ParseDuration(strings.TrimSpace(value))
+        if err != nil {
+            log.Printf("%s: %q uchun noto'g'ri davomiylik: %v", key, name, err)
+            continue
+        }
+        durations[strings.TrimSpace(name)] = duration
+    }
+    return durations
+}
+
 // Malumotlar bazasi URLini yaratadigan funksiya
 func getDatabaseURL() string {
     // Malumotlar bazasi uchun kerakli o'zgaruvchilarni oladi yoki standart qiymatlardan foydalanadi
@@ -0,0 +1,92 @@
+package contract
+
+import (
+	"context"
+	"strings"
+
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+// batchCases GraphQL loaderlari ishlatadigan "ByIDs" metodlarini tekshiradi: bir nechta kalit
+// bitta chaqiruvda olinadi, topilmagan kalitlar xatoliksiz o'tkazib yuboriladi.
+var batchCases = []Case{
+	{Name: "batch/companies, vacancies and recruiters by ids", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		otherCompany := newCompany(ctx, t, repos)
+		elsewhere := newCompany(ctx, t, repos)
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 0)
+		otherVacancy := newVacancy(ctx, t, repos, otherCompany.ID, "Backend", 0)
+		skipped := newVacancy(ctx, t, repos, elsewhere.ID, "Backend", 0)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+		otherRecruiter := newRecruiter(ctx, t, repos, elsewhere.ID, "1988-03-04T00:00:00Z", "m")
+
+		companies, err := repos.Companies.GetCompaniesByIDs(ctx, []uuid.UUID{company.ID, otherCompany.ID, uuid.New()})
+		must(t, err, "GetCompaniesByIDs")
+		ExpectEqual(t, len(companies), 2, "companies found")
+		ExpectMembers(t, companyIDs(companies), []uuid.UUID{company.ID, otherCompany.ID}, []uuid.UUID{elsewhere.ID}, "GetCompaniesByIDs")
+
+		vacancies, err := repos.Vacancies.GetVacanciesByIDs(ctx, []uuid.UUID{vacancy.ID, uuid.New()})
+		must(t, err, "GetVacanciesByIDs")
+		ExpectEqual(t, len(vacancies), 1, "vacancies found")
+
+		vacancies, err = repos.Vacancies.GetVacanciesByCompanyIDs(ctx, []uuid.UUID{company.ID, otherCompany.ID})
+		must(t, err, "GetVacanciesByCompanyIDs")
+		ExpectMembers(t, vacancyIDs(vacancies), []uuid.UUID{vacancy.ID, otherVacancy.ID}, []uuid.UUID{skipped.ID}, "GetVacanciesByCompanyIDs")
+
+		recruiters, err := repos.Recruiters.GetRecruitersByIDs(ctx, []uuid.UUID{otherRecruiter.ID})
+		must(t, err, "GetRecruitersByIDs")
+		ExpectMembers(t, recruiterIDs(recruiters), []uuid.UUID{otherRecruiter.ID}, []uuid.UUID{recruiter.ID}, "GetRecruitersByIDs")
+
+		recruiters, err = repos.Recruiters.GetRecruitersByCompanyIDs(ctx, []uuid.UUID{company.ID})
+		must(t, err, "GetRecruitersByCompanyIDs")
+		ExpectMembers(t, recruiterIDs(recruiters), []uuid.UUID{recruiter.ID}, []uuid.UUID{otherRecruiter.ID}, "GetRecruitersByCompanyIDs")
+
+		companies, err = repos.Companies.GetCompaniesByIDs(ctx, nil)
+		must(t, err, "GetCompaniesByIDs empty")
+		ExpectEqual(t, len(companies), 0, "companies for no ids")
+	}},
+	{Name: "batch/users, resumes and interviews by ids", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 0)
+		otherVacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 0)
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		otherUser := newUser(ctx, t, repos, "1991-01-01", "f")
+		resume := newResume(ctx, t, repos, user.ID, "Backend", 3)
+		otherResume := newResume(ctx, t, repos, otherUser.ID, "Backend", 1)
+		interview := newInterview(ctx, t, repos, user.ID, vacancy.ID, recruiter.ID)
+		otherInterview := newInterview(ctx, t, repos, otherUser.ID, otherVacancy.ID, recruiter.ID)
+
+		users, err := repos.Users.GetUsersByIDs(ctx, []uuid.UUID{user.ID, otherUser.ID, uuid.New()})
+		must(t, err, "GetUsersByIDs")
+		ExpectMembers(t, userIDs(users), []uuid.UUID{user.ID, otherUser.ID}, nil, "GetUsersByIDs")
+
+		// email katta-kichik harfni farqlamasdan solishtiriladi
+		users, err = repos.Users.GetUsersByEmails(ctx, []string{strings.ToUpper(user.Email), " " + otherUser.Email, "nobody@example.com"})
+		must(t, err, "GetUsersByEmails")
+		ExpectMembers(t, userIDs(users), []uuid.UUID{user.ID, otherUser.ID}, nil, "GetUsersByEmails")
+
+		resumes, err := repos.Resumes.GetResumesByUserIDs(ctx, []uuid.UUID{user.ID})
+		must(t, err, "GetResumesByUserIDs")
+		ids := make([]uuid.UUID, len(resumes))
+		for i, r := range resumes {
+			ids[i] = r.ID
+		}
+		ExpectMembers(t, ids, []uuid.UUID{resume.ID}, []uuid.UUID{otherResume.ID}, "GetResumesByUserIDs")
+
+		interviews, err := repos.Interviews.GetInterviewsByVacancyIDs(ctx, []uuid.UUID{otherVacancy.ID})
+		must(t, err, "GetInterviewsByVacancyIDs")
+		ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{otherInterview.ID}, []uuid.UUID{interview.ID}, "GetInterviewsByVacancyIDs")
+
+		interviews, err = repos.Interviews.GetInterviewsByUserIDs(ctx, []uuid.UUID{user.ID, otherUser.ID})
+		must(t, err, "GetInterviewsByUserIDs")
+		ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{interview.ID, otherInterview.ID}, nil, "GetInterviewsByUserIDs")
+
+		must(t, repos.Interviews.DeleteInterview(ctx, interview.ID), "DeleteInterview")
+		interviews, err = repos.Interviews.GetInterviewsByUserIDs(ctx, []uuid.UUID{user.ID})
+		must(t, err, "GetInterviewsByUserIDs after delete")
+		ExpectMembers(t, interviewIDs(interviews), nil, []uuid.UUID{interview.ID}, "GetInterviewsByUserIDs after delete")
+	}},
+}
@@ -0,0 +1,74 @@
+package contract
+
+import (
+	"context"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+var companyCases = []Case{
+	{Name: "companies/create, get and list", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+
+		got, err := repos.Companies.GetCompanyByID(ctx, company.ID.String())
+		must(t, err, "GetCompanyByID")
+		ExpectEqual(t, got.Name, company.Name, "name")
+		ExpectEqual(t, got.Workers, 10, "workers")
+
+		companies, err := repos.Companies.GetAllCompanies(ctx)
+		must(t, err, "GetAllCompanies")
+		ExpectMembers(t, companyIDs(companies), []uuid.UUID{company.ID}, nil, "GetAllCompanies")
+
+		_, err = repos.Companies.GetCompanyByID(ctx, uuid.NewString())
+		ExpectCode(t, err, apperrors.KindNotFound, "company_not_found", "GetCompanyByID unknown")
+		_, err = repos.Companies.GetCompanyByID(ctx, "not-a-uuid")
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_id", "GetCompanyByID malformed")
+	}},
+	{Name: "companies/update checks version", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+
+		update := company.ToUpdate()
+		update.Workers = 25
+		update.Version = company.Version
+		must(t, repos.Companies.UpdateCompany(ctx, update), "UpdateCompany")
+		err := repos.Companies.UpdateCompany(ctx, update)
+		ExpectCode(t, err, apperrors.KindPreconditionFailed, "version_mismatch", "stale UpdateCompany")
+
+		got, err := repos.Companies.GetCompanyByID(ctx, company.ID.String())
+		must(t, err, "GetCompanyByID")
+		ExpectEqual(t, got.Workers, 25, "workers")
+		ExpectEqual(t, got.Version, company.Version+1, "version")
+	}},
+	{Name: "companies/soft delete", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		must(t, repos.Companies.DeleteCompany(ctx, company.ID.String()), "DeleteCompany")
+
+		companies, err := repos.Companies.GetAllCompanies(ctx)
+		must(t, err, "GetAllCompanies")
+		ExpectMembers(t, companyIDs(companies), nil, []uuid.UUID{company.ID}, "GetAllCompanies")
+
+		got, err := repos.Companies.GetCompanyByID(ctx, company.ID.String())
+		must(t, err, "GetCompanyByID deleted")
+		if got.DeletedAt == 0 {
+			t.Errorf("GetCompanyByID deleted: deleted_at is not set")
+		}
+
+		// o'chirilgan kompaniyani yangilab bo'lmaydi, hatto joriy versiya bilan ham
+		update := got.ToUpdate()
+		update.Version = got.Version
+		err = repos.Companies.UpdateCompany(ctx, update)
+		ExpectCode(t, err, apperrors.KindPreconditionFailed, "version_mismatch", "UpdateCompany deleted")
+	}},
+}
+
+func companyIDs(companies []models.Company) []uuid.UUID {
+	ids := make([]uuid.UUID, len(companies))
+	for i, company := range companies {
+		ids[i] = company.ID
+	}
+	return ids
+}
@@ -0,0 +1,292 @@
+// Package contract - postgres paketidagi repository interfeyslari uchun umumiy tekshiruvlar to'plami.
+// Har bir amalga oshirish (Postgres va memory) bir xil Cases ni o'tishi shart:
+//
+//	go run ./cmd/contract --storage=memory
+//	go run ./cmd/contract --storage=postgres
+//
+// Holatlar umumiy bazada parallel ishlashi mumkin: har biri o'z yozuvlarini yaratadi
+// (unikal email va telefonlar bilan) va ro'yxatlarda faqat o'z yozuvlarini tekshiradi.
+package contract
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+// T - testing.T ning holatlar ishlatadigan qismi. Runner va kelajakdagi _test.go fayllar uchun.
+type T interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	FailNow()
+}
+
+// Case - bitta tekshiruv. Run faqat repos orqali ishlaydi va boshqa holatlarga bog'liq emas.
+type Case struct {
+	Name string
+	Run  func(ctx context.Context, t T, repos postgres.Repositories)
+}
+
+// Cases - barcha tekshiruvlar, jadvallar bo'yicha guruhlangan
+func Cases() []Case {
+	var cases []Case
+	cases = append(cases, userCases...)
+	cases = append(cases, resumeCases...)
+	cases = append(cases, recruiterCases...)
+	cases = append(cases, companyCases...)
+	cases = append(cases, vacancyCases...)
+	cases = append(cases, interviewCases...)
+	cases = append(cases, batchCases...)
+	return cases
+}
+
+// Result - Run natijasi, Errors bo'sh bo'lsa holat o'tgan
+type Result struct {
+	Name     string
+	Errors   []string
+	Duration time.Duration
+}
+
+func (r Result) Passed() bool {
+	return len(r.Errors) == 0
+}
+
+// Run holatlarni ketma-ket bajaradi. newRepos har bir holat uchun chaqiriladi: memory uchun
+// har safar toza Store, Postgres uchun esa bitta bazaga ulangan repositorylar qaytarilishi mumkin.
+func Run(ctx context.Context, cases []Case, newRepos func() postgres.Repositories) []Result {
+	results := make([]Result, 0, len(cases))
+	for _, c := range cases {
+		c := c
+		results = append(results, Check(c.Name, func(t T) { c.Run(ctx, t, newRepos()) }))
+	}
+	return results
+}
+
+// Check bitta tekshiruvni bajaradi va natijasini qaytaradi. FailNow runtime.Goexit qiladi,
+// shuning uchun fn alohida goroutine da ishlaydi; panic ham xatolik sifatida yoziladi.
+// integration paketi HTTP stsenariylari uchun ham shu funksiyani ishlatadi.
+func Check(name string, fn func(t T)) Result {
+	started := time.Now()
+	rt := &runner{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if p := recover(); p != nil {
+				rt.Errorf("panic: %v", p)
+			}
+		}()
+		fn(rt)
+	}()
+	<-done
+	return Result{Name: name, Errors: rt.errors, Duration: time.Since(started)}
+}
+
+// Report natijalarni PASS/FAIL qatorlari va yakuniy hisob bilan w ga yozadi hamda
+// muvaffaqiyatsiz holatlar sonini qaytaradi
+func Report(w io.Writer, label string, results []Result) int {
+	failed := 0
+	for _, result := range results {
+		if result.Passed() {
+			fmt.Fprintf(w, "PASS %s (%s)\n", result.Name, result.Duration)
+			continue
+		}
+		failed++
+		fmt.Fprintf(w, "FAIL %s (%s)\n", result.Name, result.Duration)
+		for _, msg := range result.Errors {
+			fmt.Fprintf(w, "    %s\n", msg)
+		}
+	}
+	fmt.Fprintf(w, "%s: %d/%d passed\n", label, len(results)-failed, len(results))
+	return failed
+}
+
+type runner struct {
+	mu     sync.Mutex
+	errors []string
+}
+
+func (r *runner) Helper() {}
+
+func (r *runner) Errorf(format string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *runner) FailNow() {
+	r.Errorf("FailNow")
+	runtime.Goexit()
+}
+
+// must xatolik bo'lsa holatni to'xtatadi
+func must(t T, err error, what string) {
+	t.Helper()
+	if err != nil {
+		t.Errorf("%s: unexpected error: %v", what, err)
+		t.FailNow()
+	}
+}
+
+// ExpectCode xatolik berilgan turdagi va kodli domen xatoligi ekanini tekshiradi
+func ExpectCode(t T, err error, kind apperrors.Kind, code, what string) {
+	t.Helper()
+	var appErr *apperrors.Error
+	if !errors.As(err, &appErr) {
+		t.Errorf("%s: expected %s/%s, got %v", what, kind, code, err)
+		return
+	}
+	if appErr.Kind != kind || appErr.Code != code {
+		t.Errorf("%s: expected %s/%s, got %s/%s", what, kind, code, appErr.Kind, appErr.Code)
+	}
+}
+
+// ExpectEqual taqqoslanadigan qiymatlarni == bilan solishtiradi
+func ExpectEqual(t T, got, want interface{}, what string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("%s: got %v, want %v", what, got, want)
+	}
+}
+
+// ExpectMembers ids ro'yxatda bo'lishi yoki bo'lmasligini tekshiradi; ro'yxatdagi boshqa yozuvlar
+// (parallel holatlar yoki avvalgi ishga tushirishlardan qolganlar) e'tiborga olinmaydi.
+func ExpectMembers(t T, got []uuid.UUID, present, absent []uuid.UUID, what string) {
+	t.Helper()
+	seen := make(map[uuid.UUID]bool, len(got))
+	for _, id := range got {
+		seen[id] = true
+	}
+	for _, id := range present {
+		if !seen[id] {
+			t.Errorf("%s: expected %s in result", what, id)
+		}
+	}
+	for _, id := range absent {
+		if seen[id] {
+			t.Errorf("%s: did not expect %s in result", what, id)
+		}
+	}
+}
+
+// Quyidagi yordamchilar har chaqiriqda yangi, boshqa holatlar bilan to'qnashmaydigan yozuv yaratadi
+
+var (
+	randMu sync.Mutex
+	random = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// UniquePhone tasodifiy O'zbekiston raqamini "+998 9X XXX XX XX" ko'rinishida qaytaradi
+func UniquePhone() string {
+	randMu.Lock()
+	defer randMu.Unlock()
+	n := random.Intn(100000000)
+	return fmt.Sprintf("+998 9%d %03d %02d %02d", n/10000000, n/10000%1000, n/100%100, n%100)
+}
+
+// UniqueEmail boshqa holatlar va avvalgi ishga tushirishlar bilan to'qnashmaydigan email
+func UniqueEmail(prefix string) string {
+	return prefix + "-" + strings.ReplaceAll(uuid.NewString(), "-", "") + "@contract.test"
+}
+
+// UniqueName ism bo'yicha dublikatlar guruhiga boshqa holatlar tushib qolmasligi uchun
+func UniqueName(prefix string) string {
+	return prefix + " " + uuid.NewString()[:8]
+}
+
+func newUser(ctx context.Context, t T, repos postgres.Repositories, birthday, gender string) models.User {
+	t.Helper()
+	user, err := repos.Users.CreateUser(ctx, models.UserCreate{
+		Name:        UniqueName("User"),
+		Email:       UniqueEmail("user"),
+		PhoneNumber: UniquePhone(),
+		Birthday:    birthday,
+		Gender:      gender,
+	})
+	must(t, err, "CreateUser")
+	return user
+}
+
+func newCompany(ctx context.Context, t T, repos postgres.Repositories) models.Company {
+	t.Helper()
+	company, err := repos.Companies.CreateCompany(ctx, models.CreateCompany{
+		Name:     UniqueName("Company"),
+		Location: "Tashkent",
+		Workers:  10,
+	})
+	must(t, err, "CreateCompany")
+	return company
+}
+
+func newRecruiter(ctx context.Context, t T, repos postgres.Repositories, companyID uuid.UUID, birthday, gender string) models.Recruiter {
+	t.Helper()
+	recruiter, err := repos.Recruiters.CreateRecruiter(ctx, models.CreateRecruiter{
+		Name:        UniqueName("Recruiter"),
+		Email:       UniqueEmail("recruiter"),
+		PhoneNumber: UniquePhone(),
+		Birthday:    birthday,
+		Gender:      gender,
+		CompanyID:   companyID,
+	})
+	must(t, err, "CreateRecruiter")
+	return recruiter
+}
+
+func newVacancy(ctx context.Context, t T, repos postgres.Repositories, companyID uuid.UUID, position string, minExp int) models.Vacancy {
+	t.Helper()
+	vacancy, err := repos.Vacancies.CreateVacancy(ctx, models.CreateVacancy{
+		Name:        UniqueName("Vacancy"),
+		Position:    position,
+		MinExp:      minExp,
+		CompanyID:   companyID,
+		Description: "contract",
+	})
+	must(t, err, "CreateVacancy")
+	return vacancy
+}
+
+func newResume(ctx context.Context, t T, repos postgres.Repositories, userID uuid.UUID, position string, experience int) models.Resume {
+	t.Helper()
+	resume, err := repos.Resumes.CreateResume(ctx, models.CreateResume{
+		Position:    position,
+		Experience:  experience,
+		Description: "contract",
+		UserID:      userID,
+	})
+	must(t, err, "CreateResume")
+	return resume
+}
+
+func newInterview(ctx context.Context, t T, repos postgres.Repositories, userID, vacancyID, recruiterID uuid.UUID) models.Interview {
+	t.Helper()
+	interview, err := repos.Interviews.CreateInterview(ctx, models.CreateInterview{
+		UserID:        userID,
+		VacancyID:     vacancyID,
+		RecruiterID:   recruiterID,
+		InterviewDate: "2030-01-02 10:00:00",
+	})
+	must(t, err, "CreateInterview")
+	return interview
+}
+
+// uniquePosition filtrlar faqat shu holat yozuvlarini topishi uchun lavozim nomiga qo'shimcha qo'shadi
+func uniquePosition(position string) string {
+	return position + " " + uuid.NewString()[:8]
+}
+
+// birthdayForAge bugundan aynan age yil oldingi (va bir kun oldingi) sana, YYYY-MM-DD
+func birthdayForAge(age int) string {
+	return time.Now().AddDate(-age, 0, -1).Format("2006-01-02")
+}
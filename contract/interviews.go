@@ -0,0 +1,119 @@
+package contract
+
+import (
+	"context"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+var interviewCases = []Case{
+	{Name: "interviews/create and get", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 0)
+		interview := newInterview(ctx, t, repos, user.ID, vacancy.ID, recruiter.ID)
+
+		got, err := repos.Interviews.GetInterviewByID(ctx, interview.ID)
+		must(t, err, "GetInterviewByID")
+		ExpectEqual(t, got.InterviewDate.Format("2006-01-02 15:04:05"), "2030-01-02 10:00:00", "interview_date")
+
+		interviews, err := repos.Interviews.GetInterviewsByUserID(ctx, user.ID)
+		must(t, err, "GetInterviewsByUserID")
+		ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{interview.ID}, nil, "by user")
+
+		interviews, err = repos.Users.GetUserInterviews(ctx, user.ID)
+		must(t, err, "GetUserInterviews")
+		ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{interview.ID}, nil, "user interviews")
+
+		_, err = repos.Interviews.GetInterviewByID(ctx, uuid.New())
+		ExpectCode(t, err, apperrors.KindNotFound, "interview_not_found", "GetInterviewByID unknown")
+	}},
+	{Name: "interviews/invalid date and references", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 0)
+
+		_, err := repos.Interviews.CreateInterview(ctx, models.CreateInterview{
+			UserID: user.ID, VacancyID: vacancy.ID, RecruiterID: recruiter.ID, InterviewDate: "2030-01-02T10:00:00Z",
+		})
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_date", "CreateInterview RFC 3339 date")
+
+		_, err = repos.Interviews.CreateInterview(ctx, models.CreateInterview{
+			UserID: uuid.New(), VacancyID: vacancy.ID, RecruiterID: recruiter.ID, InterviewDate: "2030-01-02 10:00:00",
+		})
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_reference", "CreateInterview unknown user")
+
+		_, err = repos.Interviews.CreateInterview(ctx, models.CreateInterview{
+			UserID: user.ID, VacancyID: vacancy.ID, RecruiterID: uuid.New(), InterviewDate: "2030-01-02 10:00:00",
+		})
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_reference", "CreateInterview unknown recruiter")
+	}},
+	{Name: "interviews/filter by company, position and experience", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		otherCompany := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+		otherRecruiter := newRecruiter(ctx, t, repos, otherCompany.ID, "1988-03-04T00:00:00Z", "m")
+		position := uniquePosition("QA Engineer")
+		vacancy := newVacancy(ctx, t, repos, company.ID, position, 0)
+		otherVacancy := newVacancy(ctx, t, repos, company.ID, "Designer", 0)
+
+		experienced := newUser(ctx, t, repos, "1990-01-01", "m")
+		newResume(ctx, t, repos, experienced.ID, "QA", 6)
+		beginner := newUser(ctx, t, repos, "1995-01-01", "f")
+		newResume(ctx, t, repos, beginner.ID, "QA", 1)
+
+		matching := newInterview(ctx, t, repos, experienced.ID, vacancy.ID, recruiter.ID)
+		tooJunior := newInterview(ctx, t, repos, beginner.ID, vacancy.ID, recruiter.ID)
+		wrongPosition := newInterview(ctx, t, repos, experienced.ID, otherVacancy.ID, recruiter.ID)
+		wrongCompany := newInterview(ctx, t, repos, experienced.ID, vacancy.ID, otherRecruiter.ID)
+
+		interviews, err := repos.Interviews.GetAllInterviews(ctx, map[string]interface{}{"company_id": company.ID})
+		must(t, err, "GetAllInterviews company")
+		ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{matching.ID, tooJunior.ID, wrongPosition.ID}, []uuid.UUID{wrongCompany.ID}, "company")
+
+		interviews, err = repos.Interviews.GetAllInterviews(ctx, map[string]interface{}{
+			"company_id": company.ID,
+			"position":   position[3:],
+			"experience": 5,
+		})
+		must(t, err, "GetAllInterviews company+position+experience")
+		ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{matching.ID}, []uuid.UUID{tooJunior.ID, wrongPosition.ID, wrongCompany.ID}, "company+position+experience")
+	}},
+	{Name: "interviews/update and delete", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 0)
+		interview := newInterview(ctx, t, repos, user.ID, vacancy.ID, recruiter.ID)
+
+		update := interview.ToUpdate()
+		update.InterviewDate = "2030-02-03 11:30:00"
+		update.Version = interview.Version
+		must(t, repos.Interviews.UpdateInterview(ctx, update), "UpdateInterview")
+		err := repos.Interviews.UpdateInterview(ctx, update)
+		ExpectCode(t, err, apperrors.KindPreconditionFailed, "version_mismatch", "stale UpdateInterview")
+
+		got, err := repos.Interviews.GetInterviewByID(ctx, interview.ID)
+		must(t, err, "GetInterviewByID")
+		ExpectEqual(t, got.InterviewDate.Format("2006-01-02 15:04:05"), "2030-02-03 11:30:00", "interview_date")
+		ExpectEqual(t, got.Version, interview.Version+1, "version")
+
+		must(t, repos.Interviews.DeleteInterview(ctx, interview.ID), "DeleteInterview")
+		_, err = repos.Interviews.GetInterviewByID(ctx, interview.ID)
+		ExpectCode(t, err, apperrors.KindNotFound, "interview_not_found", "GetInterviewByID deleted")
+	}},
+}
+
+func interviewIDs(interviews []models.Interview) []uuid.UUID {
+	ids := make([]uuid.UUID, len(interviews))
+	for i, interview := range interviews {
+		ids[i] = interview.ID
+	}
+	return ids
+}
@@ -0,0 +1,114 @@
+package contract
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+var recruiterCases = []Case{
+	{Name: "recruiters/create and get", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+
+		got, err := repos.Recruiters.GetRecruiterByID(ctx, recruiter.ID.String())
+		must(t, err, "GetRecruiterByID")
+		ExpectEqual(t, got.CompanyID, company.ID, "company_id")
+		ExpectEqual(t, got.Birthday.Format("2006-01-02"), "1988-03-04", "birthday")
+		ExpectEqual(t, got.Version, int64(1), "version")
+
+		_, err = repos.Recruiters.GetRecruiterByID(ctx, uuid.NewString())
+		ExpectCode(t, err, apperrors.KindNotFound, "recruiter_not_found", "GetRecruiterByID unknown")
+	}},
+	{Name: "recruiters/invalid birthday and unknown company", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		_, err := repos.Recruiters.CreateRecruiter(ctx, models.CreateRecruiter{
+			Name: "Rec", Email: UniqueEmail("rec"), PhoneNumber: UniquePhone(), Birthday: "1988-03-04", Gender: "m", CompanyID: company.ID,
+		})
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_date", "CreateRecruiter date only")
+
+		_, err = repos.Recruiters.CreateRecruiter(ctx, models.CreateRecruiter{
+			Name: "Rec", Email: UniqueEmail("rec"), PhoneNumber: UniquePhone(), Birthday: "1988-03-04T00:00:00Z", Gender: "m", CompanyID: uuid.New(),
+		})
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_reference", "CreateRecruiter unknown company")
+	}},
+	{Name: "recruiters/unique email and phone", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+
+		_, err := repos.Recruiters.CreateRecruiter(ctx, models.CreateRecruiter{
+			Name: "Copy", Email: strings.ToUpper(recruiter.Email), PhoneNumber: UniquePhone(), Birthday: "1988-03-04T00:00:00Z", Gender: "f", CompanyID: company.ID,
+		})
+		ExpectCode(t, err, apperrors.KindConflict, "recruiter_email_taken", "same email in other case")
+
+		_, err = repos.Recruiters.CreateRecruiter(ctx, models.CreateRecruiter{
+			Name: "Copy", Email: UniqueEmail("copy"), PhoneNumber: recruiter.PhoneNumber[4:], Birthday: "1988-03-04T00:00:00Z", Gender: "f", CompanyID: company.ID,
+		})
+		ExpectCode(t, err, apperrors.KindConflict, "recruiter_phone_taken", "same phone in other format")
+	}},
+	{Name: "recruiters/filter by age, gender and company", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		otherCompany := newCompany(ctx, t, repos)
+		birthday35 := birthdayForAge(35) + "T00:00:00Z"
+		female := newRecruiter(ctx, t, repos, company.ID, birthday35, "f")
+		male := newRecruiter(ctx, t, repos, company.ID, birthday35, "m")
+		older := newRecruiter(ctx, t, repos, company.ID, birthdayForAge(50)+"T00:00:00Z", "f")
+		elsewhere := newRecruiter(ctx, t, repos, otherCompany.ID, birthday35, "f")
+
+		recruiters, err := repos.Recruiters.GetAllRecruiters(ctx, 0, "", company.ID.String())
+		must(t, err, "GetAllRecruiters company")
+		ExpectMembers(t, recruiterIDs(recruiters), []uuid.UUID{female.ID, male.ID, older.ID}, []uuid.UUID{elsewhere.ID}, "company")
+
+		recruiters, err = repos.Recruiters.GetAllRecruiters(ctx, 35, "f", company.ID.String())
+		must(t, err, "GetAllRecruiters age+gender+company")
+		ExpectMembers(t, recruiterIDs(recruiters), []uuid.UUID{female.ID}, []uuid.UUID{male.ID, older.ID, elsewhere.ID}, "age+gender+company")
+
+		_, err = repos.Recruiters.GetAllRecruiters(ctx, 0, "", "not-a-uuid")
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_id", "GetAllRecruiters malformed company")
+	}},
+	{Name: "recruiters/update and delete", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+
+		update := recruiter.ToUpdate()
+		update.Name = "Renamed"
+		// handler YYYY-MM-DD ni RFC 3339 ga o'girib repositoryga beradi
+		update.Birthday = recruiter.Birthday.Format(time.RFC3339)
+		update.Version = recruiter.Version
+		must(t, repos.Recruiters.UpdateRecruiter(ctx, update), "UpdateRecruiter")
+		err := repos.Recruiters.UpdateRecruiter(ctx, update)
+		ExpectCode(t, err, apperrors.KindPreconditionFailed, "version_mismatch", "stale UpdateRecruiter")
+
+		must(t, repos.Recruiters.DeleteRecruiter(ctx, recruiter.ID.String()), "DeleteRecruiter")
+		_, err = repos.Recruiters.GetRecruiterByID(ctx, recruiter.ID.String())
+		ExpectCode(t, err, apperrors.KindNotFound, "recruiter_not_found", "GetRecruiterByID deleted")
+	}},
+	{Name: "recruiters/delete with interviews", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 0)
+		newInterview(ctx, t, repos, user.ID, vacancy.ID, recruiter.ID)
+
+		// intervyular avval DeleteInterviewsByRecruiterID bilan o'chirilishi kerak
+		err := repos.Recruiters.DeleteRecruiter(ctx, recruiter.ID.String())
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_reference", "DeleteRecruiter with interviews")
+
+		must(t, repos.Interviews.DeleteInterviewsByRecruiterID(ctx, recruiter.ID), "DeleteInterviewsByRecruiterID")
+		must(t, repos.Recruiters.DeleteRecruiter(ctx, recruiter.ID.String()), "DeleteRecruiter")
+	}},
+}
+
+func recruiterIDs(recruiters []models.Recruiter) []uuid.UUID {
+	ids := make([]uuid.UUID, len(recruiters))
+	for i, recruiter := range recruiters {
+		ids[i] = recruiter.ID
+	}
+	return ids
+}
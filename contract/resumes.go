@@ -0,0 +1,99 @@
+package contract
+
+import (
+	"context"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+var resumeCases = []Case{
+	{Name: "resumes/create, get and languages", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		created, err := repos.Resumes.CreateResume(ctx, models.CreateResume{
+			Position: "Backend", Experience: 4, Description: "Go", Languages: []string{"uz", "en"}, UserID: user.ID,
+		})
+		must(t, err, "CreateResume")
+
+		got, err := repos.Resumes.GetResumeByID(ctx, created.ID)
+		must(t, err, "GetResumeByID")
+		ExpectEqual(t, got.Position, "Backend", "position")
+		ExpectEqual(t, len(got.Languages), 2, "languages")
+
+		// tillar berilmasa bo'sh ro'yxat saqlanadi, nil emas
+		empty := newResume(ctx, t, repos, user.ID, "Frontend", 1)
+		got, err = repos.Resumes.GetResumeByID(ctx, empty.ID)
+		must(t, err, "GetResumeByID empty languages")
+		if got.Languages == nil {
+			t.Errorf("languages: got nil, want empty list")
+		}
+
+		resumes, err := repos.Resumes.GetResumesByUserID(ctx, user.ID)
+		must(t, err, "GetResumesByUserID")
+		ExpectEqual(t, len(resumes), 2, "resumes by user")
+	}},
+	{Name: "resumes/not found and unknown user", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		_, err := repos.Resumes.GetResumeByID(ctx, uuid.New())
+		ExpectCode(t, err, apperrors.KindNotFound, "resume_not_found", "GetResumeByID")
+
+		_, err = repos.Resumes.CreateResume(ctx, models.CreateResume{Position: "Backend", UserID: uuid.New()})
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_reference", "CreateResume unknown user")
+	}},
+	{Name: "resumes/filter by position and experience", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		position := uniquePosition("Golang Developer")
+		senior := newResume(ctx, t, repos, user.ID, position, 6)
+		junior := newResume(ctx, t, repos, user.ID, position, 1)
+		other := newResume(ctx, t, repos, user.ID, "Designer", 6)
+
+		// position katta-kichik harfsiz qism-satr bo'yicha qidiriladi
+		resumes, err := repos.Resumes.GetAllResumes(ctx, map[string]interface{}{"position": position[7:]})
+		must(t, err, "GetAllResumes position")
+		ExpectMembers(t, resumeIDs(resumes), []uuid.UUID{senior.ID, junior.ID}, []uuid.UUID{other.ID}, "position")
+
+		resumes, err = repos.Resumes.GetAllResumes(ctx, map[string]interface{}{"position": position, "min_exp": 5})
+		must(t, err, "GetAllResumes position+min_exp")
+		ExpectMembers(t, resumeIDs(resumes), []uuid.UUID{senior.ID}, []uuid.UUID{junior.ID, other.ID}, "position+min_exp")
+
+		for _, resume := range resumes {
+			if resume.ID == senior.ID {
+				ExpectEqual(t, resume.UserName, user.Name, "user_name")
+				ExpectEqual(t, resume.UserEmail, user.Email, "user_email")
+			}
+		}
+	}},
+	{Name: "resumes/update and delete", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		resume := newResume(ctx, t, repos, user.ID, "Backend", 2)
+
+		update := resume.ToUpdate()
+		update.Experience = 3
+		update.Version = resume.Version
+		must(t, repos.Resumes.UpdateResume(ctx, update), "UpdateResume")
+		err := repos.Resumes.UpdateResume(ctx, update)
+		ExpectCode(t, err, apperrors.KindPreconditionFailed, "version_mismatch", "stale UpdateResume")
+
+		got, err := repos.Resumes.GetResumeByID(ctx, resume.ID)
+		must(t, err, "GetResumeByID")
+		ExpectEqual(t, got.Experience, 3, "experience")
+		ExpectEqual(t, got.Version, resume.Version+1, "version")
+
+		must(t, repos.Resumes.DeleteResume(ctx, resume.ID.String()), "DeleteResume")
+		_, err = repos.Resumes.GetResumeByID(ctx, resume.ID)
+		ExpectCode(t, err, apperrors.KindNotFound, "resume_not_found", "GetResumeByID deleted")
+
+		err = repos.Resumes.DeleteResume(ctx, "not-a-uuid")
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_id", "DeleteResume malformed")
+	}},
+}
+
+func resumeIDs(resumes []models.ResumeWithUser) []uuid.UUID {
+	ids := make([]uuid.UUID, len(resumes))
+	for i, resume := range resumes {
+		ids[i] = resume.ID
+	}
+	return ids
+}
@@ -0,0 +1,199 @@
+package contract
+
+import (
+	"context"
+	"strings"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+var userCases = []Case{
+	{Name: "users/create and get", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		phone := UniquePhone()
+		created, err := repos.Users.CreateUser(ctx, models.UserCreate{
+			Name:        "Ali Valiyev",
+			Email:       " " + UniqueEmail("ali") + " ",
+			PhoneNumber: phone,
+			Birthday:    "1995-04-12",
+			Gender:      "m",
+		})
+		must(t, err, "CreateUser")
+		ExpectEqual(t, created.Version, int64(1), "version")
+		ExpectEqual(t, created.PhoneNumber, strings.ReplaceAll(phone, " ", ""), "normalized phone")
+		ExpectEqual(t, created.Email, strings.TrimSpace(created.Email), "trimmed email")
+
+		got, err := repos.Users.GetUserByID(ctx, created.ID.String())
+		must(t, err, "GetUserByID")
+		ExpectEqual(t, got.Name, "Ali Valiyev", "name")
+		ExpectEqual(t, got.Birthday.Format("2006-01-02"), "1995-04-12", "birthday")
+		ExpectEqual(t, got.DeletedAt, int64(0), "deleted_at")
+	}},
+	{Name: "users/invalid birthday", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		_, err := repos.Users.CreateUser(ctx, models.UserCreate{
+			Name: "Ali", Email: UniqueEmail("ali"), PhoneNumber: UniquePhone(), Birthday: "12.04.1995", Gender: "m",
+		})
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_date", "CreateUser")
+	}},
+	{Name: "users/not found and invalid id", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		_, err := repos.Users.GetUserByID(ctx, uuid.NewString())
+		ExpectCode(t, err, apperrors.KindNotFound, "user_not_found", "GetUserByID unknown")
+		_, err = repos.Users.GetUserByID(ctx, "not-a-uuid")
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_id", "GetUserByID malformed")
+	}},
+	{Name: "users/unique email and phone", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		user := newUser(ctx, t, repos, "1990-01-01", "f")
+
+		_, err := repos.Users.CreateUser(ctx, models.UserCreate{
+			Name: "Copy", Email: strings.ToUpper(user.Email), PhoneNumber: UniquePhone(), Birthday: "1990-01-01", Gender: "f",
+		})
+		ExpectCode(t, err, apperrors.KindConflict, "user_email_taken", "same email in other case")
+
+		// "+998901234567" va "901234567" bitta raqam
+		_, err = repos.Users.CreateUser(ctx, models.UserCreate{
+			Name: "Copy", Email: UniqueEmail("copy"), PhoneNumber: user.PhoneNumber[4:], Birthday: "1990-01-01", Gender: "f",
+		})
+		ExpectCode(t, err, apperrors.KindConflict, "user_phone_taken", "same phone in other format")
+
+		// o'chirilgan foydalanuvchining email va telefoni qayta ishlatilishi mumkin
+		must(t, repos.Users.DeleteUser(ctx, user.ID.String()), "DeleteUser")
+		_, err = repos.Users.CreateUser(ctx, models.UserCreate{
+			Name: "Reuse", Email: user.Email, PhoneNumber: user.PhoneNumber, Birthday: "1990-01-01", Gender: "f",
+		})
+		must(t, err, "CreateUser after delete")
+	}},
+	{Name: "users/update checks version", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+
+		update := user.ToUpdate()
+		update.Name = "Renamed"
+		update.Version = user.Version
+		must(t, repos.Users.UpdateUser(ctx, update), "UpdateUser")
+
+		got, err := repos.Users.GetUserByID(ctx, user.ID.String())
+		must(t, err, "GetUserByID")
+		ExpectEqual(t, got.Name, "Renamed", "name")
+		ExpectEqual(t, got.Version, user.Version+1, "version")
+
+		// eski versiya bilan ikkinchi yozuv rad etiladi
+		err = repos.Users.UpdateUser(ctx, update)
+		ExpectCode(t, err, apperrors.KindPreconditionFailed, "version_mismatch", "stale UpdateUser")
+
+		update.Version = got.Version
+		update.Birthday = "1990/01/01"
+		err = repos.Users.UpdateUser(ctx, update)
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_date", "UpdateUser invalid birthday")
+	}},
+	{Name: "users/update deleted", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		must(t, repos.Users.DeleteUser(ctx, user.ID.String()), "DeleteUser")
+
+		update := user.ToUpdate()
+		update.Version = user.Version
+		err := repos.Users.UpdateUser(ctx, update)
+		ExpectCode(t, err, apperrors.KindPreconditionFailed, "version_mismatch", "UpdateUser deleted")
+	}},
+	{Name: "users/soft delete hides from list", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		kept := newUser(ctx, t, repos, "1990-01-01", "m")
+		deleted := newUser(ctx, t, repos, "1990-01-01", "m")
+		must(t, repos.Users.DeleteUser(ctx, deleted.ID.String()), "DeleteUser")
+
+		users, err := repos.Users.GetAllUsers(ctx, map[string]interface{}{})
+		must(t, err, "GetAllUsers")
+		ExpectMembers(t, userIDs(users), []uuid.UUID{kept.ID}, []uuid.UUID{deleted.ID}, "GetAllUsers")
+
+		// soft delete qilingan yozuv ID bo'yicha hali ham o'qiladi
+		got, err := repos.Users.GetUserByID(ctx, deleted.ID.String())
+		must(t, err, "GetUserByID deleted")
+		if got.DeletedAt == 0 {
+			t.Errorf("GetUserByID deleted: deleted_at is not set")
+		}
+	}},
+	{Name: "users/filter by age and gender", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		male30 := newUser(ctx, t, repos, birthdayForAge(30), "m")
+		female30 := newUser(ctx, t, repos, birthdayForAge(30), "f")
+		male40 := newUser(ctx, t, repos, birthdayForAge(40), "m")
+
+		users, err := repos.Users.GetAllUsers(ctx, map[string]interface{}{"age": 30})
+		must(t, err, "GetAllUsers age")
+		ExpectMembers(t, userIDs(users), []uuid.UUID{male30.ID, female30.ID}, []uuid.UUID{male40.ID}, "age=30")
+
+		users, err = repos.Users.GetAllUsers(ctx, map[string]interface{}{"age": 30, "gender": "m"})
+		must(t, err, "GetAllUsers age+gender")
+		ExpectMembers(t, userIDs(users), []uuid.UUID{male30.ID}, []uuid.UUID{female30.ID, male40.ID}, "age=30 gender=male")
+	}},
+	{Name: "users/find duplicates by name and birthday", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		name := UniqueName("Twin")
+		first, err := repos.Users.CreateUser(ctx, models.UserCreate{
+			Name: name, Email: UniqueEmail("twin"), PhoneNumber: UniquePhone(), Birthday: "1991-05-05", Gender: "m",
+		})
+		must(t, err, "CreateUser first")
+		second, err := repos.Users.CreateUser(ctx, models.UserCreate{
+			Name: "  " + strings.ToUpper(name), Email: UniqueEmail("twin"), PhoneNumber: UniquePhone(), Birthday: "1991-05-05", Gender: "m",
+		})
+		must(t, err, "CreateUser second")
+
+		groups, err := repos.Users.FindDuplicates(ctx)
+		must(t, err, "FindDuplicates")
+		for _, group := range groups {
+			ids := userIDs(group.Users)
+			if group.Reason == "name_birthday" && containsID(ids, first.ID) {
+				ExpectMembers(t, ids, []uuid.UUID{first.ID, second.ID}, nil, "name_birthday group")
+				return
+			}
+		}
+		t.Errorf("FindDuplicates: no name_birthday group for %s", first.ID)
+	}},
+	{Name: "users/merge", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		source := newUser(ctx, t, repos, "1990-01-01", "m")
+		target := newUser(ctx, t, repos, "1990-01-01", "m")
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1985-01-01T00:00:00Z", "f")
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Go developer", 1)
+		resume := newResume(ctx, t, repos, source.ID, "Go developer", 3)
+		interview := newInterview(ctx, t, repos, source.ID, vacancy.ID, recruiter.ID)
+
+		result, err := repos.Users.MergeUsers(ctx, source.ID, target.ID)
+		must(t, err, "MergeUsers")
+		ExpectEqual(t, result.MergedUserID, source.ID, "merged_user_id")
+		ExpectEqual(t, result.User.ID, target.ID, "user")
+		ExpectEqual(t, result.MovedResumes, int64(1), "moved_resumes")
+		ExpectEqual(t, result.MovedInterviews, int64(1), "moved_interviews")
+
+		movedResume, err := repos.Resumes.GetResumeByID(ctx, resume.ID)
+		must(t, err, "GetResumeByID")
+		ExpectEqual(t, movedResume.UserID, target.ID, "resume owner")
+		movedInterview, err := repos.Interviews.GetInterviewByID(ctx, interview.ID)
+		must(t, err, "GetInterviewByID")
+		ExpectEqual(t, movedInterview.UserID, target.ID, "interview user")
+
+		merged, err := repos.Users.GetUserByID(ctx, source.ID.String())
+		must(t, err, "GetUserByID source")
+		if merged.DeletedAt == 0 {
+			t.Errorf("MergeUsers: source is not deleted")
+		}
+
+		_, err = repos.Users.MergeUsers(ctx, source.ID, target.ID)
+		ExpectCode(t, err, apperrors.KindNotFound, "user_not_found", "MergeUsers deleted source")
+	}},
+}
+
+func userIDs(users []models.User) []uuid.UUID {
+	ids := make([]uuid.UUID, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+	return ids
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package contract
+
+import (
+	"context"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+var vacancyCases = []Case{
+	{Name: "vacancies/create and get", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 2)
+
+		got, err := repos.Vacancies.GetVacancyByID(ctx, vacancy.ID)
+		must(t, err, "GetVacancyByID")
+		ExpectEqual(t, got.CompanyID, company.ID, "company_id")
+		ExpectEqual(t, got.MinExp, 2, "min_exp")
+
+		_, err = repos.Vacancies.GetVacancyByID(ctx, uuid.New())
+		ExpectCode(t, err, apperrors.KindNotFound, "vacancy_not_found", "GetVacancyByID unknown")
+
+		_, err = repos.Vacancies.CreateVacancy(ctx, models.CreateVacancy{Name: "X", Position: "Backend", CompanyID: uuid.New(), Description: "x"})
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_reference", "CreateVacancy unknown company")
+	}},
+	{Name: "vacancies/filter by position, min_exp and company", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		otherCompany := newCompany(ctx, t, repos)
+		position := uniquePosition("Data Engineer")
+		senior := newVacancy(ctx, t, repos, company.ID, position, 5)
+		junior := newVacancy(ctx, t, repos, company.ID, position, 0)
+		elsewhere := newVacancy(ctx, t, repos, otherCompany.ID, position, 5)
+
+		vacancies, err := repos.Vacancies.GetAllVacancies(ctx, map[string]interface{}{"position": position[5:]})
+		must(t, err, "GetAllVacancies position")
+		ExpectMembers(t, vacancyIDs(vacancies), []uuid.UUID{senior.ID, junior.ID, elsewhere.ID}, nil, "position")
+
+		vacancies, err = repos.Vacancies.GetAllVacancies(ctx, map[string]interface{}{"position": position, "min_exp": 3, "company_id": company.ID})
+		must(t, err, "GetAllVacancies position+min_exp+company")
+		ExpectMembers(t, vacancyIDs(vacancies), []uuid.UUID{senior.ID}, []uuid.UUID{junior.ID, elsewhere.ID}, "position+min_exp+company")
+	}},
+	{Name: "vacancies/update and delete", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 2)
+
+		update := vacancy.ToUpdate()
+		update.MinExp = 4
+		update.Version = vacancy.Version
+		must(t, repos.Vacancies.UpdateVacancy(ctx, update), "UpdateVacancy")
+		err := repos.Vacancies.UpdateVacancy(ctx, update)
+		ExpectCode(t, err, apperrors.KindPreconditionFailed, "version_mismatch", "stale UpdateVacancy")
+
+		got, err := repos.Vacancies.GetVacancyByID(ctx, vacancy.ID)
+		must(t, err, "GetVacancyByID")
+		ExpectEqual(t, got.MinExp, 4, "min_exp")
+
+		update.Version = got.Version
+		update.CompanyID = uuid.New()
+		err = repos.Vacancies.UpdateVacancy(ctx, update)
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_reference", "UpdateVacancy unknown company")
+
+		must(t, repos.Vacancies.DeleteVacancy(ctx, vacancy.ID), "DeleteVacancy")
+		_, err = repos.Vacancies.GetVacancyByID(ctx, vacancy.ID)
+		ExpectCode(t, err, apperrors.KindNotFound, "vacancy_not_found", "GetVacancyByID deleted")
+	}},
+	{Name: "vacancies/delete with interviews", Run: func(ctx context.Context, t T, repos postgres.Repositories) {
+		company := newCompany(ctx, t, repos)
+		recruiter := newRecruiter(ctx, t, repos, company.ID, "1988-03-04T00:00:00Z", "f")
+		user := newUser(ctx, t, repos, "1990-01-01", "m")
+		vacancy := newVacancy(ctx, t, repos, company.ID, "Backend", 0)
+		newInterview(ctx, t, repos, user.ID, vacancy.ID, recruiter.ID)
+
+		err := repos.Vacancies.DeleteVacancy(ctx, vacancy.ID)
+		ExpectCode(t, err, apperrors.KindValidation, "invalid_reference", "DeleteVacancy with interviews")
+
+		must(t, repos.Interviews.DeleteInterviewsByVacancyID(ctx, vacancy.ID), "DeleteInterviewsByVacancyID")
+		must(t, repos.Vacancies.DeleteVacancy(ctx, vacancy.ID), "DeleteVacancy")
+	}},
+}
+
+func vacancyIDs(vacancies []models.Vacancy) []uuid.UUID {
+	ids := make([]uuid.UUID, len(vacancies))
+	for i, vacancy := range vacancies {
+		ids[i] = vacancy.ID
+	}
+	return ids
+}
@@ -0,0 +1,168 @@
+package eligibility
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+)
+
+// Candidate - tekshiriladigan nomzod va uning rezyumelari
+type Candidate struct {
+	User    models.User
+	Resumes []models.Resume
+}
+
+// Result - tekshiruv natijasi. Reasons bo'sh bo'lsa nomzod mos keladi. Sabablar
+// apperrors.FieldError ko'rinishida: Rule va Param bo'yicha "validation.<rule>" kaliti
+// orqali so'rov tiliga tarjima qilinadi, Message esa inglizcha matn.
+type Result struct {
+	Eligible bool                   `json:"eligible"`
+	Rules    []Rule                 `json:"rules"`
+	Reasons  []apperrors.FieldError `json:"reasons"`
+}
+
+// ReasonNoResume nomzodda birorta rezyume bo'lmaganda qaytariladi
+const ReasonNoResume = "resume_required"
+
+// AgeAt birthday bo'yicha now vaqtidagi to'liq yoshni hisoblaydi
+func AgeAt(birthday, now time.Time) int {
+	age := now.Year() - birthday.Year()
+	if now.Month() < birthday.Month() || (now.Month() == birthday.Month() && now.Day() < birthday.Day()) {
+		age--
+	}
+	return age
+}
+
+// Evaluate barcha qoidalarni tekshiradi va birinchi xatoda to'xtamaydi, shuning uchun
+// natijada nomzod mos kelmasligining barcha sabablari bo'ladi. Tajriba va tillar faqat
+// vakansiya lavozimiga mos rezyumelar bo'yicha hisoblanadi.
+func Evaluate(rules []Rule, candidate Candidate, vacancy models.Vacancy, now time.Time) Result {
+	result := Result{Rules: rules, Reasons: []apperrors.FieldError{}}
+	resumes := candidate.Resumes
+
+	for _, rule := range rules {
+		if rule.Type != RulePositionMatch {
+			continue
+		}
+		if len(candidate.Resumes) == 0 {
+			result.Reasons = append(result.Reasons, apperrors.FieldError{
+				Rule:    ReasonNoResume,
+				Field:   "user_id",
+				Message: "candidate has no resume",
+			})
+			break
+		}
+		resumes = matchingResumes(candidate.Resumes, vacancy.Position, rule.Params.Mode)
+		if len(resumes) == 0 {
+			result.Reasons = append(result.Reasons, apperrors.FieldError{
+				Rule:    RulePositionMatch,
+				Field:   "vacancy_id",
+				Param:   vacancy.Position,
+				Message: fmt.Sprintf("no resume matches the vacancy position %q", vacancy.Position),
+			})
+		}
+	}
+
+	for _, rule := range rules {
+		if reason, ok := check(rule, candidate.User, resumes, vacancy, now); !ok {
+			result.Reasons = append(result.Reasons, reason)
+		}
+	}
+
+	result.Eligible = len(result.Reasons) == 0
+	return result
+}
+
+// check position_match dan boshqa bitta qoidani tekshiradi
+func check(rule Rule, user models.User, resumes []models.Resume, vacancy models.Vacancy, now time.Time) (apperrors.FieldError, bool) {
+	switch rule.Type {
+	case RuleMinAge:
+		if rule.Params.Value == nil {
+			break
+		}
+		min := *rule.Params.Value
+		if AgeAt(user.Birthday, now) < min {
+			return apperrors.FieldError{Rule: RuleMinAge, Field: "user_id", Param: strconv.Itoa(min),
+				Message: fmt.Sprintf("must be at least %d years old", min)}, false
+		}
+	case RuleMaxAge:
+		if rule.Params.Value == nil {
+			break
+		}
+		max := *rule.Params.Value
+		if AgeAt(user.Birthday, now) > max {
+			return apperrors.FieldError{Rule: RuleMaxAge, Field: "user_id", Param: strconv.Itoa(max),
+				Message: fmt.Sprintf("must be at most %d years old", max)}, false
+		}
+	case RuleMinExperience:
+		min := vacancy.MinExp
+		if rule.Params.Value != nil {
+			min = *rule.Params.Value
+		}
+		if maxExperience(resumes) < min {
+			return apperrors.FieldError{Rule: RuleMinExperience, Field: "user_id", Param: strconv.Itoa(min),
+				Message: fmt.Sprintf("must have at least %d years of experience", min)}, false
+		}
+	case RuleRequiredLanguages:
+		if missing := missingLanguages(resumes, rule.Params.Languages); len(missing) > 0 {
+			param := strings.Join(missing, ", ")
+			return apperrors.FieldError{Rule: RuleRequiredLanguages, Field: "user_id", Param: param,
+				Message: "missing required languages: " + param}, false
+		}
+	}
+	return apperrors.FieldError{}, true
+}
+
+// matchingResumes vakansiya lavozimiga mode bo'yicha mos keladigan rezyumelarni qaytaradi
+func matchingResumes(resumes []models.Resume, position, mode string) []models.Resume {
+	var matched []models.Resume
+	for _, resume := range resumes {
+		if positionMatches(resume.Position, position, mode) {
+			matched = append(matched, resume)
+		}
+	}
+	return matched
+}
+
+func positionMatches(resumePosition, vacancyPosition, mode string) bool {
+	switch mode {
+	case MatchIgnoreCase:
+		return strings.EqualFold(strings.TrimSpace(resumePosition), strings.TrimSpace(vacancyPosition))
+	case MatchContains:
+		return strings.Contains(strings.ToLower(resumePosition), strings.ToLower(strings.TrimSpace(vacancyPosition)))
+	default:
+		return resumePosition == vacancyPosition
+	}
+}
+
+func maxExperience(resumes []models.Resume) int {
+	max := 0
+	for _, resume := range resumes {
+		if resume.Experience > max {
+			max = resume.Experience
+		}
+	}
+	return max
+}
+
+// missingLanguages rezyumelarning birortasida ham ko'rsatilmagan tillarni qaytaradi (katta-kichik harf farqsiz)
+func missingLanguages(resumes []models.Resume, required []string) []string {
+	known := make(map[string]bool)
+	for _, resume := range resumes {
+		for _, language := range resume.Languages {
+			known[strings.ToLower(strings.TrimSpace(language))] = true
+		}
+	}
+
+	var missing []string
+	for _, language := range required {
+		if !known[strings.ToLower(strings.TrimSpace(language))] {
+			missing = append(missing, language)
+		}
+	}
+	return missing
+}
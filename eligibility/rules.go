@@ -0,0 +1,118 @@
+// Package eligibility - nomzodning vakansiyaga mosligini ma'lumot sifatida saqlangan
+// qoidalar bo'yicha tekshiradigan dvigatel. Qoidalar kompaniya va vakansiya darajasida
+// beriladi, dvigatel esa bazadan mustaqil: unga tayyor User, Resume va Vacancy uzatiladi.
+package eligibility
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Qoida turlari
+const (
+	RuleMinAge            = "min_age"
+	RuleMaxAge            = "max_age"
+	RuleMinExperience     = "min_experience"
+	RulePositionMatch     = "position_match"
+	RuleRequiredLanguages = "required_languages"
+)
+
+// RuleTypes - qo'llab-quvvatlanadigan qoida turlari
+var RuleTypes = []string{RuleMinAge, RuleMaxAge, RuleMinExperience, RulePositionMatch, RuleRequiredLanguages}
+
+// position_match rejimlari
+const (
+	MatchExact      = "exact" // aynan bir xil (avvalgi xatti-harakat)
+	MatchIgnoreCase = "ignore_case"
+	MatchContains   = "contains" // rezyume lavozimi vakansiya lavozimini o'z ichiga oladi
+)
+
+// Params - qoida parametrlari. Har bir tur o'ziga kerakli maydonlarni ishlatadi:
+//
+//	min_age, max_age      {"value": 16}
+//	min_experience        {"value": 2}  (berilmasa vakansiyaning min_exp qiymati)
+//	position_match        {"mode": "exact" | "ignore_case" | "contains"}
+//	required_languages    {"languages": ["uz", "en"]}
+type Params struct {
+	Value     *int     `json:"value,omitempty"`
+	Mode      string   `json:"mode,omitempty"`
+	Languages []string `json:"languages,omitempty"`
+}
+
+// Rule - bitta qoida
+type Rule struct {
+	Type   string `json:"type"`
+	Params Params `json:"params"`
+}
+
+// RuleSet - kompaniya yoki vakansiya qoidalarini almashtirish so'rovi tanasi
+type RuleSet struct {
+	Rules []Rule `json:"rules" binding:"max=20"`
+}
+
+// DefaultRules hech qanday qoida saqlanmagan bo'lsa ishlatiladi: 18+ yosh va lavozimning
+// aniq mosligi (avval CreateInterview ichida qattiq yozilgan qoidalar)
+func DefaultRules() []Rule {
+	minAge := 18
+	return []Rule{
+		{Type: RuleMinAge, Params: Params{Value: &minAge}},
+		{Type: RulePositionMatch, Params: Params{Mode: MatchExact}},
+	}
+}
+
+// Merge qoidalarni tur bo'yicha birlashtiradi: keyingi to'plamdagi qoida oldingisidagi
+// shu turdagi qoidani almashtiradi (standart -> kompaniya -> vakansiya)
+func Merge(sets ...[]Rule) []Rule {
+	byType := make(map[string]Rule)
+	for _, set := range sets {
+		for _, rule := range set {
+			byType[rule.Type] = rule
+		}
+	}
+
+	merged := make([]Rule, 0, len(byType))
+	for _, ruleType := range RuleTypes {
+		if rule, ok := byType[ruleType]; ok {
+			merged = append(merged, rule)
+		}
+	}
+	return merged
+}
+
+// Validate qoida turi va parametrlarini tekshiradi
+func (r Rule) Validate() error {
+	switch r.Type {
+	case RuleMinAge, RuleMaxAge:
+		if r.Params.Value == nil || *r.Params.Value < 0 || *r.Params.Value > 150 {
+			return fmt.Errorf("%s: params.value must be between 0 and 150", r.Type)
+		}
+	case RuleMinExperience:
+		if r.Params.Value != nil && (*r.Params.Value < 0 || *r.Params.Value > 60) {
+			return fmt.Errorf("%s: params.value must be between 0 and 60", r.Type)
+		}
+	case RulePositionMatch:
+		switch r.Params.Mode {
+		case "", MatchExact, MatchIgnoreCase, MatchContains:
+		default:
+			return fmt.Errorf("%s: params.mode must be one of %s, %s, %s", r.Type, MatchExact, MatchIgnoreCase, MatchContains)
+		}
+	case RuleRequiredLanguages:
+		if len(r.Params.Languages) == 0 {
+			return fmt.Errorf("%s: params.languages must not be empty", r.Type)
+		}
+	default:
+		return fmt.Errorf("unknown rule type %q, must be one of %s", r.Type, strings.Join(RuleTypes, ", "))
+	}
+	return nil
+}
+
+// ParseParams bazadagi JSON parametrlarni o'qiydi
+func ParseParams(raw []byte) (Params, error) {
+	var params Params
+	if len(raw) == 0 {
+		return params, nil
+	}
+	err := json.Unmarshal(raw, &params)
+	return params, err
+}
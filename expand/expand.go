@@ -0,0 +1,389 @@
+// Package expand - GET javoblariga bog'langan yozuvlarni joylashtirish (?expand=) va
+// javobni kerakli maydonlar bilan cheklash (?fields=).
+//
+//	GET /interviews/:id?expand=user,vacancy.company&fields=id,interview_date,user.name,vacancy
+//
+// Bog'langan yozuvlar har bir bog'lanish uchun bitta partiyaviy repository so'rovi
+// bilan olinadi (GetUsersByIDs, GetInterviewsByVacancyIDs ...), shuning uchun ro'yxatdagi
+// yozuvlar soni so'rovlar soniga ta'sir qilmaydi. Tashqi kalit maydonlari (user_id)
+// javobda qoladi, bog'langan yozuv esa bog'lanish nomi bilan qo'shiladi (user).
+package expand
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+// MaxDepth - expand yo'lidagi bog'lanishlarning eng ko'p soni: vacancy.company.recruiters - 3
+const MaxDepth = 3
+
+// relation - yozuv turidan boshqa turga bog'lanish
+type relation struct {
+	target string // bog'langan yozuv turi (audit.Entity*)
+	key    string // bittalik bog'lanishda ota yozuvdagi tashqi kalit, ko'plikda bola yozuvdagi ota ID si
+	many   bool
+}
+
+// relations - har bir yozuv turining bog'lanishlari nomi bo'yicha
+var relations = map[string]map[string]relation{
+	audit.EntityUser: {
+		"resumes":    {target: audit.EntityResume, key: "user_id", many: true},
+		"interviews": {target: audit.EntityInterview, key: "user_id", many: true},
+	},
+	audit.EntityResume: {
+		"user": {target: audit.EntityUser, key: "user_id"},
+	},
+	audit.EntityCompany: {
+		"vacancies":  {target: audit.EntityVacancy, key: "company_id", many: true},
+		"recruiters": {target: audit.EntityRecruiter, key: "company_id", many: true},
+	},
+	audit.EntityRecruiter: {
+		"company": {target: audit.EntityCompany, key: "company_id"},
+	},
+	audit.EntityVacancy: {
+		"company":    {target: audit.EntityCompany, key: "company_id"},
+		"interviews": {target: audit.EntityInterview, key: "vacancy_id", many: true},
+	},
+	audit.EntityInterview: {
+		"user":      {target: audit.EntityUser, key: "user_id"},
+		"vacancy":   {target: audit.EntityVacancy, key: "vacancy_id"},
+		"recruiter": {target: audit.EntityRecruiter, key: "recruiter_id"},
+	},
+}
+
+// fieldNames - ?fields= da ruxsat etilgan maydonlar, models dagi json teglaridan
+var fieldNames = map[string]map[string]bool{
+	audit.EntityUser:      jsonFields(models.User{}),
+	audit.EntityResume:    jsonFields(models.Resume{}, models.ResumeWithUser{}), // ro'yxat user_name va user_email bilan
+	audit.EntityCompany:   jsonFields(models.Company{}),
+	audit.EntityRecruiter: jsonFields(models.Recruiter{}),
+	audit.EntityVacancy:   jsonFields(models.Vacancy{}),
+	audit.EntityInterview: jsonFields(models.Interview{}),
+}
+
+func jsonFields(models ...interface{}) map[string]bool {
+	fields := make(map[string]bool)
+	for _, model := range models {
+		t := reflect.TypeOf(model)
+		for i := 0; i < t.NumField(); i++ {
+			name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			if name != "" && name != "-" {
+				fields[name] = true
+			}
+		}
+	}
+	return fields
+}
+
+// Relations yozuv turining bog'lanishlari nomlarini alfavit tartibida qaytaradi
+func Relations(entity string) []string {
+	names := make([]string, 0, len(relations[entity]))
+	for name := range relations[entity] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// tree - nuqta bilan ajratilgan yo'llar daraxti: "vacancy.company,user" ->
+// {vacancy: {company: {}}, user: {}}
+type tree map[string]tree
+
+func (t tree) add(path []string) {
+	for _, name := range path {
+		if t[name] == nil {
+			t[name] = tree{}
+		}
+		t = t[name]
+	}
+}
+
+// Query - tahlil qilingan ?expand= va ?fields= parametrlari
+type Query struct {
+	expand tree
+	fields tree
+}
+
+// IsZero parametrlar berilmaganini bildiradi: javob o'zgarishsiz yoziladi
+func (q Query) IsZero() bool {
+	return len(q.expand) == 0 && len(q.fields) == 0
+}
+
+// Parse entity turidagi yozuv uchun ?expand= va ?fields= qiymatlarini tekshiradi.
+// Noma'lum bog'lanish yoki maydon, MaxDepth dan chuqur yo'l invalid_query xatoligini
+// beradi, tafsilotda shu darajada ruxsat etilgan nomlar bo'ladi.
+func Parse(entity, expandParam, fieldsParam string) (Query, error) {
+	query := Query{expand: tree{}, fields: tree{}}
+	for _, path := range splitPaths(expandParam) {
+		if len(path) > MaxDepth {
+			return Query{}, invalidQuery("expand", "Expansion is too deep", "expand_depth", strconv.Itoa(MaxDepth))
+		}
+		current := entity
+		for _, name := range path {
+			rel, ok := relations[current][name]
+			if !ok {
+				return Query{}, invalidQuery("expand", "Unknown relation "+strings.Join(path, "."), "oneof", strings.Join(Relations(current), " "))
+			}
+			current = rel.target
+		}
+		query.expand.add(path)
+	}
+
+	for _, path := range splitPaths(fieldsParam) {
+		current := entity
+		for i, name := range path {
+			if rel, ok := relations[current][name]; ok {
+				current = rel.target
+				continue
+			}
+			if !fieldNames[current][name] || i != len(path)-1 {
+				return Query{}, invalidQuery("fields", "Unknown field "+strings.Join(path, "."), "oneof", strings.Join(allowedFields(current), " "))
+			}
+		}
+		query.fields.add(path)
+	}
+	return query, nil
+}
+
+func splitPaths(param string) [][]string {
+	var paths [][]string
+	for _, item := range strings.Split(param, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			paths = append(paths, strings.Split(item, "."))
+		}
+	}
+	return paths
+}
+
+func allowedFields(entity string) []string {
+	names := Relations(entity)
+	for name := range fieldNames[entity] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// invalidQuery - tafsiloti so'rov tiliga rule bo'yicha tarjima qilinadigan invalid_query
+func invalidQuery(field, message, rule, param string) *apperrors.Error {
+	return apperrors.Validation("invalid_query", "Invalid "+field+" value",
+		apperrors.FieldError{Field: field, Message: message, Rule: rule, Param: param})
+}
+
+// Expander bog'langan yozuvlarni repositorylardan oladi
+type Expander struct {
+	Users      postgres.UserRepository
+	Resumes    postgres.ResumeRepository
+	Companies  postgres.CompanyRepository
+	Recruiters postgres.RecruiterRepository
+	Vacancies  postgres.VacancyRepository
+	Interviews postgres.InterviewRepository
+}
+
+// Apply value (yozuv yoki yozuvlar ro'yxati) ga query dagi bog'lanishlarni joylashtiradi
+// va maydonlarni cheklaydi. Natija JSON ga yozish uchun map/slice ko'rinishida.
+func (e *Expander) Apply(ctx context.Context, entity string, query Query, value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+	var result interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber() // deleted_at va version float64 ga aylanmasin
+	if err := decoder.Decode(&result); err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	if err := e.expand(ctx, entity, records(result), query.expand); err != nil {
+		return nil, err
+	}
+	if len(query.fields) > 0 {
+		trim(result, query.fields)
+	}
+	return result, nil
+}
+
+// records yozuv yoki ro'yxatdan obyektlarni ajratib oladi
+func records(value interface{}) []map[string]interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return []map[string]interface{}{value}
+	case []interface{}:
+		result := make([]map[string]interface{}, 0, len(value))
+		for _, item := range value {
+			if record, ok := item.(map[string]interface{}); ok {
+				result = append(result, record)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// expand har bir bog'lanish uchun barcha yozuvlarning kalitlarini yig'ib bitta so'rov
+// yuboradi, keyin olingan yozuvlarning o'z bog'lanishlarini ham xuddi shunday joylashtiradi
+func (e *Expander) expand(ctx context.Context, entity string, parents []map[string]interface{}, expansions tree) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	for name, nested := range expansions {
+		rel := relations[entity][name]
+
+		key := rel.key
+		if rel.many {
+			key = "id"
+		}
+		ids := collectIDs(parents, key)
+
+		var children []map[string]interface{}
+		var err error
+		if rel.many {
+			children, err = e.byParent(ctx, rel.target, rel.key, ids)
+		} else {
+			children, err = e.byID(ctx, rel.target, ids)
+		}
+		if err != nil {
+			return err
+		}
+		if err := e.expand(ctx, rel.target, children, nested); err != nil {
+			return err
+		}
+
+		if rel.many {
+			groups := make(map[string][]interface{})
+			for _, child := range children {
+				parentID, _ := child[rel.key].(string)
+				groups[parentID] = append(groups[parentID], child)
+			}
+			for _, parent := range parents {
+				id, _ := parent["id"].(string)
+				if group := groups[id]; group != nil {
+					parent[name] = group
+				} else {
+					parent[name] = []interface{}{}
+				}
+			}
+			continue
+		}
+
+		byID := make(map[string]map[string]interface{}, len(children))
+		for _, child := range children {
+			id, _ := child["id"].(string)
+			byID[id] = child
+		}
+		for _, parent := range parents {
+			id, _ := parent[rel.key].(string)
+			if child, ok := byID[id]; ok {
+				parent[name] = child
+			} else {
+				parent[name] = nil // yozuv o'chirilgan
+			}
+		}
+	}
+	return nil
+}
+
+// collectIDs yozuvlardagi key maydonining takrorlanmas UUID qiymatlari
+func collectIDs(values []map[string]interface{}, key string) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	for _, value := range values {
+		text, _ := value[key].(string)
+		id, err := uuid.Parse(text)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (e *Expander) byID(ctx context.Context, entity string, ids []uuid.UUID) ([]map[string]interface{}, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	switch entity {
+	case audit.EntityUser:
+		return decode(e.Users.GetUsersByIDs(ctx, ids))
+	case audit.EntityCompany:
+		return decode(e.Companies.GetCompaniesByIDs(ctx, ids))
+	case audit.EntityRecruiter:
+		return decode(e.Recruiters.GetRecruitersByIDs(ctx, ids))
+	case audit.EntityVacancy:
+		return decode(e.Vacancies.GetVacanciesByIDs(ctx, ids))
+	}
+	return nil, nil
+}
+
+func (e *Expander) byParent(ctx context.Context, entity, key string, ids []uuid.UUID) ([]map[string]interface{}, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	switch entity + "." + key {
+	case audit.EntityResume + ".user_id":
+		return decode(e.Resumes.GetResumesByUserIDs(ctx, ids))
+	case audit.EntityInterview + ".user_id":
+		return decode(e.Interviews.GetInterviewsByUserIDs(ctx, ids))
+	case audit.EntityInterview + ".vacancy_id":
+		return decode(e.Interviews.GetInterviewsByVacancyIDs(ctx, ids))
+	case audit.EntityVacancy + ".company_id":
+		return decode(e.Vacancies.GetVacanciesByCompanyIDs(ctx, ids))
+	case audit.EntityRecruiter + ".company_id":
+		return decode(e.Recruiters.GetRecruitersByCompanyIDs(ctx, ids))
+	}
+	return nil, nil
+}
+
+// decode repository natijasini JSON obyektlariga aylantiradi
+func decode[V any](values []V, err error) ([]map[string]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+	var result []map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, apperrors.Internal(err)
+	}
+	return result, nil
+}
+
+// trim yozuvlarda faqat fields dagi maydonlarni qoldiradi. Bog'lanish nomi yolg'iz
+// berilsa (user) joylashtirilgan yozuv to'liq qoladi, ichki yo'l bilan (user.name)
+// u ham cheklanadi.
+func trim(value interface{}, fields tree) {
+	switch value := value.(type) {
+	case []interface{}:
+		for _, item := range value {
+			trim(item, fields)
+		}
+	case map[string]interface{}:
+		for name, nested := range value {
+			sub, ok := fields[name]
+			if !ok {
+				delete(value, name)
+				continue
+			}
+			if len(sub) > 0 {
+				trim(nested, sub)
+			}
+		}
+	}
+}
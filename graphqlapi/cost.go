@@ -0,0 +1,165 @@
+package graphqlapi
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Limits - so'rovni bajarishdan oldin tekshiriladigan chegaralar
+type Limits struct {
+	MaxCost  int // narx: har bir yozuv 1, ro'yxat ichidagi narx first ga ko'paytiriladi
+	MaxDepth int // ichma-ich yozuvlar soni, masalan companies { vacancies { name } } - 2
+}
+
+// maxCost hisoblashda to'lib ketmaslik uchun yuqori chegara, har qanday limitdan katta
+const maxCost = 1 << 30
+
+// Cost - so'rovning hisoblangan narxi va chuqurligi, javobning extensions.cost qismida qaytadi
+type Cost struct {
+	Cost     int `json:"cost"`
+	Depth    int `json:"depth"`
+	MaxCost  int `json:"max_cost"`
+	MaxDepth int `json:"max_depth"`
+}
+
+// analyze hujjatdagi har bir query operatsiyasining eng yomon holatdagi narxini va
+// chuqurligini sxema bo'yicha statik hisoblaydi. Ro'yxat maydonlari first (berilmasa
+// DefaultFirst) ta yozuv qaytaradi deb olinadi, shuning uchun ichki ro'yxatlar narxi
+// ko'paytiriladi. Skalyar va __ bilan boshlanadigan introspection maydonlari bepul.
+// Bir nechta operatsiya bo'lsa eng qimmati olinadi.
+func analyze(schema graphql.Schema, document *ast.Document, variables map[string]interface{}) (cost, depth int) {
+	a := analyzer{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+		visiting:  make(map[string]bool),
+	}
+	var operations []*ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			a.fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			operations = append(operations, definition)
+		}
+	}
+
+	for _, operation := range operations {
+		if operation.Operation != ast.OperationTypeQuery {
+			continue
+		}
+		c, d := a.selectionSet(operation.SelectionSet, schema.QueryType())
+		cost = max(cost, c)
+		depth = max(depth, d)
+	}
+	return cost, depth
+}
+
+type analyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	visiting  map[string]bool // fragment sikllari validatsiyada rad etiladi, bu faqat himoya
+}
+
+func (a *analyzer) selectionSet(set *ast.SelectionSet, parent *graphql.Object) (cost, depth int) {
+	if set == nil || parent == nil {
+		return 0, 0
+	}
+	for _, selection := range set.Selections {
+		var c, d int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			c, d = a.field(selection, parent)
+		case *ast.InlineFragment:
+			// sxemada faqat obyekt turlari bor, shuning uchun tur sharti parent bilan bir xil
+			c, d = a.selectionSet(selection.SelectionSet, parent)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			fragment, ok := a.fragments[name]
+			if !ok || a.visiting[name] {
+				continue
+			}
+			a.visiting[name] = true
+			c, d = a.selectionSet(fragment.SelectionSet, parent)
+			a.visiting[name] = false
+		}
+		cost = add(cost, c)
+		depth = max(depth, d)
+	}
+	return cost, depth
+}
+
+func (a *analyzer) field(field *ast.Field, parent *graphql.Object) (cost, depth int) {
+	name := field.Name.Value
+	if strings.HasPrefix(name, "__") {
+		return 0, 0
+	}
+	definition, ok := parent.Fields()[name]
+	if !ok {
+		return 0, 0
+	}
+
+	object, list := objectType(definition.Type)
+	if object == nil {
+		return 0, 0
+	}
+	childCost, childDepth := a.selectionSet(field.SelectionSet, object)
+	items := 1
+	if list {
+		items = a.first(field)
+	}
+	return multiply(items, add(1, childCost)), childDepth + 1
+}
+
+// first maydondagi first argumentini literal yoki o'zgaruvchidan o'qiydi
+func (a *analyzer) first(field *ast.Field) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "first" {
+			continue
+		}
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(value.Value); err == nil {
+				return max(n, 0)
+			}
+		case *ast.Variable:
+			switch n := a.variables[value.Name.Value].(type) {
+			case float64: // encoding/json raqamlari
+				return int(max(min(n, maxCost), 0))
+			case int:
+				return max(min(n, maxCost), 0)
+			}
+		}
+	}
+	return DefaultFirst
+}
+
+// objectType maydon turidan NonNull va List qobiqlarini olib tashlaydi
+func objectType(t graphql.Type) (object *graphql.Object, list bool) {
+	for {
+		switch wrapped := t.(type) {
+		case *graphql.NonNull:
+			t = wrapped.OfType
+		case *graphql.List:
+			list = true
+			t = wrapped.OfType
+		case *graphql.Object:
+			return wrapped, list
+		default:
+			return nil, list
+		}
+	}
+}
+
+func add(a, b int) int {
+	return min(a+b, maxCost)
+}
+
+func multiply(a, b int) int {
+	if a != 0 && b > maxCost/a {
+		return maxCost
+	}
+	return a * b
+}
@@ -0,0 +1,152 @@
+// Package graphqlapi - POST /graphql. Sxema models dagi yozuvlar va ular orasidagi
+// bog'lanishlarni beradi, bog'langan yozuvlar har so'rov uchun yaratiladigan loaderlar
+// orqali partiyalab olinadi (N+1 so'rovlar o'rniga har bir darajada bitta so'rov).
+// Juda qimmat yoki chuqur so'rovlar bajarilishdan oldin rad etiladi.
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+	"hrplatform/postgres"
+	"hrplatform/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+type Handler struct {
+	Users      postgres.UserRepository
+	Resumes    postgres.ResumeRepository
+	Companies  postgres.CompanyRepository
+	Recruiters postgres.RecruiterRepository
+	Vacancies  postgres.VacancyRepository
+	Interviews postgres.InterviewRepository
+	Schema     graphql.Schema
+	Limits     Limits
+}
+
+// Request - POST /graphql tanasi (GraphQL over HTTP)
+type Request struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Serve so'rovni tahlil qiladi, sxema bo'yicha tekshiradi, narxi va chuqurligini
+// Limits bilan solishtiradi va bajaradi. Sintaksis, validatsiya va limit xatoliklari
+// 400 bilan, bajarilish paytidagi xatoliklar esa GraphQL odatiga ko'ra 200 va qisman
+// data bilan qaytadi. Xatolik matnlari so'rov tiliga tarjima qilinadi, barqaror kod
+// va request_id errors[].extensions da.
+func (h *Handler) Serve(c *gin.Context) {
+	var request Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(validation.FromBindError(err))
+		return
+	}
+
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		h.writeErrors(c, http.StatusBadRequest, gqlerrors.FormatErrors(err), nil)
+		return
+	}
+	if result := graphql.ValidateDocument(&h.Schema, document, nil); !result.IsValid {
+		h.writeErrors(c, http.StatusBadRequest, result.Errors, nil)
+		return
+	}
+
+	cost, depth := analyze(h.Schema, document, request.Variables)
+	report := Cost{Cost: cost, Depth: depth, MaxCost: h.Limits.MaxCost, MaxDepth: h.Limits.MaxDepth}
+	var limitErr error
+	switch {
+	case h.Limits.MaxDepth > 0 && depth > h.Limits.MaxDepth:
+		limitErr = apperrors.Validation("query_too_deep", "Query is too deep").WithArg("Depth", depth).WithArg("Limit", h.Limits.MaxDepth)
+	case h.Limits.MaxCost > 0 && cost > h.Limits.MaxCost:
+		limitErr = apperrors.Validation("query_too_expensive", "Query is too expensive").WithArg("Cost", cost).WithArg("Limit", h.Limits.MaxCost)
+	}
+	if limitErr != nil {
+		h.writeErrors(c, http.StatusBadRequest, gqlerrors.FormatErrors(limitErr), &report)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), handlerKey{}, h)
+	ctx = withLoaders(ctx, h.newLoaders(ctx))
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.Schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       ctx,
+	})
+	result.Errors = h.localize(c, result.Errors)
+	if result.Extensions == nil {
+		result.Extensions = map[string]interface{}{}
+	}
+	result.Extensions["cost"] = report
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handler) writeErrors(c *gin.Context, status int, errs []gqlerrors.FormattedError, report *Cost) {
+	body := gin.H{"errors": h.localize(c, errs)}
+	if report != nil {
+		body["extensions"] = gin.H{"cost": report}
+	}
+	c.AbortWithStatusJSON(status, body)
+}
+
+// localize domen xatoliklari matnini REST javoblaridagi kabi tarjima qiladi va
+// extensions ga code, request_id va maydon xatoliklarini qo'shadi. graphql-go ning
+// o'z xatoliklari (sintaksis, validatsiya) "graphql_validation_failed" kodini oladi.
+func (h *Handler) localize(c *gin.Context, errs []gqlerrors.FormattedError) []gqlerrors.FormattedError {
+	requestID := c.GetString(middleware.RequestIDKey)
+	for i, formatted := range errs {
+		extensions := map[string]interface{}{"request_id": requestID}
+
+		var appErr *apperrors.Error
+		if errors.As(cause(formatted), &appErr) {
+			if appErr.Kind == apperrors.KindInternal {
+				log.Printf("graphql request %s: %v", requestID, appErr.Err)
+			}
+			message, details := middleware.Localize(middleware.GetLocale(c), appErr)
+			formatted.Message = message
+			extensions["code"] = appErr.Code
+			if len(details) > 0 {
+				extensions["details"] = details
+			}
+		} else {
+			extensions["code"] = "graphql_validation_failed"
+		}
+
+		formatted.Extensions = extensions
+		errs[i] = formatted
+	}
+	return errs
+}
+
+// cause graphql-go o'ragan xatolikdan resolver qaytargan asl xatolikni ochib oladi.
+// Thunk xatoliklari FormattedError → *gqlerrors.Error → FormattedError kabi bir necha
+// qavat o'raladi.
+func cause(err error) error {
+	for {
+		var next error
+		switch wrapped := err.(type) {
+		case gqlerrors.FormattedError:
+			next = wrapped.OriginalError()
+		case *gqlerrors.Error:
+			next = wrapped.OriginalError
+		}
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
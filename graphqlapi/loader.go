@@ -0,0 +1,145 @@
+package graphqlapi
+
+import (
+	"context"
+	"sync"
+
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+// loader bitta so'rov davomida so'ralgan kalitlarni yig'ib, ularni bitta fetch chaqiruvida
+// oladi (dataloader). graphql-go resolverlar qaytargan thunklarni kenglik bo'yicha hal
+// qiladi: bir darajadagi barcha resolverlar load ni chaqirib bo'lgach birinchi thunk
+// chaqiriladi va shu paytgacha yig'ilgan barcha kalitlar bitta so'rovda olinadi.
+// Shuning uchun N ta vakansiyaning kompaniyasi N ta emas, bitta so'rov.
+type loader[V any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, keys []uuid.UUID) (map[uuid.UUID]V, error)
+
+	mu      sync.Mutex
+	pending []uuid.UUID
+	queued  map[uuid.UUID]bool
+	done    map[uuid.UUID]loaded[V]
+}
+
+type loaded[V any] struct {
+	value V
+	ok    bool
+	err   error
+}
+
+func newLoader[V any](ctx context.Context, fetch func(ctx context.Context, keys []uuid.UUID) (map[uuid.UUID]V, error)) *loader[V] {
+	return &loader[V]{
+		ctx:    ctx,
+		fetch:  fetch,
+		queued: make(map[uuid.UUID]bool),
+		done:   make(map[uuid.UUID]loaded[V]),
+	}
+}
+
+// load kalitni navbatga qo'yadi va qiymatni qaytaradigan thunkni beradi. ok false -
+// kalit bo'yicha yozuv topilmadi. Bir xil kalit bir so'rovda faqat bir marta olinadi.
+func (l *loader[V]) load(key uuid.UUID) func() (V, bool, error) {
+	l.mu.Lock()
+	if _, ok := l.done[key]; !ok && !l.queued[key] {
+		l.queued[key] = true
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (V, bool, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if _, ok := l.done[key]; !ok {
+			l.flush()
+		}
+		result := l.done[key]
+		return result.value, result.ok, result.err
+	}
+}
+
+// flush navbatdagi barcha kalitlarni bitta fetch bilan oladi. Xatolik shu partiyadagi
+// barcha kalitlarga yoziladi.
+func (l *loader[V]) flush() {
+	keys := l.pending
+	l.pending = nil
+	values, err := l.fetch(l.ctx, keys)
+	for _, key := range keys {
+		value, ok := values[key]
+		l.done[key] = loaded[V]{value: value, ok: ok, err: err}
+		delete(l.queued, key)
+	}
+}
+
+// byID ro'yxatni ID bo'yicha xaritaga aylantiradigan fetch yasaydi
+func byID[V any](get func(ctx context.Context, ids []uuid.UUID) ([]V, error), id func(V) uuid.UUID) func(context.Context, []uuid.UUID) (map[uuid.UUID]V, error) {
+	return func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]V, error) {
+		values, err := get(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[uuid.UUID]V, len(values))
+		for _, value := range values {
+			result[id(value)] = value
+		}
+		return result, nil
+	}
+}
+
+// groupBy ro'yxatni ota yozuv ID si bo'yicha guruhlaydigan fetch yasaydi. Tartib
+// repository qaytargan tartib (created_at) saqlanadi.
+func groupBy[V any](get func(ctx context.Context, ids []uuid.UUID) ([]V, error), parent func(V) uuid.UUID) func(context.Context, []uuid.UUID) (map[uuid.UUID][]V, error) {
+	return func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]V, error) {
+		values, err := get(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[uuid.UUID][]V, len(ids))
+		for _, value := range values {
+			result[parent(value)] = append(result[parent(value)], value)
+		}
+		return result, nil
+	}
+}
+
+// loaders - bitta so'rovning barcha loaderlari. Har so'rovda yangidan yaratiladi,
+// shuning uchun kesh so'rovlar orasida bo'linmaydi va eskirmaydi.
+type loaders struct {
+	companies  *loader[models.Company]
+	vacancies  *loader[models.Vacancy]
+	recruiters *loader[models.Recruiter]
+	users      *loader[models.User]
+
+	vacanciesByCompany  *loader[[]models.Vacancy]
+	recruitersByCompany *loader[[]models.Recruiter]
+	interviewsByVacancy *loader[[]models.Interview]
+	interviewsByUser    *loader[[]models.Interview]
+	resumesByUser       *loader[[]models.Resume]
+}
+
+func (h *Handler) newLoaders(ctx context.Context) *loaders {
+	return &loaders{
+		companies:  newLoader(ctx, byID(h.Companies.GetCompaniesByIDs, func(c models.Company) uuid.UUID { return c.ID })),
+		vacancies:  newLoader(ctx, byID(h.Vacancies.GetVacanciesByIDs, func(v models.Vacancy) uuid.UUID { return v.ID })),
+		recruiters: newLoader(ctx, byID(h.Recruiters.GetRecruitersByIDs, func(r models.Recruiter) uuid.UUID { return r.ID })),
+		users:      newLoader(ctx, byID(h.Users.GetUsersByIDs, func(u models.User) uuid.UUID { return u.ID })),
+
+		vacanciesByCompany:  newLoader(ctx, groupBy(h.Vacancies.GetVacanciesByCompanyIDs, func(v models.Vacancy) uuid.UUID { return v.CompanyID })),
+		recruitersByCompany: newLoader(ctx, groupBy(h.Recruiters.GetRecruitersByCompanyIDs, func(r models.Recruiter) uuid.UUID { return r.CompanyID })),
+		interviewsByVacancy: newLoader(ctx, groupBy(h.Interviews.GetInterviewsByVacancyIDs, func(i models.Interview) uuid.UUID { return i.VacancyID })),
+		interviewsByUser:    newLoader(ctx, groupBy(h.Interviews.GetInterviewsByUserIDs, func(i models.Interview) uuid.UUID { return i.UserID })),
+		resumesByUser:       newLoader(ctx, groupBy(h.Resumes.GetResumesByUserIDs, func(r models.Resume) uuid.UUID { return r.UserID })),
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,348 @@
+package graphqlapi
+
+import (
+	"strconv"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Ro'yxat maydonlaridagi first argumenti: berilmasa DefaultFirst, MaxFirst dan ko'p bo'lmaydi.
+// Ichki ro'yxatlarda first har bir ota yozuvga alohida qo'llanadi.
+const (
+	DefaultFirst = 20
+	MaxFirst     = 100
+)
+
+// uuidScalar ID larni matn sifatida chiqaradi va kirishda UUID formatini tekshiradi
+var uuidScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "UUID",
+	Description: "RFC 4122 UUID, masalan \"0b1a5a3e-7c55-4f2a-9d67-3f1a0e4c2b11\"",
+	Serialize: func(value interface{}) interface{} {
+		switch value := value.(type) {
+		case uuid.UUID:
+			return value.String()
+		case string:
+			return value
+		}
+		return nil
+	},
+	ParseValue: func(value interface{}) interface{} {
+		if text, ok := value.(string); ok {
+			if id, err := uuid.Parse(text); err == nil {
+				return id
+			}
+		}
+		return nil
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		if text, ok := valueAST.(*ast.StringValue); ok {
+			if id, err := uuid.Parse(text.Value); err == nil {
+				return id
+			}
+		}
+		return nil
+	},
+})
+
+// NewSchema models dagi yozuvlar va ular orasidagi bog'lanishlarni
+// (Company → Vacancies → Interviews → User → Resumes) ifodalaydigan sxemani quradi.
+// Maydon nomlari REST JSON javoblaridagi kabi snake_case. Bog'langan yozuvlar
+// so'rov loaderlari orqali partiyalab olinadi.
+func NewSchema() (graphql.Schema, error) {
+	var companyType, vacancyType, recruiterType, interviewType, userType, resumeType *graphql.Object
+
+	companyType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Company",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return withMeta(graphql.Fields{
+				"name":     {Type: graphql.NewNonNull(graphql.String)},
+				"location": {Type: graphql.NewNonNull(graphql.String)},
+				"workers":  {Type: graphql.NewNonNull(graphql.Int)},
+				"vacancies": listField(vacancyType, nil, func(p graphql.ResolveParams, first int) (interface{}, error) {
+					return many(loadersFrom(p.Context).vacanciesByCompany, p.Source.(models.Company).ID, first), nil
+				}),
+				"recruiters": listField(recruiterType, nil, func(p graphql.ResolveParams, first int) (interface{}, error) {
+					return many(loadersFrom(p.Context).recruitersByCompany, p.Source.(models.Company).ID, first), nil
+				}),
+			})
+		}),
+	})
+
+	vacancyType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Vacancy",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return withMeta(graphql.Fields{
+				"name":        {Type: graphql.NewNonNull(graphql.String)},
+				"position":    {Type: graphql.NewNonNull(graphql.String)},
+				"min_exp":     {Type: graphql.NewNonNull(graphql.Int)},
+				"company_id":  {Type: graphql.NewNonNull(uuidScalar)},
+				"description": {Type: graphql.NewNonNull(graphql.String)},
+				"company": {Type: companyType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return one(loadersFrom(p.Context).companies, p.Source.(models.Vacancy).CompanyID), nil
+				}},
+				"interviews": listField(interviewType, nil, func(p graphql.ResolveParams, first int) (interface{}, error) {
+					return many(loadersFrom(p.Context).interviewsByVacancy, p.Source.(models.Vacancy).ID, first), nil
+				}),
+			})
+		}),
+	})
+
+	recruiterType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Recruiter",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return withMeta(graphql.Fields{
+				"name":         {Type: graphql.NewNonNull(graphql.String)},
+				"email":        {Type: graphql.NewNonNull(graphql.String)},
+				"phone_number": {Type: graphql.NewNonNull(graphql.String)},
+				"birthday":     {Type: graphql.NewNonNull(graphql.DateTime)},
+				"gender":       {Type: graphql.NewNonNull(graphql.String)},
+				"company_id":   {Type: graphql.NewNonNull(uuidScalar)},
+				"company": {Type: companyType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return one(loadersFrom(p.Context).companies, p.Source.(models.Recruiter).CompanyID), nil
+				}},
+			})
+		}),
+	})
+
+	interviewType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Interview",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return withMeta(graphql.Fields{
+				"user_id":        {Type: graphql.NewNonNull(uuidScalar)},
+				"vacancy_id":     {Type: graphql.NewNonNull(uuidScalar)},
+				"recruiter_id":   {Type: graphql.NewNonNull(uuidScalar)},
+				"interview_date": {Type: graphql.NewNonNull(graphql.DateTime)},
+				"user": {Type: userType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return one(loadersFrom(p.Context).users, p.Source.(models.Interview).UserID), nil
+				}},
+				"vacancy": {Type: vacancyType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return one(loadersFrom(p.Context).vacancies, p.Source.(models.Interview).VacancyID), nil
+				}},
+				"recruiter": {Type: recruiterType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return one(loadersFrom(p.Context).recruiters, p.Source.(models.Interview).RecruiterID), nil
+				}},
+			})
+		}),
+	})
+
+	userType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return withMeta(graphql.Fields{
+				"name":         {Type: graphql.NewNonNull(graphql.String)},
+				"email":        {Type: graphql.NewNonNull(graphql.String)},
+				"phone_number": {Type: graphql.NewNonNull(graphql.String)},
+				"birthday":     {Type: graphql.NewNonNull(graphql.DateTime)},
+				"gender":       {Type: graphql.NewNonNull(graphql.String)},
+				"resumes": listField(resumeType, nil, func(p graphql.ResolveParams, first int) (interface{}, error) {
+					return many(loadersFrom(p.Context).resumesByUser, p.Source.(models.User).ID, first), nil
+				}),
+				"interviews": listField(interviewType, nil, func(p graphql.ResolveParams, first int) (interface{}, error) {
+					return many(loadersFrom(p.Context).interviewsByUser, p.Source.(models.User).ID, first), nil
+				}),
+			})
+		}),
+	})
+
+	resumeType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Resume",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return withMeta(graphql.Fields{
+				"position":    {Type: graphql.NewNonNull(graphql.String)},
+				"experience":  {Type: graphql.NewNonNull(graphql.Int)},
+				"description": {Type: graphql.NewNonNull(graphql.String)},
+				"languages":   {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+				"user_id":     {Type: graphql.NewNonNull(uuidScalar)},
+				"user": {Type: userType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return one(loadersFrom(p.Context).users, p.Source.(models.Resume).UserID), nil
+				}},
+			})
+		}),
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"company": byIDField(companyType, func(p graphql.ResolveParams, id uuid.UUID) (interface{}, error) {
+				return handlerFrom(p).Companies.GetCompanyByID(p.Context, id.String())
+			}),
+			"companies": listField(companyType, nil, func(p graphql.ResolveParams, first int) (interface{}, error) {
+				companies, err := handlerFrom(p).Companies.GetAllCompanies(p.Context)
+				return page(companies, first), err
+			}),
+			"vacancy": byIDField(vacancyType, func(p graphql.ResolveParams, id uuid.UUID) (interface{}, error) {
+				return handlerFrom(p).Vacancies.GetVacancyByID(p.Context, id)
+			}),
+			"vacancies": listField(vacancyType, graphql.FieldConfigArgument{
+				"position":   {Type: graphql.String},
+				"min_exp":    {Type: graphql.Int},
+				"company_id": {Type: uuidScalar},
+			}, func(p graphql.ResolveParams, first int) (interface{}, error) {
+				filter := map[string]interface{}{}
+				if position, ok := p.Args["position"].(string); ok {
+					filter["position"] = position
+				}
+				if minExp, ok := p.Args["min_exp"].(int); ok {
+					filter["min_exp"] = minExp
+				}
+				if companyID, ok := p.Args["company_id"].(uuid.UUID); ok {
+					filter["company_id"] = companyID
+				}
+				vacancies, err := handlerFrom(p).Vacancies.GetAllVacancies(p.Context, filter)
+				return page(vacancies, first), err
+			}),
+			"recruiter": byIDField(recruiterType, func(p graphql.ResolveParams, id uuid.UUID) (interface{}, error) {
+				return handlerFrom(p).Recruiters.GetRecruiterByID(p.Context, id.String())
+			}),
+			"recruiters": listField(recruiterType, graphql.FieldConfigArgument{
+				"company_id": {Type: uuidScalar},
+			}, func(p graphql.ResolveParams, first int) (interface{}, error) {
+				var companyID string
+				if id, ok := p.Args["company_id"].(uuid.UUID); ok {
+					companyID = id.String()
+				}
+				recruiters, err := handlerFrom(p).Recruiters.GetAllRecruiters(p.Context, 0, "", companyID)
+				return page(recruiters, first), err
+			}),
+			"user": byIDField(userType, func(p graphql.ResolveParams, id uuid.UUID) (interface{}, error) {
+				return handlerFrom(p).Users.GetUserByID(p.Context, id.String())
+			}),
+			"users": listField(userType, graphql.FieldConfigArgument{
+				"gender": {Type: graphql.String},
+			}, func(p graphql.ResolveParams, first int) (interface{}, error) {
+				filters := map[string]interface{}{}
+				if gender, ok := p.Args["gender"].(string); ok {
+					filters["gender"] = gender
+				}
+				users, err := handlerFrom(p).Users.GetAllUsers(p.Context, filters)
+				return page(users, first), err
+			}),
+			"resume": byIDField(resumeType, func(p graphql.ResolveParams, id uuid.UUID) (interface{}, error) {
+				return handlerFrom(p).Resumes.GetResumeByID(p.Context, id)
+			}),
+			"interview": byIDField(interviewType, func(p graphql.ResolveParams, id uuid.UUID) (interface{}, error) {
+				return handlerFrom(p).Interviews.GetInterviewByID(p.Context, id)
+			}),
+			"interviews": listField(interviewType, graphql.FieldConfigArgument{
+				"company_id": {Type: uuidScalar},
+				"position":   {Type: graphql.String},
+			}, func(p graphql.ResolveParams, first int) (interface{}, error) {
+				filter := map[string]interface{}{}
+				if companyID, ok := p.Args["company_id"].(uuid.UUID); ok {
+					filter["company_id"] = companyID
+				}
+				if position, ok := p.Args["position"].(string); ok {
+					filter["position"] = position
+				}
+				interviews, err := handlerFrom(p).Interviews.GetAllInterviews(p.Context, filter)
+				return page(interviews, first), err
+			}),
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// withMeta barcha yozuvlarda bor id, vaqt belgilari va versiya maydonlarini qo'shadi
+func withMeta(fields graphql.Fields) graphql.Fields {
+	fields["id"] = &graphql.Field{Type: graphql.NewNonNull(uuidScalar)}
+	fields["created_at"] = &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)}
+	fields["updated_at"] = &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)}
+	fields["deleted_at"] = &graphql.Field{Type: graphql.NewNonNull(graphql.Int)}
+	fields["version"] = &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "REST javoblaridagi ETag qiymati"}
+	return fields
+}
+
+// byIDField bitta yozuvni id bo'yicha oladigan maydon. Yozuv topilmasa xatolik emas, null qaytadi.
+func byIDField(object *graphql.Object, get func(p graphql.ResolveParams, id uuid.UUID) (interface{}, error)) *graphql.Field {
+	return &graphql.Field{
+		Type: object,
+		Args: graphql.FieldConfigArgument{
+			"id": {Type: graphql.NewNonNull(uuidScalar)},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			value, err := get(p, p.Args["id"].(uuid.UUID))
+			if apperrors.Is(err, apperrors.KindNotFound) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return value, nil
+		},
+	}
+}
+
+// listField first argumentli ro'yxat maydoni. filters - qo'shimcha ixtiyoriy argumentlar (nil bo'lishi mumkin).
+func listField(object *graphql.Object, filters graphql.FieldConfigArgument, resolve func(p graphql.ResolveParams, first int) (interface{}, error)) *graphql.Field {
+	args := graphql.FieldConfigArgument{
+		"first": {Type: graphql.Int, DefaultValue: DefaultFirst, Description: "Qaytariladigan yozuvlar soni, 1.." + strconv.Itoa(MaxFirst)},
+	}
+	for name, arg := range filters {
+		args[name] = arg
+	}
+
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(object))),
+		Args: args,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			first, _ := p.Args["first"].(int)
+			if first < 1 || first > MaxFirst {
+				return nil, apperrors.Validation("validation_failed", "Request validation failed", apperrors.FieldError{
+					Field:   "first",
+					Message: "Must be between 1 and " + strconv.Itoa(MaxFirst),
+					Rule:    "max",
+					Param:   strconv.Itoa(MaxFirst),
+				})
+			}
+			return resolve(p, first)
+		},
+	}
+}
+
+// one bog'langan bitta yozuvni loader orqali oladigan thunk qaytaradi, yozuv topilmasa null
+func one[V any](l *loader[V], key uuid.UUID) func() (interface{}, error) {
+	thunk := l.load(key)
+	return func() (interface{}, error) {
+		value, ok, err := thunk()
+		if err != nil || !ok {
+			return nil, err
+		}
+		return value, nil
+	}
+}
+
+// many bog'langan ro'yxatni loader orqali oladigan thunk qaytaradi
+func many[V any](l *loader[[]V], key uuid.UUID, first int) func() (interface{}, error) {
+	thunk := l.load(key)
+	return func() (interface{}, error) {
+		values, _, err := thunk()
+		if err != nil {
+			return nil, err
+		}
+		return page(values, first), nil
+	}
+}
+
+// page ro'yxatning birinchi first ta elementini qaytaradi, nil o'rniga bo'sh ro'yxat
+func page[V any](values []V, first int) []V {
+	if len(values) > first {
+		values = values[:first]
+	}
+	if values == nil {
+		return []V{}
+	}
+	return values
+}
+
+type handlerKey struct{}
+
+// handlerFrom resolverlarga so'rovni bajarayotgan Handler ni (repositorylarni) beradi
+func handlerFrom(p graphql.ResolveParams) *Handler {
+	return p.Context.Value(handlerKey{}).(*Handler)
+}
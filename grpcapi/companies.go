@@ -0,0 +1,120 @@
+package grpcapi
+
+import (
+	"context"
+
+	"hrplatform/audit"
+	"hrplatform/grpcapi/hrpb"
+	"hrplatform/models"
+	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/validation"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CompanyServer - handlers.CompanyHandler ning gRPC dagi juftligi
+type CompanyServer struct {
+	hrpb.UnimplementedCompanyServiceServer
+	CompanyRepository postgres.CompanyRepository
+	Service           *service.CompanyService
+	Audit             *audit.Recorder
+}
+
+func (s *CompanyServer) CreateCompany(ctx context.Context, req *hrpb.CreateCompanyRequest) (*hrpb.Company, error) {
+	companyCreate := models.CreateCompany{Name: req.Name, Location: req.Location, Workers: int(req.Workers)}
+	if err := validation.Struct(&companyCreate); err != nil {
+		return nil, err
+	}
+
+	company, err := s.CompanyRepository.CreateCompany(ctx, companyCreate)
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityCompany, company.ID.String(), audit.ActionCreate, nil, company)
+	return companyToProto(company), nil
+}
+
+func (s *CompanyServer) GetCompany(ctx context.Context, req *hrpb.GetCompanyRequest) (*hrpb.Company, error) {
+	companyID, err := parseID(req.Id, "company")
+	if err != nil {
+		return nil, err
+	}
+	company, err := s.CompanyRepository.GetCompanyByID(ctx, companyID.String())
+	if err != nil {
+		return nil, err
+	}
+	return companyToProto(company), nil
+}
+
+func (s *CompanyServer) ListCompanies(req *hrpb.ListCompaniesRequest, stream hrpb.CompanyService_ListCompaniesServer) error {
+	companies, err := s.CompanyRepository.GetAllCompanies(stream.Context())
+	if err != nil {
+		return err
+	}
+	for _, company := range companies {
+		if err := stream.Send(companyToProto(company)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *CompanyServer) UpdateCompany(ctx context.Context, req *hrpb.UpdateCompanyRequest) (*hrpb.Company, error) {
+	companyID, err := parseID(req.Id, "company")
+	if err != nil {
+		return nil, err
+	}
+	companyUpdate := models.UpdateCompany{ID: companyID, Name: req.Name, Location: req.Location, Workers: int(req.Workers)}
+	if err := validation.Struct(&companyUpdate); err != nil {
+		return nil, err
+	}
+
+	before, err := s.CompanyRepository.GetCompanyByID(ctx, companyID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+	companyUpdate.Version = before.Version
+	if err := s.CompanyRepository.UpdateCompany(ctx, companyUpdate); err != nil {
+		return nil, err
+	}
+
+	after, err := s.CompanyRepository.GetCompanyByID(ctx, companyID.String())
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityCompany, companyID.String(), audit.ActionUpdate, before, after)
+	return companyToProto(after), nil
+}
+
+func (s *CompanyServer) DeleteCompany(ctx context.Context, req *hrpb.DeleteCompanyRequest) (*emptypb.Empty, error) {
+	companyID, err := parseID(req.Id, "company")
+	if err != nil {
+		return nil, err
+	}
+	before, err := s.CompanyRepository.GetCompanyByID(ctx, companyID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.Service.Delete(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	source := auditSource(ctx)
+	for _, vacancy := range deleted.Vacancies {
+		s.Audit.RecordContext(ctx, source, audit.EntityVacancy, vacancy.ID.String(), audit.ActionDelete, vacancy, nil)
+	}
+	for _, recruiter := range deleted.Recruiters {
+		s.Audit.RecordContext(ctx, source, audit.EntityRecruiter, recruiter.ID.String(), audit.ActionDelete, recruiter, nil)
+	}
+	s.Audit.RecordContext(ctx, source, audit.EntityCompany, companyID.String(), audit.ActionDelete, before, nil)
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,143 @@
+package grpcapi
+
+import (
+	"hrplatform/apperrors"
+	"hrplatform/grpcapi/hrpb"
+	"hrplatform/models"
+	"hrplatform/validation"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// parseID so'ralgan yozuv IDsi, noto'g'ri bo'lsa REST dagi kabi "invalid_id"
+func parseID(id, what string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, apperrors.Validation("invalid_id", "Invalid "+what+" ID")
+	}
+	return parsed, nil
+}
+
+// parseRef so'rov tanasidagi havola (user_id, company_id ...). Bo'sh qiymat uuid.Nil
+// bo'lib qoladi va `binding:"required"` tekshiruvida ushlanadi.
+func parseRef(id, field string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, apperrors.Validation("invalid_id", "Invalid "+field).WithField(field, "must be a UUID")
+	}
+	return parsed, nil
+}
+
+// checkVersion If-Match tekshiruvining gRPC dagi ko'rinishi: so'rovdagi version yozuvning
+// joriy versiyasiga teng bo'lishi kerak
+func checkVersion(requested, current int64) error {
+	if requested == 0 {
+		return apperrors.PreconditionRequired("version_required", "Record version is required")
+	}
+	if requested != current {
+		return apperrors.PreconditionFailed("version_mismatch", "The record was modified by another request")
+	}
+	return nil
+}
+
+func userToProto(u models.User) *hrpb.User {
+	return &hrpb.User{
+		Id:          u.ID.String(),
+		Name:        u.Name,
+		Email:       u.Email,
+		PhoneNumber: u.PhoneNumber,
+		Birthday:    u.Birthday.Format(validation.DateLayout),
+		Gender:      u.Gender,
+		CreatedAt:   timestamppb.New(u.CreatedAt),
+		UpdatedAt:   timestamppb.New(u.UpdatedAt),
+		Version:     u.Version,
+	}
+}
+
+func resumeToProto(r models.Resume) *hrpb.Resume {
+	return &hrpb.Resume{
+		Id:          r.ID.String(),
+		Position:    r.Position,
+		Experience:  int32(r.Experience),
+		Description: r.Description,
+		Languages:   r.Languages,
+		UserId:      r.UserID.String(),
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+		UpdatedAt:   timestamppb.New(r.UpdatedAt),
+		Version:     r.Version,
+	}
+}
+
+func resumeWithUserToProto(r models.ResumeWithUser) *hrpb.Resume {
+	return &hrpb.Resume{
+		Id:          r.ID.String(),
+		Position:    r.Position,
+		Experience:  int32(r.Experience),
+		Description: r.Description,
+		Languages:   r.Languages,
+		UserId:      r.UserID.String(),
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+		UpdatedAt:   timestamppb.New(r.UpdatedAt),
+		Version:     r.Version,
+		UserName:    r.UserName,
+		UserEmail:   r.UserEmail,
+	}
+}
+
+func companyToProto(c models.Company) *hrpb.Company {
+	return &hrpb.Company{
+		Id:        c.ID.String(),
+		Name:      c.Name,
+		Location:  c.Location,
+		Workers:   int32(c.Workers),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+		Version:   c.Version,
+	}
+}
+
+func recruiterToProto(r models.Recruiter) *hrpb.Recruiter {
+	return &hrpb.Recruiter{
+		Id:          r.ID.String(),
+		Name:        r.Name,
+		Email:       r.Email,
+		PhoneNumber: r.PhoneNumber,
+		Birthday:    r.Birthday.Format(validation.DateLayout),
+		Gender:      r.Gender,
+		CompanyId:   r.CompanyID.String(),
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+		UpdatedAt:   timestamppb.New(r.UpdatedAt),
+		Version:     r.Version,
+	}
+}
+
+func vacancyToProto(v models.Vacancy) *hrpb.Vacancy {
+	return &hrpb.Vacancy{
+		Id:          v.ID.String(),
+		Name:        v.Name,
+		Position:    v.Position,
+		MinExp:      int32(v.MinExp),
+		CompanyId:   v.CompanyID.String(),
+		Description: v.Description,
+		CreatedAt:   timestamppb.New(v.CreatedAt),
+		UpdatedAt:   timestamppb.New(v.UpdatedAt),
+		Version:     v.Version,
+	}
+}
+
+func interviewToProto(i models.Interview) *hrpb.Interview {
+	return &hrpb.Interview{
+		Id:            i.ID.String(),
+		UserId:        i.UserID.String(),
+		VacancyId:     i.VacancyID.String(),
+		RecruiterId:   i.RecruiterID.String(),
+		InterviewDate: timestamppb.New(i.InterviewDate),
+		CreatedAt:     timestamppb.New(i.CreatedAt),
+		UpdatedAt:     timestamppb.New(i.UpdatedAt),
+		Version:       i.Version,
+	}
+}
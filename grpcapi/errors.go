@@ -0,0 +1,71 @@
+package grpcapi
+
+import (
+	"log"
+
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain - google.rpc.ErrorInfo dagi domen
+const ErrorDomain = "hrplatform"
+
+// Code xatolik turiga mos gRPC kodini qaytaradi (middleware.StatusCode ning gRPC dagi juftligi)
+func Code(kind apperrors.Kind) codes.Code {
+	switch kind {
+	case apperrors.KindNotFound:
+		return codes.NotFound
+	case apperrors.KindConflict:
+		return codes.AlreadyExists
+	case apperrors.KindValidation:
+		return codes.InvalidArgument
+	case apperrors.KindForbidden:
+		return codes.PermissionDenied
+	case apperrors.KindTimeout:
+		return codes.DeadlineExceeded
+	case apperrors.KindUnprocessable, apperrors.KindPreconditionRequired:
+		return codes.FailedPrecondition
+	case apperrors.KindPreconditionFailed:
+		return codes.Aborted
+	}
+	return codes.Internal
+}
+
+// statusError domen xatoligini gRPC statusiga aylantiradi. Matn chaqiruv tiliga
+// tarjima qilinadi, barqaror kod ErrorInfo.Reason da, maydon xatoliklari BadRequest da
+// qaytadi. Tayyor gRPC statuslari (masalan stream.Send xatoligi) o'zgarishsiz o'tadi.
+func statusError(info requestInfo, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	appErr := apperrors.From(err)
+	if appErr.Kind == apperrors.KindInternal {
+		log.Printf("grpc request %s: %v", info.ID, appErr.Err)
+	}
+
+	message, fields := middleware.Localize(info.Locale, appErr)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   appErr.Code,
+		Domain:   ErrorDomain,
+		Metadata: map[string]string{"request_id": info.ID},
+	}}
+	if len(fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, field := range fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: field.Field, Description: field.Message})
+		}
+		details = append(details, badRequest)
+	}
+
+	st := status.New(Code(appErr.Kind), message)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
@@ -0,0 +1,1665 @@
+// HR platform gRPC API. REST API (api/router.go) bilan bir xil imkoniyatlar:
+// foydalanuvchilar, rezyumelar, kompaniyalar, rekruiterlar, vakansiyalar va intervyular.
+//
+// Update va Delete so'rovlaridagi version - REST dagi If-Match ning o'rnida: yozuvning
+// oxirgi o'qilgan versiyasi. Bo'sh bo'lsa FAILED_PRECONDITION (version_required),
+// mos kelmasa ABORTED (version_mismatch) qaytadi.
+//
+// Xatoliklar google.rpc.ErrorInfo (reason - barqaror kod, masalan user_not_found) va
+// maydon xatoliklari uchun google.rpc.BadRequest tafsilotlari bilan qaytadi.
+// Metadata: x-request-id, x-actor-id (audit), accept-language (uz, ru, en).
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: hrpb/hrplatform.proto
+
+package hrpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	UserService_CreateUser_FullMethodName = "/hrplatform.v1.UserService/CreateUser"
+	UserService_GetUser_FullMethodName    = "/hrplatform.v1.UserService/GetUser"
+	UserService_ListUsers_FullMethodName  = "/hrplatform.v1.UserService/ListUsers"
+	UserService_UpdateUser_FullMethodName = "/hrplatform.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName = "/hrplatform.v1.UserService/DeleteUser"
+)
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserServiceClient interface {
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (UserService_ListUsersClient, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (UserService_ListUsersClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_ListUsers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceListUsersClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_ListUsersClient interface {
+	Recv() (*User, error)
+	grpc.ClientStream
+}
+
+type userServiceListUsersClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceListUsersClient) Recv() (*User, error) {
+	m := new(User)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility
+type UserServiceServer interface {
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	GetUser(context.Context, *GetUserRequest) (*User, error)
+	ListUsers(*ListUsersRequest, UserService_ListUsersServer) error
+	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserServiceServer struct {
+}
+
+func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(*ListUsersRequest, UserService_ListUsersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).ListUsers(m, &userServiceListUsersServer{ServerStream: stream})
+}
+
+type UserService_ListUsersServer interface {
+	Send(*User) error
+	grpc.ServerStream
+}
+
+type userServiceListUsersServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceListUsersServer) Send(m *User) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hrplatform.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserService_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListUsers",
+			Handler:       _UserService_ListUsers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hrpb/hrplatform.proto",
+}
+
+const (
+	ResumeService_CreateResume_FullMethodName = "/hrplatform.v1.ResumeService/CreateResume"
+	ResumeService_GetResume_FullMethodName    = "/hrplatform.v1.ResumeService/GetResume"
+	ResumeService_ListResumes_FullMethodName  = "/hrplatform.v1.ResumeService/ListResumes"
+	ResumeService_UpdateResume_FullMethodName = "/hrplatform.v1.ResumeService/UpdateResume"
+	ResumeService_DeleteResume_FullMethodName = "/hrplatform.v1.ResumeService/DeleteResume"
+)
+
+// ResumeServiceClient is the client API for ResumeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ResumeServiceClient interface {
+	CreateResume(ctx context.Context, in *CreateResumeRequest, opts ...grpc.CallOption) (*Resume, error)
+	GetResume(ctx context.Context, in *GetResumeRequest, opts ...grpc.CallOption) (*Resume, error)
+	ListResumes(ctx context.Context, in *ListResumesRequest, opts ...grpc.CallOption) (ResumeService_ListResumesClient, error)
+	UpdateResume(ctx context.Context, in *UpdateResumeRequest, opts ...grpc.CallOption) (*Resume, error)
+	DeleteResume(ctx context.Context, in *DeleteResumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type resumeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResumeServiceClient(cc grpc.ClientConnInterface) ResumeServiceClient {
+	return &resumeServiceClient{cc}
+}
+
+func (c *resumeServiceClient) CreateResume(ctx context.Context, in *CreateResumeRequest, opts ...grpc.CallOption) (*Resume, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resume)
+	err := c.cc.Invoke(ctx, ResumeService_CreateResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) GetResume(ctx context.Context, in *GetResumeRequest, opts ...grpc.CallOption) (*Resume, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resume)
+	err := c.cc.Invoke(ctx, ResumeService_GetResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) ListResumes(ctx context.Context, in *ListResumesRequest, opts ...grpc.CallOption) (ResumeService_ListResumesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResumeService_ServiceDesc.Streams[0], ResumeService_ListResumes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resumeServiceListResumesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResumeService_ListResumesClient interface {
+	Recv() (*Resume, error)
+	grpc.ClientStream
+}
+
+type resumeServiceListResumesClient struct {
+	grpc.ClientStream
+}
+
+func (x *resumeServiceListResumesClient) Recv() (*Resume, error) {
+	m := new(Resume)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *resumeServiceClient) UpdateResume(ctx context.Context, in *UpdateResumeRequest, opts ...grpc.CallOption) (*Resume, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resume)
+	err := c.cc.Invoke(ctx, ResumeService_UpdateResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) DeleteResume(ctx context.Context, in *DeleteResumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResumeService_DeleteResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResumeServiceServer is the server API for ResumeService service.
+// All implementations must embed UnimplementedResumeServiceServer
+// for forward compatibility
+type ResumeServiceServer interface {
+	CreateResume(context.Context, *CreateResumeRequest) (*Resume, error)
+	GetResume(context.Context, *GetResumeRequest) (*Resume, error)
+	ListResumes(*ListResumesRequest, ResumeService_ListResumesServer) error
+	UpdateResume(context.Context, *UpdateResumeRequest) (*Resume, error)
+	DeleteResume(context.Context, *DeleteResumeRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedResumeServiceServer()
+}
+
+// UnimplementedResumeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedResumeServiceServer struct {
+}
+
+func (UnimplementedResumeServiceServer) CreateResume(context.Context, *CreateResumeRequest) (*Resume, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResume not implemented")
+}
+func (UnimplementedResumeServiceServer) GetResume(context.Context, *GetResumeRequest) (*Resume, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResume not implemented")
+}
+func (UnimplementedResumeServiceServer) ListResumes(*ListResumesRequest, ResumeService_ListResumesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListResumes not implemented")
+}
+func (UnimplementedResumeServiceServer) UpdateResume(context.Context, *UpdateResumeRequest) (*Resume, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateResume not implemented")
+}
+func (UnimplementedResumeServiceServer) DeleteResume(context.Context, *DeleteResumeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResume not implemented")
+}
+func (UnimplementedResumeServiceServer) mustEmbedUnimplementedResumeServiceServer() {}
+
+// UnsafeResumeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResumeServiceServer will
+// result in compilation errors.
+type UnsafeResumeServiceServer interface {
+	mustEmbedUnimplementedResumeServiceServer()
+}
+
+func RegisterResumeServiceServer(s grpc.ServiceRegistrar, srv ResumeServiceServer) {
+	s.RegisterService(&ResumeService_ServiceDesc, srv)
+}
+
+func _ResumeService_CreateResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).CreateResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_CreateResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).CreateResume(ctx, req.(*CreateResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_GetResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).GetResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_GetResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).GetResume(ctx, req.(*GetResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_ListResumes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListResumesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResumeServiceServer).ListResumes(m, &resumeServiceListResumesServer{ServerStream: stream})
+}
+
+type ResumeService_ListResumesServer interface {
+	Send(*Resume) error
+	grpc.ServerStream
+}
+
+type resumeServiceListResumesServer struct {
+	grpc.ServerStream
+}
+
+func (x *resumeServiceListResumesServer) Send(m *Resume) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ResumeService_UpdateResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).UpdateResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_UpdateResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).UpdateResume(ctx, req.(*UpdateResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_DeleteResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).DeleteResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_DeleteResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).DeleteResume(ctx, req.(*DeleteResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResumeService_ServiceDesc is the grpc.ServiceDesc for ResumeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResumeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hrplatform.v1.ResumeService",
+	HandlerType: (*ResumeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateResume",
+			Handler:    _ResumeService_CreateResume_Handler,
+		},
+		{
+			MethodName: "GetResume",
+			Handler:    _ResumeService_GetResume_Handler,
+		},
+		{
+			MethodName: "UpdateResume",
+			Handler:    _ResumeService_UpdateResume_Handler,
+		},
+		{
+			MethodName: "DeleteResume",
+			Handler:    _ResumeService_DeleteResume_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListResumes",
+			Handler:       _ResumeService_ListResumes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hrpb/hrplatform.proto",
+}
+
+const (
+	CompanyService_CreateCompany_FullMethodName = "/hrplatform.v1.CompanyService/CreateCompany"
+	CompanyService_GetCompany_FullMethodName    = "/hrplatform.v1.CompanyService/GetCompany"
+	CompanyService_ListCompanies_FullMethodName = "/hrplatform.v1.CompanyService/ListCompanies"
+	CompanyService_UpdateCompany_FullMethodName = "/hrplatform.v1.CompanyService/UpdateCompany"
+	CompanyService_DeleteCompany_FullMethodName = "/hrplatform.v1.CompanyService/DeleteCompany"
+)
+
+// CompanyServiceClient is the client API for CompanyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CompanyServiceClient interface {
+	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (CompanyService_ListCompaniesClient, error)
+	UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*Company, error)
+	// Kompaniya vakansiyalari, rekruiterlari, ularning intervyulari va webhooklari bilan birga o'chadi
+	DeleteCompany(ctx context.Context, in *DeleteCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type companyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCompanyServiceClient(cc grpc.ClientConnInterface) CompanyServiceClient {
+	return &companyServiceClient{cc}
+}
+
+func (c *companyServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Company)
+	err := c.cc.Invoke(ctx, CompanyService_CreateCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Company)
+	err := c.cc.Invoke(ctx, CompanyService_GetCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (CompanyService_ListCompaniesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CompanyService_ServiceDesc.Streams[0], CompanyService_ListCompanies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &companyServiceListCompaniesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CompanyService_ListCompaniesClient interface {
+	Recv() (*Company, error)
+	grpc.ClientStream
+}
+
+type companyServiceListCompaniesClient struct {
+	grpc.ClientStream
+}
+
+func (x *companyServiceListCompaniesClient) Recv() (*Company, error) {
+	m := new(Company)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *companyServiceClient) UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*Company, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Company)
+	err := c.cc.Invoke(ctx, CompanyService_UpdateCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) DeleteCompany(ctx context.Context, in *DeleteCompanyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CompanyService_DeleteCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CompanyServiceServer is the server API for CompanyService service.
+// All implementations must embed UnimplementedCompanyServiceServer
+// for forward compatibility
+type CompanyServiceServer interface {
+	CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error)
+	GetCompany(context.Context, *GetCompanyRequest) (*Company, error)
+	ListCompanies(*ListCompaniesRequest, CompanyService_ListCompaniesServer) error
+	UpdateCompany(context.Context, *UpdateCompanyRequest) (*Company, error)
+	// Kompaniya vakansiyalari, rekruiterlari, ularning intervyulari va webhooklari bilan birga o'chadi
+	DeleteCompany(context.Context, *DeleteCompanyRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCompanyServiceServer()
+}
+
+// UnimplementedCompanyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCompanyServiceServer struct {
+}
+
+func (UnimplementedCompanyServiceServer) CreateCompany(context.Context, *CreateCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompany not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetCompany(context.Context, *GetCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompany not implemented")
+}
+func (UnimplementedCompanyServiceServer) ListCompanies(*ListCompaniesRequest, CompanyService_ListCompaniesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCompanies not implemented")
+}
+func (UnimplementedCompanyServiceServer) UpdateCompany(context.Context, *UpdateCompanyRequest) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompany not implemented")
+}
+func (UnimplementedCompanyServiceServer) DeleteCompany(context.Context, *DeleteCompanyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompany not implemented")
+}
+func (UnimplementedCompanyServiceServer) mustEmbedUnimplementedCompanyServiceServer() {}
+
+// UnsafeCompanyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CompanyServiceServer will
+// result in compilation errors.
+type UnsafeCompanyServiceServer interface {
+	mustEmbedUnimplementedCompanyServiceServer()
+}
+
+func RegisterCompanyServiceServer(s grpc.ServiceRegistrar, srv CompanyServiceServer) {
+	s.RegisterService(&CompanyService_ServiceDesc, srv)
+}
+
+func _CompanyService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).CreateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_CreateCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetCompany(ctx, req.(*GetCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_ListCompanies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCompaniesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CompanyServiceServer).ListCompanies(m, &companyServiceListCompaniesServer{ServerStream: stream})
+}
+
+type CompanyService_ListCompaniesServer interface {
+	Send(*Company) error
+	grpc.ServerStream
+}
+
+type companyServiceListCompaniesServer struct {
+	grpc.ServerStream
+}
+
+func (x *companyServiceListCompaniesServer) Send(m *Company) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CompanyService_UpdateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).UpdateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_UpdateCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).UpdateCompany(ctx, req.(*UpdateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_DeleteCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).DeleteCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_DeleteCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).DeleteCompany(ctx, req.(*DeleteCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CompanyService_ServiceDesc is the grpc.ServiceDesc for CompanyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CompanyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hrplatform.v1.CompanyService",
+	HandlerType: (*CompanyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCompany",
+			Handler:    _CompanyService_CreateCompany_Handler,
+		},
+		{
+			MethodName: "GetCompany",
+			Handler:    _CompanyService_GetCompany_Handler,
+		},
+		{
+			MethodName: "UpdateCompany",
+			Handler:    _CompanyService_UpdateCompany_Handler,
+		},
+		{
+			MethodName: "DeleteCompany",
+			Handler:    _CompanyService_DeleteCompany_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListCompanies",
+			Handler:       _CompanyService_ListCompanies_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hrpb/hrplatform.proto",
+}
+
+const (
+	RecruiterService_CreateRecruiter_FullMethodName = "/hrplatform.v1.RecruiterService/CreateRecruiter"
+	RecruiterService_GetRecruiter_FullMethodName    = "/hrplatform.v1.RecruiterService/GetRecruiter"
+	RecruiterService_ListRecruiters_FullMethodName  = "/hrplatform.v1.RecruiterService/ListRecruiters"
+	RecruiterService_UpdateRecruiter_FullMethodName = "/hrplatform.v1.RecruiterService/UpdateRecruiter"
+	RecruiterService_DeleteRecruiter_FullMethodName = "/hrplatform.v1.RecruiterService/DeleteRecruiter"
+)
+
+// RecruiterServiceClient is the client API for RecruiterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecruiterServiceClient interface {
+	CreateRecruiter(ctx context.Context, in *CreateRecruiterRequest, opts ...grpc.CallOption) (*Recruiter, error)
+	GetRecruiter(ctx context.Context, in *GetRecruiterRequest, opts ...grpc.CallOption) (*Recruiter, error)
+	ListRecruiters(ctx context.Context, in *ListRecruitersRequest, opts ...grpc.CallOption) (RecruiterService_ListRecruitersClient, error)
+	UpdateRecruiter(ctx context.Context, in *UpdateRecruiterRequest, opts ...grpc.CallOption) (*Recruiter, error)
+	// Rekruiter intervyulari bilan birga o'chadi
+	DeleteRecruiter(ctx context.Context, in *DeleteRecruiterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type recruiterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecruiterServiceClient(cc grpc.ClientConnInterface) RecruiterServiceClient {
+	return &recruiterServiceClient{cc}
+}
+
+func (c *recruiterServiceClient) CreateRecruiter(ctx context.Context, in *CreateRecruiterRequest, opts ...grpc.CallOption) (*Recruiter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recruiter)
+	err := c.cc.Invoke(ctx, RecruiterService_CreateRecruiter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recruiterServiceClient) GetRecruiter(ctx context.Context, in *GetRecruiterRequest, opts ...grpc.CallOption) (*Recruiter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recruiter)
+	err := c.cc.Invoke(ctx, RecruiterService_GetRecruiter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recruiterServiceClient) ListRecruiters(ctx context.Context, in *ListRecruitersRequest, opts ...grpc.CallOption) (RecruiterService_ListRecruitersClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecruiterService_ServiceDesc.Streams[0], RecruiterService_ListRecruiters_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &recruiterServiceListRecruitersClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RecruiterService_ListRecruitersClient interface {
+	Recv() (*Recruiter, error)
+	grpc.ClientStream
+}
+
+type recruiterServiceListRecruitersClient struct {
+	grpc.ClientStream
+}
+
+func (x *recruiterServiceListRecruitersClient) Recv() (*Recruiter, error) {
+	m := new(Recruiter)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *recruiterServiceClient) UpdateRecruiter(ctx context.Context, in *UpdateRecruiterRequest, opts ...grpc.CallOption) (*Recruiter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recruiter)
+	err := c.cc.Invoke(ctx, RecruiterService_UpdateRecruiter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recruiterServiceClient) DeleteRecruiter(ctx context.Context, in *DeleteRecruiterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecruiterService_DeleteRecruiter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecruiterServiceServer is the server API for RecruiterService service.
+// All implementations must embed UnimplementedRecruiterServiceServer
+// for forward compatibility
+type RecruiterServiceServer interface {
+	CreateRecruiter(context.Context, *CreateRecruiterRequest) (*Recruiter, error)
+	GetRecruiter(context.Context, *GetRecruiterRequest) (*Recruiter, error)
+	ListRecruiters(*ListRecruitersRequest, RecruiterService_ListRecruitersServer) error
+	UpdateRecruiter(context.Context, *UpdateRecruiterRequest) (*Recruiter, error)
+	// Rekruiter intervyulari bilan birga o'chadi
+	DeleteRecruiter(context.Context, *DeleteRecruiterRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedRecruiterServiceServer()
+}
+
+// UnimplementedRecruiterServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRecruiterServiceServer struct {
+}
+
+func (UnimplementedRecruiterServiceServer) CreateRecruiter(context.Context, *CreateRecruiterRequest) (*Recruiter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecruiter not implemented")
+}
+func (UnimplementedRecruiterServiceServer) GetRecruiter(context.Context, *GetRecruiterRequest) (*Recruiter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecruiter not implemented")
+}
+func (UnimplementedRecruiterServiceServer) ListRecruiters(*ListRecruitersRequest, RecruiterService_ListRecruitersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRecruiters not implemented")
+}
+func (UnimplementedRecruiterServiceServer) UpdateRecruiter(context.Context, *UpdateRecruiterRequest) (*Recruiter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecruiter not implemented")
+}
+func (UnimplementedRecruiterServiceServer) DeleteRecruiter(context.Context, *DeleteRecruiterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecruiter not implemented")
+}
+func (UnimplementedRecruiterServiceServer) mustEmbedUnimplementedRecruiterServiceServer() {}
+
+// UnsafeRecruiterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecruiterServiceServer will
+// result in compilation errors.
+type UnsafeRecruiterServiceServer interface {
+	mustEmbedUnimplementedRecruiterServiceServer()
+}
+
+func RegisterRecruiterServiceServer(s grpc.ServiceRegistrar, srv RecruiterServiceServer) {
+	s.RegisterService(&RecruiterService_ServiceDesc, srv)
+}
+
+func _RecruiterService_CreateRecruiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecruiterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecruiterServiceServer).CreateRecruiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecruiterService_CreateRecruiter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecruiterServiceServer).CreateRecruiter(ctx, req.(*CreateRecruiterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecruiterService_GetRecruiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecruiterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecruiterServiceServer).GetRecruiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecruiterService_GetRecruiter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecruiterServiceServer).GetRecruiter(ctx, req.(*GetRecruiterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecruiterService_ListRecruiters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRecruitersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecruiterServiceServer).ListRecruiters(m, &recruiterServiceListRecruitersServer{ServerStream: stream})
+}
+
+type RecruiterService_ListRecruitersServer interface {
+	Send(*Recruiter) error
+	grpc.ServerStream
+}
+
+type recruiterServiceListRecruitersServer struct {
+	grpc.ServerStream
+}
+
+func (x *recruiterServiceListRecruitersServer) Send(m *Recruiter) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RecruiterService_UpdateRecruiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecruiterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecruiterServiceServer).UpdateRecruiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecruiterService_UpdateRecruiter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecruiterServiceServer).UpdateRecruiter(ctx, req.(*UpdateRecruiterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecruiterService_DeleteRecruiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecruiterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecruiterServiceServer).DeleteRecruiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecruiterService_DeleteRecruiter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecruiterServiceServer).DeleteRecruiter(ctx, req.(*DeleteRecruiterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecruiterService_ServiceDesc is the grpc.ServiceDesc for RecruiterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecruiterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hrplatform.v1.RecruiterService",
+	HandlerType: (*RecruiterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRecruiter",
+			Handler:    _RecruiterService_CreateRecruiter_Handler,
+		},
+		{
+			MethodName: "GetRecruiter",
+			Handler:    _RecruiterService_GetRecruiter_Handler,
+		},
+		{
+			MethodName: "UpdateRecruiter",
+			Handler:    _RecruiterService_UpdateRecruiter_Handler,
+		},
+		{
+			MethodName: "DeleteRecruiter",
+			Handler:    _RecruiterService_DeleteRecruiter_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListRecruiters",
+			Handler:       _RecruiterService_ListRecruiters_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hrpb/hrplatform.proto",
+}
+
+const (
+	VacancyService_CreateVacancy_FullMethodName = "/hrplatform.v1.VacancyService/CreateVacancy"
+	VacancyService_GetVacancy_FullMethodName    = "/hrplatform.v1.VacancyService/GetVacancy"
+	VacancyService_ListVacancies_FullMethodName = "/hrplatform.v1.VacancyService/ListVacancies"
+	VacancyService_UpdateVacancy_FullMethodName = "/hrplatform.v1.VacancyService/UpdateVacancy"
+	VacancyService_DeleteVacancy_FullMethodName = "/hrplatform.v1.VacancyService/DeleteVacancy"
+)
+
+// VacancyServiceClient is the client API for VacancyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VacancyServiceClient interface {
+	CreateVacancy(ctx context.Context, in *CreateVacancyRequest, opts ...grpc.CallOption) (*Vacancy, error)
+	GetVacancy(ctx context.Context, in *GetVacancyRequest, opts ...grpc.CallOption) (*Vacancy, error)
+	ListVacancies(ctx context.Context, in *ListVacanciesRequest, opts ...grpc.CallOption) (VacancyService_ListVacanciesClient, error)
+	UpdateVacancy(ctx context.Context, in *UpdateVacancyRequest, opts ...grpc.CallOption) (*Vacancy, error)
+	// Vakansiya intervyulari bilan birga o'chadi, kompaniyaga vacancy.closed webhooki yuboriladi
+	DeleteVacancy(ctx context.Context, in *DeleteVacancyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type vacancyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVacancyServiceClient(cc grpc.ClientConnInterface) VacancyServiceClient {
+	return &vacancyServiceClient{cc}
+}
+
+func (c *vacancyServiceClient) CreateVacancy(ctx context.Context, in *CreateVacancyRequest, opts ...grpc.CallOption) (*Vacancy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vacancy)
+	err := c.cc.Invoke(ctx, VacancyService_CreateVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) GetVacancy(ctx context.Context, in *GetVacancyRequest, opts ...grpc.CallOption) (*Vacancy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vacancy)
+	err := c.cc.Invoke(ctx, VacancyService_GetVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ListVacancies(ctx context.Context, in *ListVacanciesRequest, opts ...grpc.CallOption) (VacancyService_ListVacanciesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VacancyService_ServiceDesc.Streams[0], VacancyService_ListVacancies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vacancyServiceListVacanciesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VacancyService_ListVacanciesClient interface {
+	Recv() (*Vacancy, error)
+	grpc.ClientStream
+}
+
+type vacancyServiceListVacanciesClient struct {
+	grpc.ClientStream
+}
+
+func (x *vacancyServiceListVacanciesClient) Recv() (*Vacancy, error) {
+	m := new(Vacancy)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vacancyServiceClient) UpdateVacancy(ctx context.Context, in *UpdateVacancyRequest, opts ...grpc.CallOption) (*Vacancy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vacancy)
+	err := c.cc.Invoke(ctx, VacancyService_UpdateVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) DeleteVacancy(ctx context.Context, in *DeleteVacancyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VacancyService_DeleteVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VacancyServiceServer is the server API for VacancyService service.
+// All implementations must embed UnimplementedVacancyServiceServer
+// for forward compatibility
+type VacancyServiceServer interface {
+	CreateVacancy(context.Context, *CreateVacancyRequest) (*Vacancy, error)
+	GetVacancy(context.Context, *GetVacancyRequest) (*Vacancy, error)
+	ListVacancies(*ListVacanciesRequest, VacancyService_ListVacanciesServer) error
+	UpdateVacancy(context.Context, *UpdateVacancyRequest) (*Vacancy, error)
+	// Vakansiya intervyulari bilan birga o'chadi, kompaniyaga vacancy.closed webhooki yuboriladi
+	DeleteVacancy(context.Context, *DeleteVacancyRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedVacancyServiceServer()
+}
+
+// UnimplementedVacancyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVacancyServiceServer struct {
+}
+
+func (UnimplementedVacancyServiceServer) CreateVacancy(context.Context, *CreateVacancyRequest) (*Vacancy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVacancy not implemented")
+}
+func (UnimplementedVacancyServiceServer) GetVacancy(context.Context, *GetVacancyRequest) (*Vacancy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVacancy not implemented")
+}
+func (UnimplementedVacancyServiceServer) ListVacancies(*ListVacanciesRequest, VacancyService_ListVacanciesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListVacancies not implemented")
+}
+func (UnimplementedVacancyServiceServer) UpdateVacancy(context.Context, *UpdateVacancyRequest) (*Vacancy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVacancy not implemented")
+}
+func (UnimplementedVacancyServiceServer) DeleteVacancy(context.Context, *DeleteVacancyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVacancy not implemented")
+}
+func (UnimplementedVacancyServiceServer) mustEmbedUnimplementedVacancyServiceServer() {}
+
+// UnsafeVacancyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VacancyServiceServer will
+// result in compilation errors.
+type UnsafeVacancyServiceServer interface {
+	mustEmbedUnimplementedVacancyServiceServer()
+}
+
+func RegisterVacancyServiceServer(s grpc.ServiceRegistrar, srv VacancyServiceServer) {
+	s.RegisterService(&VacancyService_ServiceDesc, srv)
+}
+
+func _VacancyService_CreateVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).CreateVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_CreateVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).CreateVacancy(ctx, req.(*CreateVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_GetVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).GetVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_GetVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).GetVacancy(ctx, req.(*GetVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ListVacancies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListVacanciesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VacancyServiceServer).ListVacancies(m, &vacancyServiceListVacanciesServer{ServerStream: stream})
+}
+
+type VacancyService_ListVacanciesServer interface {
+	Send(*Vacancy) error
+	grpc.ServerStream
+}
+
+type vacancyServiceListVacanciesServer struct {
+	grpc.ServerStream
+}
+
+func (x *vacancyServiceListVacanciesServer) Send(m *Vacancy) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VacancyService_UpdateVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).UpdateVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_UpdateVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).UpdateVacancy(ctx, req.(*UpdateVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_DeleteVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).DeleteVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_DeleteVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).DeleteVacancy(ctx, req.(*DeleteVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VacancyService_ServiceDesc is the grpc.ServiceDesc for VacancyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VacancyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hrplatform.v1.VacancyService",
+	HandlerType: (*VacancyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVacancy",
+			Handler:    _VacancyService_CreateVacancy_Handler,
+		},
+		{
+			MethodName: "GetVacancy",
+			Handler:    _VacancyService_GetVacancy_Handler,
+		},
+		{
+			MethodName: "UpdateVacancy",
+			Handler:    _VacancyService_UpdateVacancy_Handler,
+		},
+		{
+			MethodName: "DeleteVacancy",
+			Handler:    _VacancyService_DeleteVacancy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListVacancies",
+			Handler:       _VacancyService_ListVacancies_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hrpb/hrplatform.proto",
+}
+
+const (
+	InterviewService_CreateInterview_FullMethodName = "/hrplatform.v1.InterviewService/CreateInterview"
+	InterviewService_GetInterview_FullMethodName    = "/hrplatform.v1.InterviewService/GetInterview"
+	InterviewService_ListInterviews_FullMethodName  = "/hrplatform.v1.InterviewService/ListInterviews"
+	InterviewService_UpdateInterview_FullMethodName = "/hrplatform.v1.InterviewService/UpdateInterview"
+	InterviewService_DeleteInterview_FullMethodName = "/hrplatform.v1.InterviewService/DeleteInterview"
+)
+
+// InterviewServiceClient is the client API for InterviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InterviewServiceClient interface {
+	// Nomzod vakansiya talablariga mos kelmasa INVALID_ARGUMENT (candidate_not_eligible)
+	CreateInterview(ctx context.Context, in *CreateInterviewRequest, opts ...grpc.CallOption) (*Interview, error)
+	GetInterview(ctx context.Context, in *GetInterviewRequest, opts ...grpc.CallOption) (*Interview, error)
+	ListInterviews(ctx context.Context, in *ListInterviewsRequest, opts ...grpc.CallOption) (InterviewService_ListInterviewsClient, error)
+	UpdateInterview(ctx context.Context, in *UpdateInterviewRequest, opts ...grpc.CallOption) (*Interview, error)
+	DeleteInterview(ctx context.Context, in *DeleteInterviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type interviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInterviewServiceClient(cc grpc.ClientConnInterface) InterviewServiceClient {
+	return &interviewServiceClient{cc}
+}
+
+func (c *interviewServiceClient) CreateInterview(ctx context.Context, in *CreateInterviewRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, InterviewService_CreateInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interviewServiceClient) GetInterview(ctx context.Context, in *GetInterviewRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, InterviewService_GetInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interviewServiceClient) ListInterviews(ctx context.Context, in *ListInterviewsRequest, opts ...grpc.CallOption) (InterviewService_ListInterviewsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InterviewService_ServiceDesc.Streams[0], InterviewService_ListInterviews_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &interviewServiceListInterviewsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InterviewService_ListInterviewsClient interface {
+	Recv() (*Interview, error)
+	grpc.ClientStream
+}
+
+type interviewServiceListInterviewsClient struct {
+	grpc.ClientStream
+}
+
+func (x *interviewServiceListInterviewsClient) Recv() (*Interview, error) {
+	m := new(Interview)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *interviewServiceClient) UpdateInterview(ctx context.Context, in *UpdateInterviewRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, InterviewService_UpdateInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interviewServiceClient) DeleteInterview(ctx context.Context, in *DeleteInterviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InterviewService_DeleteInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InterviewServiceServer is the server API for InterviewService service.
+// All implementations must embed UnimplementedInterviewServiceServer
+// for forward compatibility
+type InterviewServiceServer interface {
+	// Nomzod vakansiya talablariga mos kelmasa INVALID_ARGUMENT (candidate_not_eligible)
+	CreateInterview(context.Context, *CreateInterviewRequest) (*Interview, error)
+	GetInterview(context.Context, *GetInterviewRequest) (*Interview, error)
+	ListInterviews(*ListInterviewsRequest, InterviewService_ListInterviewsServer) error
+	UpdateInterview(context.Context, *UpdateInterviewRequest) (*Interview, error)
+	DeleteInterview(context.Context, *DeleteInterviewRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedInterviewServiceServer()
+}
+
+// UnimplementedInterviewServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedInterviewServiceServer struct {
+}
+
+func (UnimplementedInterviewServiceServer) CreateInterview(context.Context, *CreateInterviewRequest) (*Interview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInterview not implemented")
+}
+func (UnimplementedInterviewServiceServer) GetInterview(context.Context, *GetInterviewRequest) (*Interview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInterview not implemented")
+}
+func (UnimplementedInterviewServiceServer) ListInterviews(*ListInterviewsRequest, InterviewService_ListInterviewsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListInterviews not implemented")
+}
+func (UnimplementedInterviewServiceServer) UpdateInterview(context.Context, *UpdateInterviewRequest) (*Interview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterview not implemented")
+}
+func (UnimplementedInterviewServiceServer) DeleteInterview(context.Context, *DeleteInterviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInterview not implemented")
+}
+func (UnimplementedInterviewServiceServer) mustEmbedUnimplementedInterviewServiceServer() {}
+
+// UnsafeInterviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InterviewServiceServer will
+// result in compilation errors.
+type UnsafeInterviewServiceServer interface {
+	mustEmbedUnimplementedInterviewServiceServer()
+}
+
+func RegisterInterviewServiceServer(s grpc.ServiceRegistrar, srv InterviewServiceServer) {
+	s.RegisterService(&InterviewService_ServiceDesc, srv)
+}
+
+func _InterviewService_CreateInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterviewServiceServer).CreateInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InterviewService_CreateInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterviewServiceServer).CreateInterview(ctx, req.(*CreateInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InterviewService_GetInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterviewServiceServer).GetInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InterviewService_GetInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterviewServiceServer).GetInterview(ctx, req.(*GetInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InterviewService_ListInterviews_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListInterviewsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InterviewServiceServer).ListInterviews(m, &interviewServiceListInterviewsServer{ServerStream: stream})
+}
+
+type InterviewService_ListInterviewsServer interface {
+	Send(*Interview) error
+	grpc.ServerStream
+}
+
+type interviewServiceListInterviewsServer struct {
+	grpc.ServerStream
+}
+
+func (x *interviewServiceListInterviewsServer) Send(m *Interview) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _InterviewService_UpdateInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterviewServiceServer).UpdateInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InterviewService_UpdateInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterviewServiceServer).UpdateInterview(ctx, req.(*UpdateInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InterviewService_DeleteInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterviewServiceServer).DeleteInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InterviewService_DeleteInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterviewServiceServer).DeleteInterview(ctx, req.(*DeleteInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InterviewService_ServiceDesc is the grpc.ServiceDesc for InterviewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InterviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hrplatform.v1.InterviewService",
+	HandlerType: (*InterviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateInterview",
+			Handler:    _InterviewService_CreateInterview_Handler,
+		},
+		{
+			MethodName: "GetInterview",
+			Handler:    _InterviewService_GetInterview_Handler,
+		},
+		{
+			MethodName: "UpdateInterview",
+			Handler:    _InterviewService_UpdateInterview_Handler,
+		},
+		{
+			MethodName: "DeleteInterview",
+			Handler:    _InterviewService_DeleteInterview_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListInterviews",
+			Handler:       _InterviewService_ListInterviews_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hrpb/hrplatform.proto",
+}
@@ -0,0 +1,147 @@
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"hrplatform/api/middleware"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/i18n"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Metadata kalitlari (gRPC da kichik harflar bilan): HTTP sarlavhalari bilan bir xil
+var (
+	requestIDKey = strings.ToLower(middleware.RequestIDHeader)
+	actorKey     = strings.ToLower(middleware.ActorHeader)
+)
+
+// requestInfo - bitta chaqiruv haqidagi ma'lumot: HTTP dagi RequestID va Locale middlewarelari o'rnida
+type requestInfo struct {
+	ID     string
+	Actor  string
+	Locale string
+	IP     string
+}
+
+type requestInfoKey struct{}
+
+func fromContext(ctx context.Context) requestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(requestInfo)
+	if info.Locale == "" {
+		info.Locale = i18n.DefaultLocale
+	}
+	return info
+}
+
+// auditSource audit yozuvi uchun chaqiruv manbai
+func auditSource(ctx context.Context) audit.Source {
+	info := fromContext(ctx)
+	return audit.Source{Actor: info.Actor, RequestID: info.ID, IP: info.IP}
+}
+
+// newRequestInfo metadatadan request id, actor va tilni o'qiydi. Request id yuborilmagan
+// bo'lsa yangisi yaratiladi.
+func newRequestInfo(ctx context.Context, preferences postgres.NotificationPreferenceRepository) requestInfo {
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	info := requestInfo{ID: first(requestIDKey), Actor: first(actorKey)}
+	if info.ID == "" || len(info.ID) > 128 {
+		info.ID = uuid.NewString()
+	}
+	locale, ok := i18n.Negotiate(first("accept-language"))
+	if !ok {
+		locale = middleware.PreferredLocale(ctx, preferences, info.Actor)
+	}
+	info.Locale = locale
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		info.IP = p.Addr.String()
+		if i := strings.LastIndex(info.IP, ":"); i > 0 {
+			info.IP = strings.Trim(info.IP[:i], "[]")
+		}
+	}
+	return info
+}
+
+// responseHeader chaqiruv javobiga qo'yiladigan metadata
+func (info requestInfo) responseHeader() metadata.MD {
+	return metadata.Pairs(requestIDKey, info.ID, "content-language", info.Locale)
+}
+
+// withTimeout mijoz muddat bermagan bo'lsa standart muddatni qo'yadi
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// recoverPanic handlerdagi panicni Internal xatolikka aylantiradi (gin.Recovery o'rnida)
+func recoverPanic(method string, err *error) {
+	if r := recover(); r != nil {
+		*err = apperrors.Internal(fmt.Errorf("panic in %s: %v", method, r))
+	}
+}
+
+func unaryInterceptor(preferences postgres.NotificationPreferenceRepository, timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, call *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		info := newRequestInfo(ctx, preferences)
+		if err := grpc.SetHeader(ctx, info.responseHeader()); err != nil {
+			log.Printf("grpc %s: javob sarlavhasini qo'yishda xatolik: %v", call.FullMethod, err)
+		}
+		ctx, cancel := withTimeout(context.WithValue(ctx, requestInfoKey{}, info), timeout)
+		defer cancel()
+
+		defer func() {
+			if err != nil {
+				err = statusError(info, err)
+			}
+		}()
+		defer recoverPanic(call.FullMethod, &err)
+		return handler(ctx, req)
+	}
+}
+
+func streamInterceptor(preferences postgres.NotificationPreferenceRepository, timeout time.Duration) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, call *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		info := newRequestInfo(stream.Context(), preferences)
+		if err := stream.SetHeader(info.responseHeader()); err != nil {
+			log.Printf("grpc %s: javob sarlavhasini qo'yishda xatolik: %v", call.FullMethod, err)
+		}
+		ctx, cancel := withTimeout(context.WithValue(stream.Context(), requestInfoKey{}, info), timeout)
+		defer cancel()
+
+		defer func() {
+			if err != nil {
+				err = statusError(info, err)
+			}
+		}()
+		defer recoverPanic(call.FullMethod, &err)
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// contextStream oqim kontekstini request ma'lumotlari va muddat qo'shilgani bilan almashtiradi
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,198 @@
+package grpcapi
+
+import (
+	"context"
+	"log"
+
+	"hrplatform/audit"
+	"hrplatform/grpcapi/hrpb"
+	"hrplatform/models"
+	"hrplatform/notification"
+	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/validation"
+	"hrplatform/webhook"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// InterviewServer - handlers.InterviewHandler ning gRPC dagi juftligi
+type InterviewServer struct {
+	hrpb.UnimplementedInterviewServiceServer
+	InterviewRepository postgres.InterviewRepository
+	VacancyRepository   postgres.VacancyRepository
+	Service             *service.InterviewService
+	Webhooks            *webhook.Dispatcher
+	Notifier            *notification.Notifier
+	Audit               *audit.Recorder
+}
+
+// dispatchEvent eventni vakansiya egasi bo'lgan kompaniyaning webhooklariga yuboradi
+func (s *InterviewServer) dispatchEvent(ctx context.Context, vacancyID uuid.UUID, event string, data interface{}) {
+	if s.Webhooks == nil || s.VacancyRepository == nil {
+		return
+	}
+	vacancy, err := s.VacancyRepository.GetVacancyByID(ctx, vacancyID)
+	if err != nil {
+		log.Printf("webhook: %s uchun vakansiyani olishda xatolik: %v", event, err)
+		return
+	}
+	s.Webhooks.Dispatch(ctx, vacancy.CompanyID, event, data)
+}
+
+// interviewRefs so'rovdagi nomzod, vakansiya va rekruiter IDlari
+func interviewRefs(userID, vacancyID, recruiterID string) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	user, err := parseRef(userID, "user_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	vacancy, err := parseRef(vacancyID, "vacancy_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	recruiter, err := parseRef(recruiterID, "recruiter_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	return user, vacancy, recruiter, nil
+}
+
+func (s *InterviewServer) CreateInterview(ctx context.Context, req *hrpb.CreateInterviewRequest) (*hrpb.Interview, error) {
+	userID, vacancyID, recruiterID, err := interviewRefs(req.UserId, req.VacancyId, req.RecruiterId)
+	if err != nil {
+		return nil, err
+	}
+	interviewCreate := models.CreateInterview{
+		UserID:        userID,
+		VacancyID:     vacancyID,
+		RecruiterID:   recruiterID,
+		InterviewDate: req.InterviewDate,
+	}
+	if err := validation.Struct(&interviewCreate); err != nil {
+		return nil, err
+	}
+
+	// Yosh va lavozim qoidalari service da tekshiriladi
+	interview, err := s.Service.Create(ctx, interviewCreate)
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityInterview, interview.ID.String(), audit.ActionCreate, nil, interview)
+	s.dispatchEvent(ctx, interview.VacancyID, webhook.EventInterviewScheduled, interview)
+	s.Notifier.NotifyInterview(ctx, notification.KindInterviewScheduled, interview)
+	return interviewToProto(interview), nil
+}
+
+func (s *InterviewServer) GetInterview(ctx context.Context, req *hrpb.GetInterviewRequest) (*hrpb.Interview, error) {
+	interviewID, err := parseID(req.Id, "interview")
+	if err != nil {
+		return nil, err
+	}
+	interview, err := s.InterviewRepository.GetInterviewByID(ctx, interviewID)
+	if err != nil {
+		return nil, err
+	}
+	return interviewToProto(interview), nil
+}
+
+func (s *InterviewServer) ListInterviews(req *hrpb.ListInterviewsRequest, stream hrpb.InterviewService_ListInterviewsServer) error {
+	var interviews []models.Interview
+	if req.UserId != "" {
+		userID, err := parseID(req.UserId, "user")
+		if err != nil {
+			return err
+		}
+		if interviews, err = s.InterviewRepository.GetInterviewsByUserID(stream.Context(), userID); err != nil {
+			return err
+		}
+	} else {
+		filter := make(map[string]interface{})
+		if req.CompanyId != "" {
+			companyID, err := parseID(req.CompanyId, "company")
+			if err != nil {
+				return err
+			}
+			filter["company_id"] = companyID
+		}
+		if req.Position != "" {
+			filter["position"] = req.Position
+		}
+		if req.Experience != 0 {
+			filter["experience"] = int(req.Experience)
+		}
+		var err error
+		if interviews, err = s.InterviewRepository.GetAllInterviews(stream.Context(), filter); err != nil {
+			return err
+		}
+	}
+
+	for _, interview := range interviews {
+		if err := stream.Send(interviewToProto(interview)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InterviewServer) UpdateInterview(ctx context.Context, req *hrpb.UpdateInterviewRequest) (*hrpb.Interview, error) {
+	interviewID, err := parseID(req.Id, "interview")
+	if err != nil {
+		return nil, err
+	}
+	userID, vacancyID, recruiterID, err := interviewRefs(req.UserId, req.VacancyId, req.RecruiterId)
+	if err != nil {
+		return nil, err
+	}
+	interviewUpdate := models.UpdateInterview{
+		ID:            interviewID,
+		UserID:        userID,
+		VacancyID:     vacancyID,
+		RecruiterID:   recruiterID,
+		InterviewDate: req.InterviewDate,
+	}
+	if err := validation.Struct(&interviewUpdate); err != nil {
+		return nil, err
+	}
+
+	before, err := s.InterviewRepository.GetInterviewByID(ctx, interviewID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+	interviewUpdate.Version = before.Version
+	if err := s.Service.Update(ctx, before, interviewUpdate); err != nil {
+		return nil, err
+	}
+
+	interview, err := s.InterviewRepository.GetInterviewByID(ctx, interviewID)
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityInterview, interviewID.String(), audit.ActionUpdate, before, interview)
+	s.dispatchEvent(ctx, interview.VacancyID, webhook.EventApplicationStatusChanged, interview)
+	s.Notifier.NotifyInterview(ctx, notification.KindInterviewRescheduled, interview)
+	return interviewToProto(interview), nil
+}
+
+func (s *InterviewServer) DeleteInterview(ctx context.Context, req *hrpb.DeleteInterviewRequest) (*emptypb.Empty, error) {
+	interviewID, err := parseID(req.Id, "interview")
+	if err != nil {
+		return nil, err
+	}
+	interview, err := s.InterviewRepository.GetInterviewByID(ctx, interviewID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, interview.Version); err != nil {
+		return nil, err
+	}
+	if err := s.InterviewRepository.DeleteInterview(ctx, interviewID); err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityInterview, interviewID.String(), audit.ActionDelete, interview, nil)
+	s.Notifier.NotifyInterview(ctx, notification.KindInterviewCancelled, interview)
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,148 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"hrplatform/audit"
+	"hrplatform/grpcapi/hrpb"
+	"hrplatform/models"
+	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/validation"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RecruiterServer - handlers.RecruiterHandler ning gRPC dagi juftligi
+type RecruiterServer struct {
+	hrpb.UnimplementedRecruiterServiceServer
+	RecruiterRepository postgres.RecruiterRepository
+	Service             *service.RecruiterService
+	Audit               *audit.Recorder
+}
+
+func (s *RecruiterServer) CreateRecruiter(ctx context.Context, req *hrpb.CreateRecruiterRequest) (*hrpb.Recruiter, error) {
+	companyID, err := parseRef(req.CompanyId, "company_id")
+	if err != nil {
+		return nil, err
+	}
+	recruiterCreate := models.CreateRecruiter{
+		Name:        req.Name,
+		Email:       req.Email,
+		PhoneNumber: req.PhoneNumber,
+		Birthday:    req.Birthday,
+		Gender:      req.Gender,
+		CompanyID:   companyID,
+	}
+	if err := validation.Struct(&recruiterCreate); err != nil {
+		return nil, err
+	}
+
+	// Repository tug'ilgan sanani RFC3339 ko'rinishida kutadi (format tekshirilgan)
+	birthday, _ := time.Parse(validation.DateLayout, recruiterCreate.Birthday)
+	recruiterCreate.Birthday = birthday.Format(time.RFC3339)
+
+	recruiter, err := s.Service.Create(ctx, recruiterCreate)
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityRecruiter, recruiter.ID.String(), audit.ActionCreate, nil, recruiter)
+	return recruiterToProto(recruiter), nil
+}
+
+func (s *RecruiterServer) GetRecruiter(ctx context.Context, req *hrpb.GetRecruiterRequest) (*hrpb.Recruiter, error) {
+	recruiterID, err := parseID(req.Id, "recruiter")
+	if err != nil {
+		return nil, err
+	}
+	recruiter, err := s.RecruiterRepository.GetRecruiterByID(ctx, recruiterID.String())
+	if err != nil {
+		return nil, err
+	}
+	return recruiterToProto(recruiter), nil
+}
+
+func (s *RecruiterServer) ListRecruiters(req *hrpb.ListRecruitersRequest, stream hrpb.RecruiterService_ListRecruitersServer) error {
+	if req.CompanyId != "" {
+		if _, err := parseID(req.CompanyId, "company"); err != nil {
+			return err
+		}
+	}
+	recruiters, err := s.RecruiterRepository.GetAllRecruiters(stream.Context(), int(req.Age), req.Gender, req.CompanyId)
+	if err != nil {
+		return err
+	}
+	for _, recruiter := range recruiters {
+		if err := stream.Send(recruiterToProto(recruiter)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *RecruiterServer) UpdateRecruiter(ctx context.Context, req *hrpb.UpdateRecruiterRequest) (*hrpb.Recruiter, error) {
+	recruiterID, err := parseID(req.Id, "recruiter")
+	if err != nil {
+		return nil, err
+	}
+	companyID, err := parseRef(req.CompanyId, "company_id")
+	if err != nil {
+		return nil, err
+	}
+	recruiterUpdate := models.UpdateRecruiter{
+		ID:          recruiterID,
+		Name:        req.Name,
+		Email:       req.Email,
+		PhoneNumber: req.PhoneNumber,
+		Birthday:    req.Birthday,
+		Gender:      req.Gender,
+		CompanyID:   companyID,
+	}
+	if err := validation.Struct(&recruiterUpdate); err != nil {
+		return nil, err
+	}
+
+	before, err := s.RecruiterRepository.GetRecruiterByID(ctx, recruiterID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+	recruiterUpdate.Version = before.Version
+	birthday, _ := time.Parse(validation.DateLayout, recruiterUpdate.Birthday)
+	recruiterUpdate.Birthday = birthday.Format(time.RFC3339)
+
+	if err := s.Service.Update(ctx, before, recruiterUpdate); err != nil {
+		return nil, err
+	}
+
+	after, err := s.RecruiterRepository.GetRecruiterByID(ctx, recruiterID.String())
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityRecruiter, recruiterID.String(), audit.ActionUpdate, before, after)
+	return recruiterToProto(after), nil
+}
+
+func (s *RecruiterServer) DeleteRecruiter(ctx context.Context, req *hrpb.DeleteRecruiterRequest) (*emptypb.Empty, error) {
+	recruiterID, err := parseID(req.Id, "recruiter")
+	if err != nil {
+		return nil, err
+	}
+	before, err := s.RecruiterRepository.GetRecruiterByID(ctx, recruiterID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+
+	// Rekruiter intervyulari bilan birga o'chiriladi
+	if err := s.Service.Delete(ctx, recruiterID); err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityRecruiter, recruiterID.String(), audit.ActionDelete, before, nil)
+	return &emptypb.Empty{}, nil
+}
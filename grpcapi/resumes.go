@@ -0,0 +1,153 @@
+package grpcapi
+
+import (
+	"context"
+
+	"hrplatform/audit"
+	"hrplatform/grpcapi/hrpb"
+	"hrplatform/models"
+	"hrplatform/postgres"
+	"hrplatform/validation"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ResumeServer - handlers.ResumeHandler ning gRPC dagi juftligi
+type ResumeServer struct {
+	hrpb.UnimplementedResumeServiceServer
+	ResumeRepository postgres.ResumeRepository
+	Audit            *audit.Recorder
+}
+
+func (s *ResumeServer) CreateResume(ctx context.Context, req *hrpb.CreateResumeRequest) (*hrpb.Resume, error) {
+	userID, err := parseRef(req.UserId, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	resumeCreate := models.CreateResume{
+		Position:    req.Position,
+		Experience:  int(req.Experience),
+		Description: req.Description,
+		Languages:   req.Languages,
+		UserID:      userID,
+	}
+	if err := validation.Struct(&resumeCreate); err != nil {
+		return nil, err
+	}
+
+	resume, err := s.ResumeRepository.CreateResume(ctx, resumeCreate)
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityResume, resume.ID.String(), audit.ActionCreate, nil, resume)
+	return resumeToProto(resume), nil
+}
+
+func (s *ResumeServer) GetResume(ctx context.Context, req *hrpb.GetResumeRequest) (*hrpb.Resume, error) {
+	resumeID, err := parseID(req.Id, "resume")
+	if err != nil {
+		return nil, err
+	}
+	resume, err := s.ResumeRepository.GetResumeByID(ctx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+	return resumeToProto(resume), nil
+}
+
+func (s *ResumeServer) ListResumes(req *hrpb.ListResumesRequest, stream hrpb.ResumeService_ListResumesServer) error {
+	if req.UserId != "" {
+		userID, err := parseID(req.UserId, "user")
+		if err != nil {
+			return err
+		}
+		resumes, err := s.ResumeRepository.GetResumesByUserID(stream.Context(), userID)
+		if err != nil {
+			return err
+		}
+		for _, resume := range resumes {
+			if err := stream.Send(resumeToProto(resume)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	filter := make(map[string]interface{})
+	if req.Position != "" {
+		filter["position"] = req.Position
+	}
+	if req.MinExp != 0 {
+		filter["min_exp"] = int(req.MinExp)
+	}
+	resumes, err := s.ResumeRepository.GetAllResumes(stream.Context(), filter)
+	if err != nil {
+		return err
+	}
+	for _, resume := range resumes {
+		if err := stream.Send(resumeWithUserToProto(resume)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ResumeServer) UpdateResume(ctx context.Context, req *hrpb.UpdateResumeRequest) (*hrpb.Resume, error) {
+	resumeID, err := parseID(req.Id, "resume")
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseRef(req.UserId, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	resumeUpdate := models.UpdateResume{
+		ID:          resumeID.String(),
+		Position:    req.Position,
+		Experience:  int(req.Experience),
+		Description: req.Description,
+		Languages:   req.Languages,
+		UserID:      userID,
+	}
+	if err := validation.Struct(&resumeUpdate); err != nil {
+		return nil, err
+	}
+
+	before, err := s.ResumeRepository.GetResumeByID(ctx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+	resumeUpdate.Version = before.Version
+	if err := s.ResumeRepository.UpdateResume(ctx, resumeUpdate); err != nil {
+		return nil, err
+	}
+
+	after, err := s.ResumeRepository.GetResumeByID(ctx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityResume, resumeID.String(), audit.ActionUpdate, before, after)
+	return resumeToProto(after), nil
+}
+
+func (s *ResumeServer) DeleteResume(ctx context.Context, req *hrpb.DeleteResumeRequest) (*emptypb.Empty, error) {
+	resumeID, err := parseID(req.Id, "resume")
+	if err != nil {
+		return nil, err
+	}
+	before, err := s.ResumeRepository.GetResumeByID(ctx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+	if err := s.ResumeRepository.DeleteResume(ctx, resumeID.String()); err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityResume, resumeID.String(), audit.ActionDelete, before, nil)
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,37 @@
+// Package grpcapi - REST API dagi foydalanuvchi, rezyume, kompaniya, rekruiter, vakansiya
+// va intervyu imkoniyatlarini gRPC orqali beradi. Serverlar handlerlar ishlatadigan
+// o'sha repository va servislar ustida ishlaydi, audit, webhook va bildirishnomalar ham
+// bir xil yuboriladi. Ro'yxatlar server-streaming chaqiruvlar.
+//
+// Protobuf ta'riflari hrpb/hrplatform.proto da, Go kodi undan generatsiya qilinadi:
+//
+//	go generate ./grpcapi
+package grpcapi
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative hrpb/hrplatform.proto
+
+import (
+	"time"
+
+	"hrplatform/grpcapi/hrpb"
+	"hrplatform/postgres"
+
+	"google.golang.org/grpc"
+)
+
+// NewServer barcha servislarni ro'yxatdan o'tkazilgan gRPC serverini yaratadi.
+// preferences - actor tilini aniqlash uchun, requestTimeout - muddat berilmagan
+// chaqiruvlar uchun standart muddat.
+func NewServer(users *UserServer, resumes *ResumeServer, companies *CompanyServer, recruiters *RecruiterServer, vacancies *VacancyServer, interviews *InterviewServer, preferences postgres.NotificationPreferenceRepository, requestTimeout time.Duration) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptor(preferences, requestTimeout)),
+		grpc.ChainStreamInterceptor(streamInterceptor(preferences, requestTimeout)),
+	)
+	hrpb.RegisterUserServiceServer(server, users)
+	hrpb.RegisterResumeServiceServer(server, resumes)
+	hrpb.RegisterCompanyServiceServer(server, companies)
+	hrpb.RegisterRecruiterServiceServer(server, recruiters)
+	hrpb.RegisterVacancyServiceServer(server, vacancies)
+	hrpb.RegisterInterviewServiceServer(server, interviews)
+	return server
+}
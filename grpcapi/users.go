@@ -0,0 +1,129 @@
+package grpcapi
+
+import (
+	"context"
+
+	"hrplatform/audit"
+	"hrplatform/grpcapi/hrpb"
+	"hrplatform/models"
+	"hrplatform/postgres"
+	"hrplatform/validation"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UserServer - handlers.UserHandler ning gRPC dagi juftligi
+type UserServer struct {
+	hrpb.UnimplementedUserServiceServer
+	UserRepository postgres.UserRepository
+	Audit          *audit.Recorder
+}
+
+func (s *UserServer) CreateUser(ctx context.Context, req *hrpb.CreateUserRequest) (*hrpb.User, error) {
+	userCreate := models.UserCreate{
+		Name:        req.Name,
+		Email:       req.Email,
+		PhoneNumber: req.PhoneNumber,
+		Birthday:    req.Birthday,
+		Gender:      req.Gender,
+	}
+	if err := validation.Struct(&userCreate); err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepository.CreateUser(ctx, userCreate)
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityUser, user.ID.String(), audit.ActionCreate, nil, user)
+	return userToProto(user), nil
+}
+
+func (s *UserServer) GetUser(ctx context.Context, req *hrpb.GetUserRequest) (*hrpb.User, error) {
+	userID, err := parseID(req.Id, "user")
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.UserRepository.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	return userToProto(user), nil
+}
+
+func (s *UserServer) ListUsers(req *hrpb.ListUsersRequest, stream hrpb.UserService_ListUsersServer) error {
+	filters := make(map[string]interface{})
+	if req.Age != 0 {
+		filters["age"] = int(req.Age)
+	}
+	if req.Gender != "" {
+		filters["gender"] = req.Gender
+	}
+
+	users, err := s.UserRepository.GetAllUsers(stream.Context(), filters)
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if err := stream.Send(userToProto(user)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *UserServer) UpdateUser(ctx context.Context, req *hrpb.UpdateUserRequest) (*hrpb.User, error) {
+	userID, err := parseID(req.Id, "user")
+	if err != nil {
+		return nil, err
+	}
+	userUpdate := models.UserUpdate{
+		ID:          userID,
+		Name:        req.Name,
+		Email:       req.Email,
+		PhoneNumber: req.PhoneNumber,
+		Birthday:    req.Birthday,
+		Gender:      req.Gender,
+	}
+	if err := validation.Struct(&userUpdate); err != nil {
+		return nil, err
+	}
+
+	before, err := s.UserRepository.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+	userUpdate.Version = before.Version
+	if err := s.UserRepository.UpdateUser(ctx, userUpdate); err != nil {
+		return nil, err
+	}
+
+	after, err := s.UserRepository.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityUser, userID.String(), audit.ActionUpdate, before, after)
+	return userToProto(after), nil
+}
+
+func (s *UserServer) DeleteUser(ctx context.Context, req *hrpb.DeleteUserRequest) (*emptypb.Empty, error) {
+	userID, err := parseID(req.Id, "user")
+	if err != nil {
+		return nil, err
+	}
+	before, err := s.UserRepository.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+	if err := s.UserRepository.DeleteUser(ctx, userID.String()); err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityUser, userID.String(), audit.ActionDelete, before, nil)
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,151 @@
+package grpcapi
+
+import (
+	"context"
+
+	"hrplatform/audit"
+	"hrplatform/grpcapi/hrpb"
+	"hrplatform/models"
+	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/validation"
+	"hrplatform/webhook"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// VacancyServer - handlers.VacancyHandler ning gRPC dagi juftligi
+type VacancyServer struct {
+	hrpb.UnimplementedVacancyServiceServer
+	VacancyRepository postgres.VacancyRepository
+	Service           *service.VacancyService
+	Webhooks          *webhook.Dispatcher
+	Audit             *audit.Recorder
+}
+
+func (s *VacancyServer) CreateVacancy(ctx context.Context, req *hrpb.CreateVacancyRequest) (*hrpb.Vacancy, error) {
+	companyID, err := parseRef(req.CompanyId, "company_id")
+	if err != nil {
+		return nil, err
+	}
+	vacancyCreate := models.CreateVacancy{
+		Name:        req.Name,
+		Position:    req.Position,
+		MinExp:      int(req.MinExp),
+		CompanyID:   companyID,
+		Description: req.Description,
+	}
+	if err := validation.Struct(&vacancyCreate); err != nil {
+		return nil, err
+	}
+
+	vacancy, err := s.Service.Create(ctx, vacancyCreate)
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityVacancy, vacancy.ID.String(), audit.ActionCreate, nil, vacancy)
+	return vacancyToProto(vacancy), nil
+}
+
+func (s *VacancyServer) GetVacancy(ctx context.Context, req *hrpb.GetVacancyRequest) (*hrpb.Vacancy, error) {
+	vacancyID, err := parseID(req.Id, "vacancy")
+	if err != nil {
+		return nil, err
+	}
+	vacancy, err := s.VacancyRepository.GetVacancyByID(ctx, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	return vacancyToProto(vacancy), nil
+}
+
+func (s *VacancyServer) ListVacancies(req *hrpb.ListVacanciesRequest, stream hrpb.VacancyService_ListVacanciesServer) error {
+	filter := make(map[string]interface{})
+	if req.Position != "" {
+		filter["position"] = req.Position
+	}
+	if req.MinExp != 0 {
+		filter["min_exp"] = int(req.MinExp)
+	}
+	if req.CompanyId != "" {
+		companyID, err := parseID(req.CompanyId, "company")
+		if err != nil {
+			return err
+		}
+		filter["company_id"] = companyID
+	}
+
+	vacancies, err := s.VacancyRepository.GetAllVacancies(stream.Context(), filter)
+	if err != nil {
+		return err
+	}
+	for _, vacancy := range vacancies {
+		if err := stream.Send(vacancyToProto(vacancy)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *VacancyServer) UpdateVacancy(ctx context.Context, req *hrpb.UpdateVacancyRequest) (*hrpb.Vacancy, error) {
+	vacancyID, err := parseID(req.Id, "vacancy")
+	if err != nil {
+		return nil, err
+	}
+	companyID, err := parseRef(req.CompanyId, "company_id")
+	if err != nil {
+		return nil, err
+	}
+	vacancyUpdate := models.UpdateVacancy{
+		ID:          vacancyID,
+		Name:        req.Name,
+		Position:    req.Position,
+		MinExp:      int(req.MinExp),
+		CompanyID:   companyID,
+		Description: req.Description,
+	}
+	if err := validation.Struct(&vacancyUpdate); err != nil {
+		return nil, err
+	}
+
+	before, err := s.VacancyRepository.GetVacancyByID(ctx, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, before.Version); err != nil {
+		return nil, err
+	}
+	vacancyUpdate.Version = before.Version
+	if err := s.Service.Update(ctx, before, vacancyUpdate); err != nil {
+		return nil, err
+	}
+
+	after, err := s.VacancyRepository.GetVacancyByID(ctx, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityVacancy, vacancyID.String(), audit.ActionUpdate, before, after)
+	return vacancyToProto(after), nil
+}
+
+func (s *VacancyServer) DeleteVacancy(ctx context.Context, req *hrpb.DeleteVacancyRequest) (*emptypb.Empty, error) {
+	vacancyID, err := parseID(req.Id, "vacancy")
+	if err != nil {
+		return nil, err
+	}
+	vacancy, err := s.VacancyRepository.GetVacancyByID(ctx, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(req.Version, vacancy.Version); err != nil {
+		return nil, err
+	}
+
+	// Vakansiya intervyulari bilan birga o'chiriladi
+	if err := s.Service.Delete(ctx, vacancyID); err != nil {
+		return nil, err
+	}
+	s.Audit.RecordContext(ctx, auditSource(ctx), audit.EntityVacancy, vacancyID.String(), audit.ActionDelete, vacancy, nil)
+	s.Webhooks.Dispatch(ctx, vacancy.CompanyID, webhook.EventVacancyClosed, vacancy)
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,102 @@
+package i18n
+
+var en = map[string]string{
+	// Errors
+	"error.already_exists":            "Record already exists",
+	"error.candidate_not_eligible":    "Candidate does not meet the vacancy requirements",
+	"error.company_not_found":         "Company with the given ID does not exist",
+	"error.constraint_violation":      "Value violates a database constraint",
+	"error.idempotency_key_in_use":    "A request with this Idempotency-Key is still being processed",
+	"error.idempotency_key_reused":    "This Idempotency-Key was already used with a different request body",
+	"error.internal_error":            "Internal server error",
+	"error.if_match_required":         "If-Match header is required, fetch the record with GET first",
+	"error.import_file_too_large":     "The import file must be at most {{.Limit}}",
+	"error.import_too_many_rows":      "The import file must have at most {{.Limit}} rows",
+	"error.interview_not_found":       "Interview not found",
+	"error.invalid_body":              "Failed to read request body",
+	"error.invalid_date":              "Invalid date format, expected YYYY-MM-DD",
+	"error.invalid_eligibility_rule":  "Invalid eligibility rules",
+	"error.invalid_id":                "Invalid ID format",
+	"error.invalid_idempotency_key":   "Idempotency-Key must be at most 255 characters",
+	"error.invalid_import_file":       "The import file is not a valid CSV or XLSX table",
+	"error.invalid_import_mapping":    "Invalid column mapping",
+	"error.invalid_json":              "Invalid JSON body",
+	"error.invalid_phone":             "Phone number is not a valid Uzbek number",
+	"error.invalid_query":             "Invalid query parameter",
+	"error.invalid_reference":         "Referenced record does not exist",
+	"error.invalid_secret_token":      "Invalid secret token",
+	"error.merge_same_user":           "Cannot merge a user into itself",
+	"error.query_too_deep":            "Query depth {{.Depth}} exceeds the limit of {{.Limit}}",
+	"error.query_too_expensive":       "Query cost {{.Cost}} exceeds the limit of {{.Limit}}, request fewer items with first",
+	"error.recruiter_email_taken":     "Recruiter with email '{{.Email}}' already exists",
+	"error.recruiter_not_found":       "Recruiter not found",
+	"error.recruiter_phone_taken":     "Recruiter with phone number '{{.Phone}}' already exists",
+	"error.request_timeout":           "Request timed out, please try again later",
+	"error.resume_not_found":          "Resume not found",
+	"error.telegram_chat_not_linked":  "Telegram chat is not linked to any user",
+	"error.telegram_not_linked":       "Telegram chat is not linked yet, open {{.LinkURL}} first",
+	"error.unknown_channel":           "Unknown channel",
+	"error.unknown_entity":            "Unknown entity type",
+	"error.unknown_event":             "Unknown event: {{.Event}}",
+	"error.unknown_notification_kind": "Unknown notification kind: {{.Kind}}",
+	"error.unsupported_locale":        "Unsupported locale",
+	"error.user_email_taken":          "User with email '{{.Email}}' already exists",
+	"error.user_not_found":            "User not found",
+	"error.user_phone_taken":          "User with phone number '{{.Phone}}' already exists",
+	"error.validation_failed":         "Request validation failed",
+	"error.vacancy_not_found":         "Vacancy not found",
+	"error.version_mismatch":          "The record was modified by another request, fetch it again (ETag mismatch)",
+	"error.version_required":          "Record version is required, fetch the record first",
+	"error.webhook_not_found":         "Webhook not found",
+
+	// Field validation rules
+	"validation.required":           "Is required",
+	"validation.email":              "Must be a valid email address",
+	"validation.phone":              "Must be an E.164 or Uzbek phone number",
+	"validation.gender":             "Must be 'm' or 'f'",
+	"validation.date":               "Must be a date in YYYY-MM-DD format",
+	"validation.pastdate":           "Must be a past date in YYYY-MM-DD format",
+	"validation.datetime":           "Must match format {{.Param}}",
+	"validation.min":                "Must be at least {{.Param}}",
+	"validation.max":                "Must be at most {{.Param}}",
+	"validation.min_length":         "Must be at least {{.Param}} characters long",
+	"validation.max_length":         "Must be at most {{.Param}} characters long",
+	"validation.oneof":              "Must be one of: {{.Param}}",
+	"validation.http_url":           "Must be an absolute http(s) URL",
+	"validation.min_age":            "Candidate must be at least {{.Param}} years old",
+	"validation.max_age":            "Candidate must be at most {{.Param}} years old",
+	"validation.min_experience":     "Candidate must have at least {{.Param}} years of experience",
+	"validation.position_match":     "No resume matches the position '{{.Param}}'",
+	"validation.required_languages": "Candidate must speak: {{.Param}}",
+	"validation.resume_required":    "Candidate has no resume",
+	"validation.expand_depth":       "At most {{.Param}} nested relations are allowed",
+	"validation.integer":            "Must be a whole number",
+	"validation.uuid":               "Must be a UUID",
+	"validation.user_exists":        "No active user matches this value",
+	"validation.import_column":      "Column '{{.Param}}' is not in the file",
+
+	// Successful operations
+	"message.company_deleted":   "Company deleted successfully",
+	"message.company_updated":   "Company updated successfully",
+	"message.recruiter_deleted": "Recruiter deleted successfully",
+	"message.recruiter_updated": "Recruiter updated successfully",
+	"message.resume_deleted":    "Resume deleted successfully",
+	"message.resume_updated":    "Resume updated successfully",
+	"message.user_deleted":      "User deleted successfully",
+	"message.user_updated":      "User updated successfully",
+	"message.vacancy_deleted":   "Vacancy deleted successfully",
+	"message.vacancy_updated":   "Vacancy updated successfully",
+	"message.webhook_deleted":   "Webhook deleted successfully",
+
+	// Notifications
+	"notification.interview_scheduled.subject":        "Interview scheduled: {{.VacancyName}}",
+	"notification.interview_scheduled.body":           "Hello {{.Name}},\n\nYour interview for \"{{.VacancyName}}\" has been scheduled for {{.InterviewDate}}.\n\nGood luck!",
+	"notification.interview_rescheduled.subject":      "Interview rescheduled: {{.VacancyName}}",
+	"notification.interview_rescheduled.body":         "Hello {{.Name}},\n\nYour interview for \"{{.VacancyName}}\" has been moved. New time: {{.InterviewDate}}.",
+	"notification.interview_cancelled.subject":        "Interview cancelled: {{.VacancyName}}",
+	"notification.interview_cancelled.body":           "Hello {{.Name}},\n\nYour interview for \"{{.VacancyName}}\" on {{.InterviewDate}} has been cancelled.",
+	"notification.interview_reminder.subject":         "Reminder: interview at {{.InterviewDate}}",
+	"notification.interview_reminder.body":            "Hello {{.Name}},\n\nThis is a reminder that your interview for \"{{.VacancyName}}\" is at {{.InterviewDate}}.",
+	"notification.application_status_changed.subject": "Application status updated: {{.VacancyName}}",
+	"notification.application_status_changed.body":    "Hello {{.Name}},\n\nThe status of your application for \"{{.VacancyName}}\" is now: {{.Status}}.",
+}
@@ -0,0 +1,131 @@
+// Package i18n API xabarlari va bildirishnoma shablonlari uchun umumiy
+// tarjimalar katalogini saqlaydi. Kalitlar nom fazolariga bo'lingan:
+// "error.<kod>", "message.<kalit>", "validation.<qoida>",
+// "notification.<tur>.subject|body".
+package i18n
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Qo'llab-quvvatlanadigan tillar
+const (
+	LocaleUz = "uz"
+	LocaleRu = "ru"
+	LocaleEn = "en"
+
+	DefaultLocale = LocaleUz
+)
+
+// Locales - qo'llab-quvvatlanadigan barcha tillar
+var Locales = []string{LocaleUz, LocaleRu, LocaleEn}
+
+var catalog = map[string]map[string]string{
+	LocaleUz: uz,
+	LocaleRu: ru,
+	LocaleEn: en,
+}
+
+// IsSupported tilni qo'llab-quvvatlanishini tekshiradi
+func IsSupported(locale string) bool {
+	_, ok := catalog[locale]
+	return ok
+}
+
+// Normalize "ru-RU", "EN_us" kabi teglarni asosiy til kodiga keltiradi.
+// Til qo'llab-quvvatlanmasa bo'sh satr qaytaradi.
+func Normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	if IsSupported(tag) {
+		return tag
+	}
+	return ""
+}
+
+// Negotiate Accept-Language sarlavhasidan q-qiymatlar bo'yicha eng mos
+// qo'llab-quvvatlanadigan tilni tanlaydi. Mos til bo'lmasa ok=false.
+func Negotiate(acceptLanguage string) (string, bool) {
+	type candidate struct {
+		locale string
+		q      float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(part, ";")
+		locale := Normalize(fields[0])
+		if locale == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{locale: locale, q: q})
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].locale, true
+}
+
+// Lookup kalitning berilgan tildagi matnini qaytaradi. Tarjima bo'lmasa
+// DefaultLocale, so'ng inglizcha matn qidiriladi.
+func Lookup(locale, key string) (string, bool) {
+	for _, l := range []string{locale, DefaultLocale, LocaleEn} {
+		if text, ok := catalog[l][key]; ok {
+			return text, true
+		}
+	}
+	return "", false
+}
+
+// Render kalit matnini data bilan to'ldiradi ({{.Name}} kabi o'rinlar).
+// Kalit katalogda bo'lmasa ok=false.
+func Render(locale, key string, data interface{}) (string, bool, error) {
+	text, ok := Lookup(locale, key)
+	if !ok {
+		return "", false, nil
+	}
+	if !strings.Contains(text, "{{") {
+		return text, true, nil
+	}
+
+	t, err := template.New(key).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", true, err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", true, err
+	}
+	return buf.String(), true, nil
+}
+
+// T kalit tarjimasini qaytaradi, topilmasa yoki shablon buzilgan bo'lsa kalitning o'zini
+func T(locale, key string, data interface{}) string {
+	text, ok, err := Render(locale, key, data)
+	if !ok || err != nil {
+		return key
+	}
+	return text
+}
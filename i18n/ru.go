@@ -0,0 +1,102 @@
+package i18n
+
+var ru = map[string]string{
+	// Ошибки
+	"error.already_exists":            "Такая запись уже существует",
+	"error.candidate_not_eligible":    "Кандидат не соответствует требованиям вакансии",
+	"error.company_not_found":         "Компания с указанным ID не существует",
+	"error.constraint_violation":      "Значение нарушает ограничение базы данных",
+	"error.idempotency_key_in_use":    "Запрос с этим Idempotency-Key ещё выполняется",
+	"error.idempotency_key_reused":    "Этот Idempotency-Key уже использован с другим телом запроса",
+	"error.internal_error":            "Внутренняя ошибка сервера",
+	"error.if_match_required":         "Заголовок If-Match обязателен, сначала получите запись через GET",
+	"error.import_file_too_large":     "Файл импорта должен быть не больше {{.Limit}}",
+	"error.import_too_many_rows":      "В файле импорта должно быть не более {{.Limit}} строк",
+	"error.interview_not_found":       "Собеседование не найдено",
+	"error.invalid_body":              "Не удалось прочитать тело запроса",
+	"error.invalid_date":              "Неверный формат даты. Используйте YYYY-MM-DD",
+	"error.invalid_eligibility_rule":  "Некорректные правила соответствия",
+	"error.invalid_id":                "Неверный формат ID",
+	"error.invalid_idempotency_key":   "Idempotency-Key должен быть не длиннее 255 символов",
+	"error.invalid_import_file":       "Файл импорта не является корректной таблицей CSV или XLSX",
+	"error.invalid_import_mapping":    "Неверное сопоставление столбцов",
+	"error.invalid_json":              "Некорректный JSON в теле запроса",
+	"error.invalid_phone":             "Номер телефона не является узбекским номером",
+	"error.invalid_query":             "Неверный параметр запроса",
+	"error.invalid_reference":         "Связанная запись не существует",
+	"error.invalid_secret_token":      "Неверный секретный токен",
+	"error.merge_same_user":           "Нельзя объединить пользователя с самим собой",
+	"error.query_too_deep":            "Глубина запроса {{.Depth}} превышает лимит {{.Limit}}",
+	"error.query_too_expensive":       "Стоимость запроса {{.Cost}} превышает лимит {{.Limit}}, уменьшите first",
+	"error.recruiter_email_taken":     "Рекрутер с электронной почтой '{{.Email}}' уже существует",
+	"error.recruiter_not_found":       "Рекрутер не найден",
+	"error.recruiter_phone_taken":     "Рекрутер с номером телефона '{{.Phone}}' уже существует",
+	"error.request_timeout":           "Время ожидания запроса истекло, повторите попытку позже",
+	"error.resume_not_found":          "Резюме не найдено",
+	"error.telegram_chat_not_linked":  "Telegram-чат не привязан ни к одному пользователю",
+	"error.telegram_not_linked":       "Telegram-чат ещё не привязан, сначала откройте {{.LinkURL}}",
+	"error.unknown_channel":           "Неизвестный канал",
+	"error.unknown_entity":            "Неизвестный тип объекта",
+	"error.unknown_event":             "Неизвестное событие: {{.Event}}",
+	"error.unknown_notification_kind": "Неизвестный тип уведомления: {{.Kind}}",
+	"error.unsupported_locale":        "Язык не поддерживается",
+	"error.user_email_taken":          "Пользователь с электронной почтой '{{.Email}}' уже существует",
+	"error.user_not_found":            "Пользователь не найден",
+	"error.user_phone_taken":          "Пользователь с номером телефона '{{.Phone}}' уже существует",
+	"error.validation_failed":         "Данные запроса не прошли проверку",
+	"error.vacancy_not_found":         "Вакансия не найдена",
+	"error.version_mismatch":          "Запись была изменена другим запросом, получите её заново (ETag не совпадает)",
+	"error.version_required":          "Версия записи обязательна, сначала получите запись",
+	"error.webhook_not_found":         "Вебхук не найден",
+
+	// Правила проверки полей
+	"validation.required":           "Обязательное поле",
+	"validation.email":              "Некорректный адрес электронной почты",
+	"validation.phone":              "Номер телефона должен быть в формате E.164 или узбекском формате",
+	"validation.gender":             "Должно быть 'm' или 'f'",
+	"validation.date":               "Должна быть дата в формате YYYY-MM-DD",
+	"validation.pastdate":           "Должна быть прошедшая дата в формате YYYY-MM-DD",
+	"validation.datetime":           "Должно соответствовать формату {{.Param}}",
+	"validation.min":                "Должно быть не меньше {{.Param}}",
+	"validation.max":                "Должно быть не больше {{.Param}}",
+	"validation.min_length":         "Должно содержать не меньше {{.Param}} символов",
+	"validation.max_length":         "Должно содержать не больше {{.Param}} символов",
+	"validation.oneof":              "Должно быть одним из: {{.Param}}",
+	"validation.http_url":           "Должен быть абсолютный http(s) URL",
+	"validation.min_age":            "Кандидату должно быть не меньше {{.Param}} лет",
+	"validation.max_age":            "Кандидату должно быть не больше {{.Param}} лет",
+	"validation.min_experience":     "Требуется опыт работы не меньше {{.Param}} лет",
+	"validation.position_match":     "Ни одно резюме не соответствует должности '{{.Param}}'",
+	"validation.required_languages": "Кандидат должен владеть языками: {{.Param}}",
+	"validation.resume_required":    "У кандидата нет резюме",
+	"validation.expand_depth":       "Допускается не более {{.Param}} вложенных связей",
+	"validation.integer":            "Должно быть целым числом",
+	"validation.uuid":               "Должно быть UUID",
+	"validation.user_exists":        "Активный пользователь с таким значением не найден",
+	"validation.import_column":      "Столбца '{{.Param}}' нет в файле",
+
+	// Успешные операции
+	"message.company_deleted":   "Компания успешно удалена",
+	"message.company_updated":   "Компания успешно обновлена",
+	"message.recruiter_deleted": "Рекрутер успешно удалён",
+	"message.recruiter_updated": "Рекрутер успешно обновлён",
+	"message.resume_deleted":    "Резюме успешно удалено",
+	"message.resume_updated":    "Резюме успешно обновлено",
+	"message.user_deleted":      "Пользователь успешно удалён",
+	"message.user_updated":      "Пользователь успешно обновлён",
+	"message.vacancy_deleted":   "Вакансия успешно удалена",
+	"message.vacancy_updated":   "Вакансия успешно обновлена",
+	"message.webhook_deleted":   "Вебхук успешно удалён",
+
+	// Уведомления
+	"notification.interview_scheduled.subject":        "Назначено собеседование: {{.VacancyName}}",
+	"notification.interview_scheduled.body":           "Здравствуйте, {{.Name}}!\n\nСобеседование по вакансии «{{.VacancyName}}» назначено на {{.InterviewDate}}.\n\nЖелаем удачи!",
+	"notification.interview_rescheduled.subject":      "Собеседование перенесено: {{.VacancyName}}",
+	"notification.interview_rescheduled.body":         "Здравствуйте, {{.Name}}!\n\nСобеседование по вакансии «{{.VacancyName}}» перенесено. Новое время: {{.InterviewDate}}.",
+	"notification.interview_cancelled.subject":        "Собеседование отменено: {{.VacancyName}}",
+	"notification.interview_cancelled.body":           "Здравствуйте, {{.Name}}!\n\nСобеседование по вакансии «{{.VacancyName}}», назначенное на {{.InterviewDate}}, отменено.",
+	"notification.interview_reminder.subject":         "Напоминание: собеседование в {{.InterviewDate}}",
+	"notification.interview_reminder.body":            "Здравствуйте, {{.Name}}!\n\nНапоминаем, что собеседование по вакансии «{{.VacancyName}}» состоится {{.InterviewDate}}.",
+	"notification.application_status_changed.subject": "Статус вашей заявки изменён: {{.VacancyName}}",
+	"notification.application_status_changed.body":    "Здравствуйте, {{.Name}}!\n\nСтатус вашей заявки на вакансию «{{.VacancyName}}» обновлён: {{.Status}}.",
+}
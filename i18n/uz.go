@@ -0,0 +1,102 @@
+package i18n
+
+var uz = map[string]string{
+	// Xatoliklar
+	"error.already_exists":            "Bunday yozuv allaqachon mavjud",
+	"error.candidate_not_eligible":    "Nomzod vakansiya talablariga mos kelmaydi",
+	"error.company_not_found":         "Berilgan IDga ega kompaniya mavjud emas",
+	"error.constraint_violation":      "Qiymat ma'lumotlar bazasi cheklovini buzadi",
+	"error.idempotency_key_in_use":    "Shu Idempotency-Key bilan yuborilgan so'rov hali bajarilmoqda",
+	"error.idempotency_key_reused":    "Bu Idempotency-Key boshqa so'rov tanasi bilan ishlatilgan",
+	"error.internal_error":            "Serverda ichki xatolik yuz berdi",
+	"error.if_match_required":         "If-Match sarlavhasi majburiy, avval yozuvni GET orqali oling",
+	"error.import_file_too_large":     "Import fayli {{.Limit}} dan oshmasligi kerak",
+	"error.import_too_many_rows":      "Import faylida ko'pi bilan {{.Limit}} ta qator bo'lishi kerak",
+	"error.interview_not_found":       "Intervyu topilmadi",
+	"error.invalid_body":              "So'rov tanasini o'qib bo'lmadi",
+	"error.invalid_date":              "Noto'g'ri sana formati. YYYY-MM-DD shaklida kiriting",
+	"error.invalid_eligibility_rule":  "Moslik qoidalari noto'g'ri",
+	"error.invalid_id":                "Noto'g'ri ID formati",
+	"error.invalid_idempotency_key":   "Idempotency-Key 255 belgidan oshmasligi kerak",
+	"error.invalid_import_file":       "Import fayli to'g'ri CSV yoki XLSX jadval emas",
+	"error.invalid_import_mapping":    "Ustunlar xaritasi noto'g'ri",
+	"error.invalid_json":              "So'rov tanasidagi JSON noto'g'ri",
+	"error.invalid_phone":             "Telefon raqami O'zbekiston raqami emas",
+	"error.invalid_query":             "So'rov parametri noto'g'ri",
+	"error.invalid_reference":         "Bog'langan yozuv mavjud emas",
+	"error.invalid_secret_token":      "Maxfiy token noto'g'ri",
+	"error.merge_same_user":           "Foydalanuvchini o'zi bilan birlashtirib bo'lmaydi",
+	"error.query_too_deep":            "So'rov chuqurligi {{.Depth}} chegaradan ({{.Limit}}) oshib ketdi",
+	"error.query_too_expensive":       "So'rov narxi {{.Cost}} chegaradan ({{.Limit}}) oshib ketdi, first ni kamaytiring",
+	"error.recruiter_email_taken":     "'{{.Email}}' elektron pochtali yollanma xodim allaqachon mavjud",
+	"error.recruiter_not_found":       "Yollanma xodim topilmadi",
+	"error.recruiter_phone_taken":     "'{{.Phone}}' telefon raqamli rekruiter allaqachon mavjud",
+	"error.request_timeout":           "So'rov vaqti tugadi, birozdan keyin qayta urinib ko'ring",
+	"error.resume_not_found":          "Rezyume topilmadi",
+	"error.telegram_chat_not_linked":  "Telegram chat hech bir foydalanuvchiga bog'lanmagan",
+	"error.telegram_not_linked":       "Telegram chat hali bog'lanmagan, avval {{.LinkURL}} havolasini oching",
+	"error.unknown_channel":           "Noma'lum kanal",
+	"error.unknown_entity":            "Noma'lum obyekt turi",
+	"error.unknown_event":             "Noma'lum event: {{.Event}}",
+	"error.unknown_notification_kind": "Noma'lum bildirishnoma turi: {{.Kind}}",
+	"error.unsupported_locale":        "Bu til qo'llab-quvvatlanmaydi",
+	"error.user_email_taken":          "'{{.Email}}' emailli foydalanuvchi allaqachon mavjud",
+	"error.user_not_found":            "Foydalanuvchi topilmadi",
+	"error.user_phone_taken":          "'{{.Phone}}' telefon raqamli foydalanuvchi allaqachon mavjud",
+	"error.validation_failed":         "So'rov ma'lumotlari tekshiruvdan o'tmadi",
+	"error.vacancy_not_found":         "Vakansiya topilmadi",
+	"error.version_mismatch":          "Yozuv boshqa so'rov tomonidan o'zgartirilgan, uni qayta oling (ETag mos emas)",
+	"error.version_required":          "Yozuv versiyasi majburiy, avval yozuvni oling",
+	"error.webhook_not_found":         "Webhook topilmadi",
+
+	// Maydonlarni tekshirish qoidalari
+	"validation.required":           "To'ldirilishi shart",
+	"validation.email":              "Elektron pochta manzili noto'g'ri",
+	"validation.phone":              "Telefon raqami E.164 yoki O'zbekiston formatida bo'lishi kerak",
+	"validation.gender":             "'m' yoki 'f' bo'lishi kerak",
+	"validation.date":               "YYYY-MM-DD formatidagi sana bo'lishi kerak",
+	"validation.pastdate":           "YYYY-MM-DD formatidagi o'tgan sana bo'lishi kerak",
+	"validation.datetime":           "{{.Param}} formatida bo'lishi kerak",
+	"validation.min":                "Kamida {{.Param}} bo'lishi kerak",
+	"validation.max":                "Ko'pi bilan {{.Param}} bo'lishi kerak",
+	"validation.min_length":         "Kamida {{.Param}} ta belgidan iborat bo'lishi kerak",
+	"validation.max_length":         "Ko'pi bilan {{.Param}} ta belgidan iborat bo'lishi kerak",
+	"validation.oneof":              "Quyidagilardan biri bo'lishi kerak: {{.Param}}",
+	"validation.http_url":           "To'liq http(s) URL bo'lishi kerak",
+	"validation.min_age":            "Nomzod kamida {{.Param}} yoshda bo'lishi kerak",
+	"validation.max_age":            "Nomzod {{.Param}} yoshdan katta bo'lmasligi kerak",
+	"validation.min_experience":     "Nomzodning kamida {{.Param}} yillik tajribasi bo'lishi kerak",
+	"validation.position_match":     "Birorta rezyume '{{.Param}}' lavozimiga mos kelmaydi",
+	"validation.required_languages": "Nomzod quyidagi tillarni bilishi kerak: {{.Param}}",
+	"validation.resume_required":    "Nomzodda rezyume yo'q",
+	"validation.expand_depth":       "Ko'pi bilan {{.Param}} ta ichma-ich bog'lanishga ruxsat etiladi",
+	"validation.integer":            "Butun son bo'lishi kerak",
+	"validation.uuid":               "UUID bo'lishi kerak",
+	"validation.user_exists":        "Bu qiymatga mos faol foydalanuvchi topilmadi",
+	"validation.import_column":      "Faylda '{{.Param}}' ustuni yo'q",
+
+	// Muvaffaqiyatli amallar
+	"message.company_deleted":   "Kompaniya muvaffaqiyatli o'chirildi",
+	"message.company_updated":   "Kompaniya muvaffaqiyatli yangilandi",
+	"message.recruiter_deleted": "Yollanma xodim muvaffaqiyatli o'chirildi",
+	"message.recruiter_updated": "Yollanma xodim muvaffaqiyatli yangilandi",
+	"message.resume_deleted":    "Rezyume muvaffaqiyatli o'chirildi",
+	"message.resume_updated":    "Rezyume muvaffaqiyatli yangilandi",
+	"message.user_deleted":      "Foydalanuvchi muvaffaqiyatli o'chirildi",
+	"message.user_updated":      "Foydalanuvchi muvaffaqiyatli yangilandi",
+	"message.vacancy_deleted":   "Vakansiya muvaffaqiyatli o'chirildi",
+	"message.vacancy_updated":   "Vakansiya muvaffaqiyatli yangilandi",
+	"message.webhook_deleted":   "Webhook muvaffaqiyatli o'chirildi",
+
+	// Bildirishnomalar. Maydonlar: .Name, .VacancyName, .InterviewDate, .Status
+	"notification.interview_scheduled.subject":        "Intervyu belgilandi: {{.VacancyName}}",
+	"notification.interview_scheduled.body":           "Assalomu alaykum, {{.Name}}!\n\n\"{{.VacancyName}}\" vakansiyasi bo'yicha intervyuingiz {{.InterviewDate}} ga belgilandi.\n\nOmad tilaymiz!",
+	"notification.interview_rescheduled.subject":      "Intervyu vaqti o'zgardi: {{.VacancyName}}",
+	"notification.interview_rescheduled.body":         "Assalomu alaykum, {{.Name}}!\n\n\"{{.VacancyName}}\" vakansiyasi bo'yicha intervyuingiz vaqti o'zgardi. Yangi vaqt: {{.InterviewDate}}.",
+	"notification.interview_cancelled.subject":        "Intervyu bekor qilindi: {{.VacancyName}}",
+	"notification.interview_cancelled.body":           "Assalomu alaykum, {{.Name}}!\n\n{{.InterviewDate}} ga belgilangan \"{{.VacancyName}}\" intervyusi bekor qilindi.",
+	"notification.interview_reminder.subject":         "Eslatma: intervyu {{.InterviewDate}} da",
+	"notification.interview_reminder.body":            "Assalomu alaykum, {{.Name}}!\n\n\"{{.VacancyName}}\" vakansiyasi bo'yicha intervyuingiz {{.InterviewDate}} da bo'lishini eslatib o'tamiz.",
+	"notification.application_status_changed.subject": "Arizangiz holati o'zgardi: {{.VacancyName}}",
+	"notification.application_status_changed.body":    "Assalomu alaykum, {{.Name}}!\n\n\"{{.VacancyName}}\" vakansiyasiga arizangiz holati yangilandi: {{.Status}}.",
+}
@@ -0,0 +1,398 @@
+// Package importer foydalanuvchilar, rezyumelar va vakansiyalarni CSV yoki XLSX faylidan
+// ommaviy yaratadi. Har bir qator Create DTO ga aylantirilib POST endpointlaridagi
+// `binding` qoidalari bilan tekshiriladi, xatolari qator raqami bilan hisobotga yoziladi.
+// To'g'ri qatorlar BatchSize tadan bitta tranzaksiyada yoziladi; AllOrNothing da esa
+// bitta xato qator ham butun importni bekor qiladi.
+package importer
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/models"
+	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/validation"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultBatchSize = 100
+	MaxBatchSize     = 1000
+)
+
+// Importer - qatorlarni tekshirib, tranzaksiyalarda yozadi
+type Importer struct {
+	Users      postgres.UserRepository
+	Resumes    postgres.ResumeRepository
+	Vacancies  *service.VacancyService // kompaniya mavjudligini POST /vacancies/ kabi tekshiradi
+	UnitOfWork postgres.UnitOfWork
+	Audit      *audit.Recorder
+}
+
+// Options - bitta import sozlamalari
+type Options struct {
+	BatchSize    int  // bitta tranzaksiyadagi qatorlar, 0 bo'lsa DefaultBatchSize
+	AllOrNothing bool // barcha qatorlar bitta tranzaksiyada, birorta xato bo'lsa hech narsa yozilmaydi
+	Source       audit.Source
+	// Localize qator xatoligining matni va tafsilotlarini so'rov tiliga o'giradi, nil bo'lsa inglizcha qoladi
+	Localize func(*apperrors.Error) (string, []apperrors.FieldError)
+}
+
+// pending - tekshiruvdan o'tgan, yozilishini kutayotgan qator
+type pending struct {
+	line int
+	dto  interface{}
+}
+
+// created - tranzaksiyada yaratilgan yozuv (audit tranzaksiyadan keyin yoziladi)
+type created struct {
+	line   int
+	id     uuid.UUID
+	record interface{}
+}
+
+// Import jadvalni entity turidagi yozuvlarga aylantiradi va yozadi. Qatorlardagi xatoliklar
+// hisobotga tushadi; qaytgan xatolik esa butun importga tegishli (noto'g'ri xarita,
+// baza ishlamayapti). Bunday holda oldingi partiyalar yozilganicha qoladi.
+func (i *Importer) Import(ctx context.Context, entity string, table Table, spec Spec, opts Options) (models.ImportReport, error) {
+	report, err := i.run(ctx, entity, table, spec, opts)
+	// tekshiruv va yozish xatoliklari har xil bosqichda yig'iladi, hisobotda fayl tartibida
+	sort.SliceStable(report.Errors, func(a, b int) bool { return report.Errors[a].Row < report.Errors[b].Row })
+	return report, err
+}
+
+func (i *Importer) run(ctx context.Context, entity string, table Table, spec Spec, opts Options) (models.ImportReport, error) {
+	report := models.ImportReport{Entity: entity, Total: len(table.Rows), Records: []models.ImportedRecord{}, Errors: []models.ImportRowError{}}
+	index, err := spec.columns(entity, table.Header)
+	if err != nil {
+		return report, err
+	}
+
+	values := make([]map[string]string, len(table.Rows))
+	for n, row := range table.Rows {
+		values[n] = spec.values(index, row)
+	}
+	convert, create, err := i.entity(ctx, entity, values)
+	if err != nil {
+		return report, err
+	}
+
+	var rows []pending
+	for n, row := range table.Rows {
+		dto, err := convert(values[n])
+		if err != nil {
+			i.fail(&report, row.Line, err, opts)
+			continue
+		}
+		rows = append(rows, pending{line: row.Line, dto: dto})
+	}
+
+	if opts.AllOrNothing {
+		if report.Failed > 0 {
+			report.RolledBack = true
+			return report, nil
+		}
+		if len(rows) == 0 {
+			return report, nil
+		}
+		records, failed, err := i.write(ctx, rows, create)
+		if failed >= 0 {
+			i.fail(&report, rows[failed].line, err, opts)
+			report.RolledBack = true
+			return report, nil
+		}
+		if err != nil {
+			return report, err
+		}
+		i.done(ctx, &report, entity, records, opts)
+		return report, nil
+	}
+
+	size := opts.BatchSize
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+	for start := 0; start < len(rows); start += size {
+		batch := rows[start:min(start+size, len(rows))]
+		// Xato qator partiyadan chiqariladi va qolganlari qaytadan yoziladi: Postgres da
+		// xatolikdan keyin tranzaksiyani davom ettirib bo'lmaydi
+		for len(batch) > 0 {
+			records, failed, err := i.write(ctx, batch, create)
+			if failed >= 0 {
+				i.fail(&report, batch[failed].line, err, opts)
+				batch = append(batch[:failed:failed], batch[failed+1:]...)
+				continue
+			}
+			if err != nil {
+				return report, err
+			}
+			i.done(ctx, &report, entity, records, opts)
+			break
+		}
+	}
+	return report, nil
+}
+
+// write qatorlarni bitta tranzaksiyada yozadi. Qator xatoligida (validatsiya, band email,
+// topilmagan kompaniya) tranzaksiya bekor qilinadi va shu qatorning indeksi qaytadi,
+// boshqa xatoliklarda indeks -1 bo'ladi.
+func (i *Importer) write(ctx context.Context, rows []pending, create func(context.Context, interface{}) (uuid.UUID, interface{}, error)) ([]created, int, error) {
+	var records []created
+	failed := -1
+	err := i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		// fn qayta bajarilishi mumkin, natija har safar boshidan yig'iladi
+		records, failed = records[:0], -1
+		for n, row := range rows {
+			id, record, err := create(ctx, row.dto)
+			if err != nil {
+				if rowError(err) {
+					failed = n
+				}
+				return err
+			}
+			records = append(records, created{line: row.line, id: id, record: record})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, failed, err
+	}
+	return records, -1, nil
+}
+
+// rowError xatolik qatorning o'ziga tegishlimi: baza yoki vaqt xatoliklari butun importni to'xtatadi
+func rowError(err error) bool {
+	switch apperrors.From(err).Kind {
+	case apperrors.KindInternal, apperrors.KindTimeout:
+		return false
+	}
+	return true
+}
+
+// done yozilgan partiyani hisobotga qo'shadi va audit yozuvlarini saqlaydi
+func (i *Importer) done(ctx context.Context, report *models.ImportReport, entity string, records []created, opts Options) {
+	report.Batches++
+	report.Created += len(records)
+	for _, record := range records {
+		report.Records = append(report.Records, models.ImportedRecord{Row: record.line, ID: record.id})
+		i.Audit.RecordContext(ctx, opts.Source, entity, record.id.String(), audit.ActionCreate, nil, record.record)
+	}
+}
+
+func (i *Importer) fail(report *models.ImportReport, line int, err error, opts Options) {
+	appErr := apperrors.From(err)
+	message, details := appErr.Message, appErr.Fields
+	if opts.Localize != nil {
+		message, details = opts.Localize(appErr)
+	}
+	report.Failed++
+	report.Errors = append(report.Errors, models.ImportRowError{Row: line, Code: appErr.Code, Message: message, Details: details})
+}
+
+// entity yozuv turi uchun qatorni DTO ga aylantiruvchi va DTO ni yozuvchi funksiyalarni qaytaradi
+func (i *Importer) entity(ctx context.Context, entity string, values []map[string]string) (func(map[string]string) (interface{}, error), func(context.Context, interface{}) (uuid.UUID, interface{}, error), error) {
+	switch entity {
+	case audit.EntityUser:
+		return convertUser, func(ctx context.Context, dto interface{}) (uuid.UUID, interface{}, error) {
+			user, err := i.Users.CreateUser(ctx, dto.(models.UserCreate))
+			return user.ID, user, err
+		}, nil
+	case audit.EntityResume:
+		users, err := i.resumeUsers(ctx, values)
+		if err != nil {
+			return nil, nil, err
+		}
+		convert := func(values map[string]string) (interface{}, error) {
+			return convertResume(values, users)
+		}
+		return convert, func(ctx context.Context, dto interface{}) (uuid.UUID, interface{}, error) {
+			resume, err := i.Resumes.CreateResume(ctx, dto.(models.CreateResume))
+			return resume.ID, resume, err
+		}, nil
+	case audit.EntityVacancy:
+		return convertVacancy, func(ctx context.Context, dto interface{}) (uuid.UUID, interface{}, error) {
+			vacancy, err := i.Vacancies.Create(ctx, dto.(models.CreateVacancy))
+			return vacancy.ID, vacancy, err
+		}, nil
+	}
+	return nil, nil, apperrors.Validation("unknown_entity", "Unknown entity type")
+}
+
+// userLookup - rezyume qatorlaridagi user_id va user_email bo'yicha topilgan faol foydalanuvchilar
+type userLookup struct {
+	byID    map[uuid.UUID]bool
+	byEmail map[string]uuid.UUID
+}
+
+// resumeUsers fayldagi barcha user_id va user_email larni ikki so'rovda tekshiradi
+func (i *Importer) resumeUsers(ctx context.Context, values []map[string]string) (userLookup, error) {
+	var ids []uuid.UUID
+	var emails []string
+	for _, row := range values {
+		if id, err := uuid.Parse(row["user_id"]); err == nil {
+			ids = append(ids, id)
+		}
+		if row["user_email"] != "" {
+			emails = append(emails, row["user_email"])
+		}
+	}
+
+	lookup := userLookup{byID: map[uuid.UUID]bool{}, byEmail: map[string]uuid.UUID{}}
+	if len(ids) > 0 {
+		users, err := i.Users.GetUsersByIDs(ctx, ids)
+		if err != nil {
+			return lookup, err
+		}
+		for _, user := range users {
+			lookup.byID[user.ID] = user.DeletedAt == 0
+		}
+	}
+	if len(emails) > 0 {
+		users, err := i.Users.GetUsersByEmails(ctx, emails)
+		if err != nil {
+			return lookup, err
+		}
+		for _, user := range users {
+			lookup.byEmail[strings.ToLower(user.Email)] = user.ID
+		}
+	}
+	return lookup, nil
+}
+
+func convertUser(values map[string]string) (interface{}, error) {
+	dto := models.UserCreate{
+		Name:        values["name"],
+		Email:       values["email"],
+		PhoneNumber: values["phone_number"],
+		Birthday:    normalizeDate(values["birthday"]),
+		Gender:      strings.ToLower(values["gender"]),
+	}
+	return dto, check(&dto, nil)
+}
+
+func convertResume(values map[string]string, users userLookup) (interface{}, error) {
+	var problems []apperrors.FieldError
+	dto := models.CreateResume{
+		Position:    values["position"],
+		Description: values["description"],
+		Languages:   splitList(values["languages"]),
+	}
+	dto.Experience, problems = parseInt(values, "experience", problems)
+	dto.UserID, problems = parseUUID(values, "user_id", problems)
+
+	var covered []string
+	switch email := values["user_email"]; {
+	case dto.UserID != uuid.Nil:
+		if !users.byID[dto.UserID] {
+			problems = append(problems, missing("user_id"))
+		}
+	case email != "" && values["user_id"] == "":
+		if id, ok := users.byEmail[strings.ToLower(email)]; ok {
+			dto.UserID = id
+		} else {
+			// user_id ning "required" xatosi emas, sababi - topilmagan email ko'rsatiladi
+			problems, covered = append(problems, missing("user_email")), []string{"user_id"}
+		}
+	}
+	return dto, check(&dto, problems, covered...)
+}
+
+func convertVacancy(values map[string]string) (interface{}, error) {
+	var problems []apperrors.FieldError
+	dto := models.CreateVacancy{
+		Name:        values["name"],
+		Position:    values["position"],
+		Description: values["description"],
+	}
+	dto.MinExp, problems = parseInt(values, "min_exp", problems)
+	dto.CompanyID, problems = parseUUID(values, "company_id", problems)
+	return dto, check(&dto, problems)
+}
+
+// check DTO ni `binding` qoidalari bilan tekshiradi. Turga aylantirishda xato bo'lgan
+// maydonlar va covered uchun validatorning (masalan "required") xatosi qo'shilmaydi.
+func check(dto interface{}, problems []apperrors.FieldError, covered ...string) error {
+	if err := validation.Struct(dto); err != nil {
+		for _, field := range apperrors.From(err).Fields {
+			if !hasField(problems, field.Field) && !contains(covered, field.Field) {
+				problems = append(problems, field)
+			}
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return apperrors.Validation("validation_failed", "Request validation failed", problems...)
+}
+
+func hasField(problems []apperrors.FieldError, field string) bool {
+	for _, problem := range problems {
+		if problem.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+func parseInt(values map[string]string, field string, problems []apperrors.FieldError) (int, []apperrors.FieldError) {
+	value := values[field]
+	if value == "" {
+		return 0, problems
+	}
+	// XLSX dagi sonlar "3" yoki "3.0" ko'rinishida kelishi mumkin
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n != float64(int(n)) {
+		return 0, append(problems, apperrors.FieldError{Field: field, Message: "must be a whole number", Rule: "integer"})
+	}
+	return int(n), problems
+}
+
+func parseUUID(values map[string]string, field string, problems []apperrors.FieldError) (uuid.UUID, []apperrors.FieldError) {
+	value := values[field]
+	if value == "" {
+		return uuid.Nil, problems
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, append(problems, apperrors.FieldError{Field: field, Message: "must be a UUID", Rule: "uuid"})
+	}
+	return id, problems
+}
+
+func missing(field string) apperrors.FieldError {
+	return apperrors.FieldError{Field: field, Message: "no active user matches this value", Rule: "user_exists"}
+}
+
+// splitList "uz, ru; en" ko'rinishidagi ro'yxatni ajratadi
+func splitList(value string) []string {
+	parts := strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' })
+	list := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			list = append(list, part)
+		}
+	}
+	return list
+}
+
+// excelEpoch - Excel seriya sanalarining boshlanishi (1900 yil kabisa xatosi hisobga olingan)
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// normalizeDate XLSX seriya raqamini (33253) va "15.01.1991" ko'rinishidagi sanani
+// YYYY-MM-DD ga aylantiradi, qolgan qiymatlar o'zgarmaydi va validatsiyada tekshiriladi
+func normalizeDate(value string) string {
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 {
+		return excelEpoch.AddDate(0, 0, int(serial)).Format("2006-01-02")
+	}
+	if date, err := time.Parse("02.01.2006", value); err == nil {
+		return date.Format("2006-01-02")
+	}
+	return value
+}
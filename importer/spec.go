@@ -0,0 +1,124 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+)
+
+// fields - har bir yozuv turi uchun fayldan o'qiladigan maydonlar, tartibi hujjatdagidek.
+// Maydon nomlari Create DTO larining json nomlari; resume uchun user_email user_id o'rniga
+// ishlatilishi mumkin.
+var fields = map[string][]string{
+	audit.EntityUser:    {"name", "email", "phone_number", "birthday", "gender"},
+	audit.EntityResume:  {"position", "experience", "description", "languages", "user_id", "user_email"},
+	audit.EntityVacancy: {"name", "position", "min_exp", "company_id", "description"},
+}
+
+// Fields yozuv turi uchun import qilinadigan maydonlar
+func Fields(entity string) []string {
+	return fields[entity]
+}
+
+// Spec - ustunlar xaritasi. Columns maydonni fayldagi ustun sarlavhasiga bog'laydi
+// (katta-kichik harf farqlanmaydi), xaritada yo'q maydon o'z nomidagi ustundan o'qiladi.
+// Defaults ustuni yo'q yoki katagi bo'sh qatorlar uchun qiymat beradi, masalan
+// barcha vakansiyalar uchun bitta company_id.
+//
+//	{"columns": {"name": "Full name", "phone_number": "Phone"}, "defaults": {"gender": "f"}}
+type Spec struct {
+	Columns  map[string]string `json:"columns"`
+	Defaults map[string]string `json:"defaults"`
+}
+
+// ParseSpec JSON dan Spec o'qiydi, bo'sh matn bo'sh Spec beradi
+func ParseSpec(data string) (Spec, error) {
+	var spec Spec
+	if strings.TrimSpace(data) == "" {
+		return spec, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		appErr := apperrors.Validation("invalid_import_mapping", "Invalid column mapping").WithField("mapping", "must be a JSON object with columns and defaults")
+		appErr.Err = err
+		return Spec{}, appErr
+	}
+	return spec, nil
+}
+
+// columns har bir maydonning sarlavhadagi indeksini topadi (ustuni yo'q maydonlar -1).
+// Noma'lum maydon yoki faylda topilmagan xaritadagi ustun invalid_import_mapping bo'ladi.
+func (s Spec) columns(entity string, header []string) (map[string]int, error) {
+	known := fields[entity]
+	allowed := strings.Join(known, " ")
+	var problems []apperrors.FieldError
+	for _, group := range []struct {
+		name   string
+		values map[string]string
+	}{{"columns", s.Columns}, {"defaults", s.Defaults}} {
+		for _, field := range sortedKeys(group.values) {
+			if !contains(known, field) {
+				problems = append(problems, apperrors.FieldError{Field: group.name + "." + field, Message: "must be one of: " + allowed, Rule: "oneof", Param: allowed})
+			}
+		}
+	}
+
+	index := make(map[string]int, len(known))
+	for _, field := range known {
+		column, mapped := s.Columns[field]
+		if !mapped {
+			column = field
+		}
+		index[field] = -1
+		for i, name := range header {
+			if strings.EqualFold(name, strings.TrimSpace(column)) {
+				index[field] = i
+				break
+			}
+		}
+		if mapped && index[field] < 0 {
+			problems = append(problems, apperrors.FieldError{Field: "columns." + field, Message: "column '" + column + "' is not in the file", Rule: "import_column", Param: column})
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, apperrors.Validation("invalid_import_mapping", "Invalid column mapping", problems...)
+	}
+	return index, nil
+}
+
+// values qatordagi maydon qiymatlari: ustundagi qiymat, u bo'sh bo'lsa Defaults dagisi
+func (s Spec) values(index map[string]int, row Row) map[string]string {
+	values := make(map[string]string, len(index))
+	for field, i := range index {
+		if i >= 0 && i < len(row.Values) && row.Values[i] != "" {
+			values[field] = row.Values[i]
+			continue
+		}
+		values[field] = strings.TrimSpace(s.Defaults[field])
+	}
+	return values
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
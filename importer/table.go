@@ -0,0 +1,161 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"hrplatform/apperrors"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format - import faylining formati
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// MaxRows - bitta fayldagi ma'lumot qatorlarining eng katta soni
+const MaxRows = 10000
+
+// Table - fayldan o'qilgan jadval. Birinchi qator sarlavha, to'liq bo'sh qatorlar tashlab yuboriladi.
+type Table struct {
+	Header []string
+	Rows   []Row
+}
+
+// Row - bitta ma'lumot qatori. Line fayldagi qator raqami (sarlavha 1-qator).
+type Row struct {
+	Line   int
+	Values []string
+}
+
+// DetectFormat fayl formatini kengaytmasi bo'yicha, u bo'lmasa tarkibi bo'yicha aniqlaydi:
+// XLSX zip arxiv, qolgani CSV deb olinadi
+func DetectFormat(filename string, data []byte) Format {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".xlsx":
+		return FormatXLSX
+	case ".csv", ".txt":
+		return FormatCSV
+	}
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return FormatXLSX
+	}
+	return FormatCSV
+}
+
+// ReadTable faylni jadvalga o'qiydi. XLSX da birinchi varaq olinadi.
+func ReadTable(data []byte, format Format) (Table, error) {
+	var records [][]string
+	var err error
+	switch format {
+	case FormatXLSX:
+		records, err = readXLSX(data)
+	default:
+		records, err = readCSV(data)
+	}
+	if err != nil {
+		return Table{}, invalidFile(err)
+	}
+
+	var table Table
+	for i, record := range records {
+		if blank(record) {
+			continue
+		}
+		if table.Header == nil {
+			table.Header = trimAll(record)
+			continue
+		}
+		if len(table.Rows) == MaxRows {
+			return Table{}, apperrors.Validation("import_too_many_rows", "The import file has too many rows").WithArg("Limit", MaxRows)
+		}
+		table.Rows = append(table.Rows, Row{Line: i + 1, Values: trimAll(record)})
+	}
+	if table.Header == nil {
+		return Table{}, invalidFile(errors.New("no header row"))
+	}
+	return table, nil
+}
+
+// readCSV UTF-8 BOM ni olib tashlaydi va ajratuvchini (vergul, nuqtali vergul yoki tab)
+// sarlavha qatoridan aniqlaydi: Excel ru/uz lokalida CSV ni ";" bilan saqlaydi
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	header := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		header = data[:i]
+	}
+	delimiter, best := ',', bytes.Count(header, []byte(","))
+	for _, candidate := range []rune{';', '\t'} {
+		if n := bytes.Count(header, []byte(string(candidate))); n > best {
+			delimiter, best = candidate, n
+		}
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// csv.Reader bo'sh qatorlarni o'tkazib yuboradi, qator raqamlari fayldagidek qolishi uchun ular tiklanadi
+		line, _ := reader.FieldPos(0)
+		for len(records) < line-1 {
+			records = append(records, nil)
+		}
+		records = append(records, record)
+	}
+}
+
+// readXLSX birinchi varaqning xom qiymatlarini o'qiydi: sanalar Excel seriya raqami
+// ko'rinishida qoladi va maydon turiga qarab aylantiriladi (normalizeDate)
+func readXLSX(data []byte) ([][]string, error) {
+	file, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, errors.New("workbook has no sheets")
+	}
+	return file.GetRows(sheets[0], excelize.Options{RawCellValue: true})
+}
+
+func invalidFile(err error) *apperrors.Error {
+	appErr := apperrors.Validation("invalid_import_file", "The import file is not a valid CSV or XLSX table")
+	appErr.Err = err
+	return appErr
+}
+
+func blank(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func trimAll(record []string) []string {
+	values := make([]string, len(record))
+	for i, value := range record {
+		values[i] = strings.TrimSpace(value)
+	}
+	return values
+}
@@ -0,0 +1,170 @@
+package integration
+
+import (
+	"net/http"
+	"time"
+
+	"hrplatform/contract"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+// Case - bitta HTTP stsenariy
+type Case struct {
+	Name string
+	Run  func(t contract.T, api *Client)
+}
+
+// Cases - barcha stsenariylar, route guruhlari bo'yicha
+func Cases() []Case {
+	var cases []Case
+	cases = append(cases, userCases...)
+	cases = append(cases, resumeCases...)
+	cases = append(cases, companyCases...)
+	cases = append(cases, recruiterCases...)
+	cases = append(cases, vacancyCases...)
+	cases = append(cases, interviewCases...)
+	cases = append(cases, eligibilityCases...)
+	cases = append(cases, sdkCases...)
+	cases = append(cases, versioningCases...)
+	cases = append(cases, importCases...)
+	return cases
+}
+
+// Run stsenariylarni baseURL dagi serverga qarshi ketma-ket bajaradi
+func Run(baseURL string, cases []Case) []contract.Result {
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	results := make([]contract.Result, 0, len(cases))
+	for _, c := range cases {
+		c := c
+		results = append(results, contract.Check(c.Name, func(t contract.T) {
+			c.Run(t, &Client{BaseURL: baseURL, Prefix: APIPrefix, HTTP: httpClient, T: t})
+		}))
+	}
+	return results
+}
+
+// Quyidagi yordamchilar API orqali yangi yozuv yaratadi va 201 ni talab qiladi
+
+func createUser(api *Client, birthday, gender string) models.User {
+	api.T.Helper()
+	var user models.User
+	api.Expect(http.StatusCreated, &user, http.MethodPost, "/users/", models.UserCreate{
+		Name:        contract.UniqueName("User"),
+		Email:       contract.UniqueEmail("user"),
+		PhoneNumber: contract.UniquePhone(),
+		Birthday:    birthday,
+		Gender:      gender,
+	})
+	return user
+}
+
+func createCompany(api *Client) models.Company {
+	api.T.Helper()
+	var company models.Company
+	api.Expect(http.StatusCreated, &company, http.MethodPost, "/companies/", models.CreateCompany{
+		Name:     contract.UniqueName("Company"),
+		Location: "Tashkent",
+		Workers:  10,
+	})
+	return company
+}
+
+func createRecruiter(api *Client, companyID uuid.UUID) models.Recruiter {
+	api.T.Helper()
+	var recruiter models.Recruiter
+	api.Expect(http.StatusCreated, &recruiter, http.MethodPost, "/recruiters/", models.CreateRecruiter{
+		Name:        contract.UniqueName("Recruiter"),
+		Email:       contract.UniqueEmail("recruiter"),
+		PhoneNumber: contract.UniquePhone(),
+		Birthday:    "1988-03-04",
+		Gender:      "f",
+		CompanyID:   companyID,
+	})
+	return recruiter
+}
+
+func createVacancy(api *Client, companyID uuid.UUID, position string, minExp int) models.Vacancy {
+	api.T.Helper()
+	var vacancy models.Vacancy
+	api.Expect(http.StatusCreated, &vacancy, http.MethodPost, "/vacancies/", models.CreateVacancy{
+		Name:        contract.UniqueName("Vacancy"),
+		Position:    position,
+		MinExp:      minExp,
+		CompanyID:   companyID,
+		Description: "integration",
+	})
+	return vacancy
+}
+
+func createResume(api *Client, userID uuid.UUID, position string, experience int, languages ...string) models.Resume {
+	api.T.Helper()
+	var resume models.Resume
+	api.Expect(http.StatusCreated, &resume, http.MethodPost, "/resumes/", models.CreateResume{
+		Position:    position,
+		Experience:  experience,
+		Description: "integration",
+		Languages:   languages,
+		UserID:      userID,
+	})
+	return resume
+}
+
+func createInterview(api *Client, userID, vacancyID, recruiterID uuid.UUID) models.Interview {
+	api.T.Helper()
+	var interview models.Interview
+	api.Expect(http.StatusCreated, &interview, http.MethodPost, "/interviews/", models.CreateInterview{
+		UserID:        userID,
+		VacancyID:     vacancyID,
+		RecruiterID:   recruiterID,
+		InterviewDate: "2030-01-02 10:00:00",
+	})
+	return interview
+}
+
+// hiring - intervyu uchun tayyor to'plam: kompaniya, rekruiter, vakansiya va unga mos nomzod
+type hiring struct {
+	Company   models.Company
+	Recruiter models.Recruiter
+	Vacancy   models.Vacancy
+	User      models.User
+	Resume    models.Resume
+}
+
+// newHiring standart qoidalardan (18+ yosh, lavozim aynan mos) o'tadigan nomzod bilan to'plam yaratadi
+func newHiring(api *Client, position string) hiring {
+	api.T.Helper()
+	h := hiring{Company: createCompany(api)}
+	h.Recruiter = createRecruiter(api, h.Company.ID)
+	h.Vacancy = createVacancy(api, h.Company.ID, position, 1)
+	h.User = createUser(api, "1990-01-01", "m")
+	h.Resume = createResume(api, h.User.ID, position, 3)
+	return h
+}
+
+// adultBirthday bugundan age yil oldingi sana, YYYY-MM-DD
+func adultBirthday(age int) string {
+	return time.Now().AddDate(-age, 0, -1).Format("2006-01-02")
+}
+
+// historyActions GET /.../:id/history javobidagi amallar ketma-ketligi
+func historyActions(api *Client, path string) []string {
+	api.T.Helper()
+	var entries []models.AuditEntry
+	api.Expect(http.StatusOK, &entries, http.MethodGet, path+"/history", nil)
+	actions := make([]string, len(entries))
+	for i, entry := range entries {
+		actions[i] = entry.Action
+	}
+	return actions
+}
+
+func hasAction(actions []string, action string) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"hrplatform/api/middleware"
+	"hrplatform/contract"
+)
+
+// APIPrefix - stsenariylardagi yo'llar shu prefiksga nisbatan yoziladi
+const APIPrefix = "/api/v1"
+
+// Client - stsenariylar uchun yupqa HTTP mijoz. Har bir metod kutilgan statusni tekshiradi,
+// mos kelmasa javob tanasi bilan birga xatolik yozib stsenariyni to'xtatadi.
+type Client struct {
+	BaseURL string // server manzili, prefikssiz
+	Prefix  string // har bir yo'l oldiga qo'shiladi: APIPrefix yoki eski yo'llar uchun ""
+	HTTP    *http.Client
+	T       contract.T
+}
+
+// Response - o'qib bo'lingan javob
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// ETag keyingi PUT/PATCH/DELETE uchun If-Match qiymati
+func (r Response) ETag() string {
+	return r.Header.Get("ETag")
+}
+
+// Header - so'rovga qo'shiladigan sarlavha
+type Header struct {
+	Name, Value string
+}
+
+func IfMatch(etag string) Header {
+	return Header{Name: "If-Match", Value: etag}
+}
+
+// Do so'rov yuboradi. body nil bo'lmasa JSON sifatida yuboriladi (string bo'lsa o'zgarishsiz).
+func (c *Client) Do(method, path string, body interface{}, headers ...Header) Response {
+	c.T.Helper()
+
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case string:
+		reader = strings.NewReader(b)
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			c.T.Errorf("%s %s: marshal body: %v", method, path, err)
+			c.T.FailNow()
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+c.Prefix+path, reader)
+	if err != nil {
+		c.T.Errorf("%s %s: %v", method, path, err)
+		c.T.FailNow()
+	}
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, h := range headers {
+		req.Header.Set(h.Name, h.Value)
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		c.T.Errorf("%s %s: %v", method, path, err)
+		c.T.FailNow()
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.T.Errorf("%s %s: read body: %v", method, path, err)
+		c.T.FailNow()
+	}
+	return Response{Status: resp.StatusCode, Header: resp.Header, Body: data}
+}
+
+// Expect so'rov yuboradi, status want bo'lishini talab qiladi va javobni out ga o'qiydi (out nil bo'lishi mumkin)
+func (c *Client) Expect(want int, out interface{}, method, path string, body interface{}, headers ...Header) Response {
+	c.T.Helper()
+	resp := c.Do(method, path, body, headers...)
+	if resp.Status != want {
+		c.T.Errorf("%s %s: status %d, want %d: %s", method, path, resp.Status, want, resp.Body)
+		c.T.FailNow()
+	}
+	if out != nil {
+		if err := json.Unmarshal(resp.Body, out); err != nil {
+			c.T.Errorf("%s %s: decode %s: %v", method, path, resp.Body, err)
+			c.T.FailNow()
+		}
+	}
+	return resp
+}
+
+// ExpectError so'rov xatolik bilan tugashini va javobdagi code ni tekshiradi
+func (c *Client) ExpectError(want int, code, method, path string, body interface{}, headers ...Header) middleware.ErrorResponse {
+	c.T.Helper()
+	var errResp middleware.ErrorResponse
+	c.Expect(want, &errResp, method, path, body, headers...)
+	if errResp.Code != code {
+		c.T.Errorf("%s %s: error code %q, want %q", method, path, errResp.Code, code)
+	}
+	return errResp
+}
@@ -0,0 +1,67 @@
+package integration
+
+import (
+	"net/http"
+
+	"hrplatform/contract"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+var companyCases = []Case{
+	{Name: "companies/crud with etag", Run: func(t contract.T, api *Client) {
+		company := createCompany(api)
+		path := "/companies/" + company.ID.String()
+
+		var got models.Company
+		resp := api.Expect(http.StatusOK, &got, http.MethodGet, path, nil)
+		contract.ExpectEqual(t, got.Name, company.Name, "name")
+
+		var companies []models.Company
+		api.Expect(http.StatusOK, &companies, http.MethodGet, "/companies/", nil)
+		contract.ExpectMembers(t, companyIDs(companies), []uuid.UUID{company.ID}, nil, "GET /companies")
+
+		update := got.ToUpdate()
+		update.Workers = 42
+		api.ExpectError(http.StatusBadRequest, "validation_failed", http.MethodPut, path, models.UpdateCompany{Workers: -1}, IfMatch(resp.ETag()))
+		api.Expect(http.StatusOK, nil, http.MethodPut, path, update, IfMatch(resp.ETag()))
+
+		var patched models.Company
+		resp = api.Expect(http.StatusOK, &patched, http.MethodPatch, path, `{"location": "Samarkand"}`, IfMatch(`"2"`))
+		contract.ExpectEqual(t, patched.Workers, 42, "workers after patch")
+		contract.ExpectEqual(t, patched.Location, "Samarkand", "location after patch")
+
+		api.Expect(http.StatusOK, nil, http.MethodDelete, path, nil, IfMatch(resp.ETag()))
+		api.Expect(http.StatusOK, &companies, http.MethodGet, "/companies/", nil)
+		contract.ExpectMembers(t, companyIDs(companies), nil, []uuid.UUID{company.ID}, "GET /companies after delete")
+
+		actions := historyActions(api, path)
+		contract.ExpectEqual(t, len(actions), 4, "history entries")
+	}},
+	{Name: "companies/delete cascades to vacancies, recruiters and interviews", Run: func(t contract.T, api *Client) {
+		h := newHiring(api, contract.UniqueName("Analyst"))
+		interview := createInterview(api, h.User.ID, h.Vacancy.ID, h.Recruiter.ID)
+
+		var company models.Company
+		resp := api.Expect(http.StatusOK, &company, http.MethodGet, "/companies/"+h.Company.ID.String(), nil)
+		api.Expect(http.StatusOK, nil, http.MethodDelete, "/companies/"+h.Company.ID.String(), nil, IfMatch(resp.ETag()))
+
+		api.ExpectError(http.StatusNotFound, "vacancy_not_found", http.MethodGet, "/vacancies/"+h.Vacancy.ID.String(), nil)
+		api.ExpectError(http.StatusNotFound, "recruiter_not_found", http.MethodGet, "/recruiters/"+h.Recruiter.ID.String(), nil)
+		api.ExpectError(http.StatusNotFound, "interview_not_found", http.MethodGet, "/interviews/"+interview.ID.String(), nil)
+
+		// o'chirilgan kompaniyaga yangi vakansiya ochib bo'lmaydi
+		api.ExpectError(http.StatusBadRequest, "company_not_found", http.MethodPost, "/vacancies/", models.CreateVacancy{
+			Name: "X", Position: "X", CompanyID: h.Company.ID, Description: "x",
+		})
+	}},
+}
+
+func companyIDs(companies []models.Company) []uuid.UUID {
+	ids := make([]uuid.UUID, len(companies))
+	for i, company := range companies {
+		ids[i] = company.ID
+	}
+	return ids
+}
@@ -0,0 +1,124 @@
+package integration
+
+import (
+	"net/http"
+	"strings"
+
+	"hrplatform/apperrors"
+	"hrplatform/contract"
+	"hrplatform/eligibility"
+
+	"github.com/google/uuid"
+)
+
+// companyRules - GET/PUT /companies/:id/eligibility-rules javobi
+type companyRules struct {
+	CompanyID uuid.UUID          `json:"company_id"`
+	Rules     []eligibility.Rule `json:"rules"`
+}
+
+// vacancyRules - GET/PUT /vacancies/:id/eligibility-rules javobi
+type vacancyRules struct {
+	VacancyID      uuid.UUID          `json:"vacancy_id"`
+	Rules          []eligibility.Rule `json:"rules"`
+	EffectiveRules []eligibility.Rule `json:"effective_rules"`
+}
+
+// eligibilityCheck - POST /vacancies/:id/eligibility-check javobi
+type eligibilityCheck struct {
+	Eligible bool                   `json:"eligible"`
+	Rules    []eligibility.Rule     `json:"rules"`
+	Reasons  []apperrors.FieldError `json:"reasons"`
+}
+
+var eligibilityCases = []Case{
+	{Name: "eligibility/default, company and vacancy rules", Run: func(t contract.T, api *Client) {
+		h := newHiring(api, contract.UniqueName("Data Engineer"))
+		companyPath := "/companies/" + h.Company.ID.String() + "/eligibility-rules"
+		vacancyPath := "/vacancies/" + h.Vacancy.ID.String() + "/eligibility-rules"
+
+		var vacancy vacancyRules
+		api.Expect(http.StatusOK, &vacancy, http.MethodGet, vacancyPath, nil)
+		contract.ExpectEqual(t, len(vacancy.Rules), 0, "own vacancy rules")
+		contract.ExpectEqual(t, ruleTypes(vacancy.EffectiveRules), ruleTypes(eligibility.DefaultRules()), "default effective rules")
+
+		var company companyRules
+		api.Expect(http.StatusOK, &company, http.MethodPut, companyPath, map[string]interface{}{"rules": []map[string]interface{}{
+			{"type": "min_age", "params": map[string]interface{}{"value": 21}},
+			{"type": "required_languages", "params": map[string]interface{}{"languages": []string{"en"}}},
+		}})
+		contract.ExpectEqual(t, len(company.Rules), 2, "company rules")
+		api.Expect(http.StatusOK, &company, http.MethodGet, companyPath, nil)
+		contract.ExpectEqual(t, len(company.Rules), 2, "company rules after GET")
+
+		var check eligibilityCheck
+		checkPath := "/vacancies/" + h.Vacancy.ID.String() + "/eligibility-check"
+		api.Expect(http.StatusOK, &check, http.MethodPost, checkPath, map[string]interface{}{"user_id": h.User.ID})
+		contract.ExpectEqual(t, check.Eligible, false, "eligible without languages")
+		expectReasons(t, check.Reasons, "required_languages")
+
+		// vakansiya qoidasi kompaniyaning shu turdagi qoidasini almashtiradi
+		api.Expect(http.StatusOK, &vacancy, http.MethodPut, vacancyPath, map[string]interface{}{"rules": []map[string]interface{}{
+			{"type": "required_languages", "params": map[string]interface{}{"languages": []string{"uz"}}},
+			{"type": "min_experience", "params": map[string]interface{}{"value": 5}},
+		}})
+		contract.ExpectEqual(t, len(vacancy.Rules), 2, "own vacancy rules after PUT")
+		for _, rule := range vacancy.EffectiveRules {
+			if rule.Type == eligibility.RuleRequiredLanguages && (len(rule.Params.Languages) != 1 || rule.Params.Languages[0] != "uz") {
+				t.Errorf("effective required_languages: got %v, want [uz]", rule.Params.Languages)
+			}
+			if rule.Type == eligibility.RuleMinAge && (rule.Params.Value == nil || *rule.Params.Value != 21) {
+				t.Errorf("effective min_age: got %v, want 21 from the company", rule.Params.Value)
+			}
+		}
+
+		api.Expect(http.StatusOK, &check, http.MethodPost, checkPath, map[string]interface{}{"user_id": h.User.ID})
+		expectReasons(t, check.Reasons, "required_languages", "min_experience")
+
+		candidate := createUser(api, "1990-01-01", "f")
+		createResume(api, candidate.ID, h.Vacancy.Position, 6, "uz")
+		api.Expect(http.StatusOK, &check, http.MethodPost, checkPath, map[string]interface{}{"user_id": candidate.ID})
+		contract.ExpectEqual(t, check.Eligible, true, "eligible candidate")
+		createInterview(api, candidate.ID, h.Vacancy.ID, h.Recruiter.ID)
+	}},
+	{Name: "eligibility/invalid rules and references", Run: func(t contract.T, api *Client) {
+		company := createCompany(api)
+		path := "/companies/" + company.ID.String() + "/eligibility-rules"
+
+		api.ExpectError(http.StatusBadRequest, "invalid_eligibility_rule", http.MethodPut, path, map[string]interface{}{"rules": []map[string]interface{}{
+			{"type": "zodiac_sign"},
+		}})
+		api.ExpectError(http.StatusBadRequest, "invalid_eligibility_rule", http.MethodPut, path, map[string]interface{}{"rules": []map[string]interface{}{
+			{"type": "min_age", "params": map[string]interface{}{"value": 500}},
+		}})
+		api.ExpectError(http.StatusNotFound, "vacancy_not_found", http.MethodGet, "/vacancies/"+uuid.NewString()+"/eligibility-rules", nil)
+
+		vacancy := createVacancy(api, company.ID, "Backend", 0)
+		api.ExpectError(http.StatusNotFound, "user_not_found", http.MethodPost, "/vacancies/"+vacancy.ID.String()+"/eligibility-check", map[string]interface{}{"user_id": uuid.New()})
+	}},
+}
+
+// ruleTypes qoidalar turlarini vergul bilan birlashtiradi (taqqoslash uchun)
+func ruleTypes(rules []eligibility.Rule) string {
+	types := make([]string, len(rules))
+	for i, rule := range rules {
+		types[i] = rule.Type
+	}
+	return strings.Join(types, ",")
+}
+
+// expectReasons sabablar orasida har bir rule borligini tekshiradi
+func expectReasons(t contract.T, reasons []apperrors.FieldError, rules ...string) {
+	t.Helper()
+	for _, rule := range rules {
+		found := false
+		for _, reason := range reasons {
+			if reason.Rule == rule {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("reasons: no %q in %+v", rule, reasons)
+		}
+	}
+}
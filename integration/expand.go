@@ -0,0 +1,179 @@
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"hrplatform/api/middleware"
+	"hrplatform/contract"
+	"hrplatform/models"
+)
+
+// expandedInterview - ?expand=user,vacancy.company,recruiter javobi
+type expandedInterview struct {
+	models.Interview
+	User    *models.User `json:"user"`
+	Vacancy *struct {
+		models.Vacancy
+		Company *models.Company `json:"company"`
+	} `json:"vacancy"`
+	Recruiter *models.Recruiter `json:"recruiter"`
+}
+
+// expandedCompany - ?expand=vacancies.interviews,recruiters javobi
+type expandedCompany struct {
+	models.Company
+	Vacancies []struct {
+		models.Vacancy
+		Interviews []models.Interview `json:"interviews"`
+	} `json:"vacancies"`
+	Recruiters []models.Recruiter `json:"recruiters"`
+}
+
+// ExpandCases ?expand= va ?fields= stsenariylari. batches - Server.Batches.
+func ExpandCases(batches *BatchCounter) []Case {
+	return []Case{
+		{Name: "expand/interview embeds user, vacancy.company and recruiter", Run: func(t contract.T, api *Client) {
+			h := newHiring(api, contract.UniqueName("Expand Position"))
+			interview := createInterview(api, h.User.ID, h.Vacancy.ID, h.Recruiter.ID)
+
+			batches.Reset()
+			var got expandedInterview
+			resp := api.Expect(http.StatusOK, &got, http.MethodGet, "/interviews/"+interview.ID.String()+"?expand=user,vacancy.company,recruiter", nil)
+			// users, vacancies, companies, recruiters
+			contract.ExpectEqual(t, batches.Calls(), int64(4), "batched lookups")
+			contract.ExpectEqual(t, resp.ETag(), fmt.Sprintf(`"%d"`, interview.Version), "ETag with expand")
+
+			contract.ExpectEqual(t, got.ID, interview.ID, "interview id")
+			contract.ExpectEqual(t, got.UserID, h.User.ID, "user_id is kept")
+			if got.User == nil || got.Vacancy == nil || got.Vacancy.Company == nil || got.Recruiter == nil {
+				t.Errorf("expanded interview: missing relations in %s", resp.Body)
+				return
+			}
+			contract.ExpectEqual(t, got.User.Email, h.User.Email, "user.email")
+			contract.ExpectEqual(t, got.Vacancy.Position, h.Vacancy.Position, "vacancy.position")
+			contract.ExpectEqual(t, got.Vacancy.Company.Name, h.Company.Name, "vacancy.company.name")
+			contract.ExpectEqual(t, got.Recruiter.ID, h.Recruiter.ID, "recruiter.id")
+		}},
+		{Name: "expand/lists and nested collections are batched", Run: func(t contract.T, api *Client) {
+			company := createCompany(api)
+			recruiter := createRecruiter(api, company.ID)
+			position := contract.UniqueName("Expand Batch")
+
+			var batchCalls []int64
+			for i := 0; i < 3; i++ {
+				vacancy := createVacancy(api, company.ID, position, 1)
+				user := createUser(api, "1990-01-01", "f")
+				createResume(api, user.ID, position, 2)
+				createInterview(api, user.ID, vacancy.ID, recruiter.ID)
+				if i == 1 {
+					continue
+				}
+
+				batches.Reset()
+				var interviews []expandedInterview
+				api.Expect(http.StatusOK, &interviews, http.MethodGet, "/interviews/?company_id="+company.ID.String()+"&expand=user,vacancy.company", nil)
+				var tree expandedCompany
+				api.Expect(http.StatusOK, &tree, http.MethodGet, "/companies/"+company.ID.String()+"?expand=vacancies.interviews,recruiters", nil)
+				batchCalls = append(batchCalls, batches.Calls())
+
+				contract.ExpectEqual(t, len(interviews), i+1, "interviews of the company")
+				for _, interview := range interviews {
+					if interview.User == nil || interview.Vacancy == nil || interview.Vacancy.Company == nil {
+						t.Errorf("interview %s: missing relations", interview.ID)
+						continue
+					}
+					contract.ExpectEqual(t, interview.User.ID, interview.UserID, "user matches user_id")
+					contract.ExpectEqual(t, interview.Vacancy.Company.ID, company.ID, "vacancy.company")
+				}
+				contract.ExpectEqual(t, len(tree.Vacancies), i+1, "company.vacancies")
+				contract.ExpectEqual(t, len(tree.Recruiters), 1, "company.recruiters")
+				for _, vacancy := range tree.Vacancies {
+					contract.ExpectEqual(t, len(vacancy.Interviews), 1, "interviews of vacancy "+vacancy.ID.String())
+				}
+			}
+			// users, vacancies, companies + vacanciesByCompany, interviewsByVacancy, recruitersByCompany
+			contract.ExpectEqual(t, batchCalls[0], int64(6), "batched lookups for 1 interview")
+			contract.ExpectEqual(t, batchCalls[1], int64(6), "batched lookups for 3 interviews")
+
+			// bog'langan yozuvlari yo'q ko'plik bog'lanish bo'sh ro'yxat
+			user := createUser(api, "1990-01-01", "m")
+			var got map[string]interface{}
+			api.Expect(http.StatusOK, &got, http.MethodGet, "/users/"+user.ID.String()+"?expand=resumes,interviews", nil)
+			for _, name := range []string{"resumes", "interviews"} {
+				if list, ok := got[name].([]interface{}); !ok || len(list) != 0 {
+					t.Errorf("%s of a user without them: got %#v, want []", name, got[name])
+				}
+			}
+		}},
+		{Name: "expand/sparse fieldsets", Run: func(t contract.T, api *Client) {
+			h := newHiring(api, contract.UniqueName("Fields Position"))
+			interview := createInterview(api, h.User.ID, h.Vacancy.ID, h.Recruiter.ID)
+
+			var got map[string]interface{}
+			api.Expect(http.StatusOK, &got, http.MethodGet, "/interviews/"+interview.ID.String()+"?expand=vacancy,user&fields=id,interview_date,vacancy.position,user", nil)
+			contract.ExpectEqual(t, keys(got), "id,interview_date,user,vacancy", "interview fields")
+			vacancy, _ := got["vacancy"].(map[string]interface{})
+			contract.ExpectEqual(t, keys(vacancy), "position", "vacancy fields")
+			contract.ExpectEqual(t, vacancy["position"], h.Vacancy.Position, "vacancy.position")
+			user, _ := got["user"].(map[string]interface{})
+			contract.ExpectEqual(t, user["email"], h.User.Email, "whole user is kept")
+
+			// expand siz ham ishlaydi, ro'yxatning har bir elementi cheklanadi
+			var resumes []map[string]interface{}
+			api.Expect(http.StatusOK, &resumes, http.MethodGet, "/resumes/?position="+url.QueryEscape(h.Resume.Position)+"&fields=id,user_name", nil)
+			contract.ExpectEqual(t, len(resumes), 1, "resumes of the position")
+			for _, resume := range resumes {
+				contract.ExpectEqual(t, keys(resume), "id,user_name", "resume fields")
+				contract.ExpectEqual(t, resume["user_name"], h.User.Name, "user_name")
+			}
+		}},
+		{Name: "expand/unknown relations, fields and too deep paths", Run: func(t contract.T, api *Client) {
+			h := newHiring(api, contract.UniqueName("Invalid Expand"))
+			path := "/vacancies/" + h.Vacancy.ID.String()
+
+			errResp := api.ExpectError(http.StatusBadRequest, "invalid_query", http.MethodGet, path+"?expand=recruiters", nil, Header{Name: "Accept-Language", Value: "ru"})
+			expectDetail(t, errResp, "expand", "oneof", "company interviews")
+			if len(errResp.Details) == 1 {
+				contract.ExpectEqual(t, errResp.Details[0].Message, "Должно быть одним из: company interviews", "localized detail")
+			}
+
+			errResp = api.ExpectError(http.StatusBadRequest, "invalid_query", http.MethodGet, path+"?expand=company.vacancies.company.recruiters", nil)
+			expectDetail(t, errResp, "expand", "expand_depth", "3")
+
+			errResp = api.ExpectError(http.StatusBadRequest, "invalid_query", http.MethodGet, "/interviews/?fields=id,salary", nil)
+			expectDetail(t, errResp, "fields", "oneof", "")
+
+			errResp = api.ExpectError(http.StatusBadRequest, "invalid_query", http.MethodGet, "/users/?fields=name.first", nil)
+			expectDetail(t, errResp, "fields", "oneof", "")
+		}},
+	}
+}
+
+// expectDetail xatolikda bitta maydon tafsiloti borligini tekshiradi. param bo'sh bo'lsa u tekshirilmaydi.
+func expectDetail(t contract.T, errResp middleware.ErrorResponse, field, rule, param string) {
+	t.Helper()
+	if len(errResp.Details) != 1 {
+		t.Errorf("details: got %+v, want one for %s", errResp.Details, field)
+		return
+	}
+	detail := errResp.Details[0]
+	contract.ExpectEqual(t, detail.Field, field, "detail field")
+	contract.ExpectEqual(t, detail.Rule, rule, "detail rule")
+	if param != "" {
+		contract.ExpectEqual(t, detail.Param, param, "detail param")
+	}
+}
+
+// keys obyekt kalitlari alfavit tartibida, vergul bilan
+func keys(object map[string]interface{}) string {
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
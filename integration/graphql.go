@@ -0,0 +1,312 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"hrplatform/contract"
+	"hrplatform/graphqlapi"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+// BatchCounter GraphQL handleri va ?expand= bog'langan yozuvlarni olish uchun "ByIDs"
+// metodlarini necha marta chaqirganini sanaydi. Partiyalash ishlasa bu son yozuvlar
+// soniga emas, so'rovdagi bog'lanishlar soniga bog'liq (N+1 yo'q).
+type BatchCounter struct {
+	calls atomic.Int64
+}
+
+func (b *BatchCounter) Reset()       { b.calls.Store(0) }
+func (b *BatchCounter) Calls() int64 { return b.calls.Load() }
+
+// countBatches repos dagi "ByIDs" metodlarini b ni oshiradigan qobiqlarga o'raydi
+func countBatches(repos postgres.Repositories, b *BatchCounter) postgres.Repositories {
+	repos.Companies = countedCompanies{repos.Companies, b}
+	repos.Vacancies = countedVacancies{repos.Vacancies, b}
+	repos.Recruiters = countedRecruiters{repos.Recruiters, b}
+	repos.Interviews = countedInterviews{repos.Interviews, b}
+	repos.Users = countedUsers{repos.Users, b}
+	repos.Resumes = countedResumes{repos.Resumes, b}
+	return repos
+}
+
+type countedCompanies struct {
+	postgres.CompanyRepository
+	b *BatchCounter
+}
+
+func (r countedCompanies) GetCompaniesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Company, error) {
+	r.b.calls.Add(1)
+	return r.CompanyRepository.GetCompaniesByIDs(ctx, ids)
+}
+
+type countedVacancies struct {
+	postgres.VacancyRepository
+	b *BatchCounter
+}
+
+func (r countedVacancies) GetVacanciesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Vacancy, error) {
+	r.b.calls.Add(1)
+	return r.VacancyRepository.GetVacanciesByIDs(ctx, ids)
+}
+
+func (r countedVacancies) GetVacanciesByCompanyIDs(ctx context.Context, companyIDs []uuid.UUID) ([]models.Vacancy, error) {
+	r.b.calls.Add(1)
+	return r.VacancyRepository.GetVacanciesByCompanyIDs(ctx, companyIDs)
+}
+
+type countedRecruiters struct {
+	postgres.RecruiterRepository
+	b *BatchCounter
+}
+
+func (r countedRecruiters) GetRecruitersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Recruiter, error) {
+	r.b.calls.Add(1)
+	return r.RecruiterRepository.GetRecruitersByIDs(ctx, ids)
+}
+
+func (r countedRecruiters) GetRecruitersByCompanyIDs(ctx context.Context, companyIDs []uuid.UUID) ([]models.Recruiter, error) {
+	r.b.calls.Add(1)
+	return r.RecruiterRepository.GetRecruitersByCompanyIDs(ctx, companyIDs)
+}
+
+type countedInterviews struct {
+	postgres.InterviewRepository
+	b *BatchCounter
+}
+
+func (r countedInterviews) GetInterviewsByVacancyIDs(ctx context.Context, vacancyIDs []uuid.UUID) ([]models.Interview, error) {
+	r.b.calls.Add(1)
+	return r.InterviewRepository.GetInterviewsByVacancyIDs(ctx, vacancyIDs)
+}
+
+func (r countedInterviews) GetInterviewsByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]models.Interview, error) {
+	r.b.calls.Add(1)
+	return r.InterviewRepository.GetInterviewsByUserIDs(ctx, userIDs)
+}
+
+type countedUsers struct {
+	postgres.UserRepository
+	b *BatchCounter
+}
+
+func (r countedUsers) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.User, error) {
+	r.b.calls.Add(1)
+	return r.UserRepository.GetUsersByIDs(ctx, ids)
+}
+
+type countedResumes struct {
+	postgres.ResumeRepository
+	b *BatchCounter
+}
+
+func (r countedResumes) GetResumesByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]models.Resume, error) {
+	r.b.calls.Add(1)
+	return r.ResumeRepository.GetResumesByUserIDs(ctx, userIDs)
+}
+
+// graphQLResponse - POST /graphql javobi
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+	Extensions struct {
+		Cost graphqlapi.Cost `json:"cost"`
+	} `json:"extensions"`
+}
+
+// graphQL so'rov yuboradi, status want bo'lishini talab qiladi va data ni out ga o'qiydi (out nil bo'lishi mumkin)
+func graphQL(api *Client, want int, out interface{}, query string, variables map[string]interface{}, headers ...Header) graphQLResponse {
+	api.T.Helper()
+	var resp graphQLResponse
+	api.Expect(want, &resp, http.MethodPost, "/graphql", graphqlapi.Request{Query: query, Variables: variables}, headers...)
+	if out != nil {
+		if err := json.Unmarshal(resp.Data, out); err != nil {
+			api.T.Errorf("graphql: decode data %s: %v", resp.Data, err)
+			api.T.FailNow()
+		}
+	}
+	return resp
+}
+
+// expectGraphQLError birinchi xatolikning extensions.code sini tekshiradi
+func expectGraphQLError(t contract.T, resp graphQLResponse, code, what string) {
+	t.Helper()
+	if len(resp.Errors) == 0 {
+		t.Errorf("%s: no errors, want code %q", what, code)
+		return
+	}
+	contract.ExpectEqual(t, resp.Errors[0].Extensions["code"], code, what+" code")
+	if requestID, _ := resp.Errors[0].Extensions["request_id"].(string); requestID == "" {
+		t.Errorf("%s: request_id is missing from extensions", what)
+	}
+}
+
+const companyTreeQuery = `query Tree($id: UUID!) {
+	company(id: $id) {
+		name
+		vacancies(first: 5) {
+			name
+			interviews(first: 5) {
+				user { name resumes(first: 5) { position } }
+				recruiter { name }
+			}
+		}
+		recruiters(first: 5) { name }
+	}
+}`
+
+type companyTree struct {
+	Company struct {
+		Name      string `json:"name"`
+		Vacancies []struct {
+			Name       string `json:"name"`
+			Interviews []struct {
+				User struct {
+					Name    string `json:"name"`
+					Resumes []struct {
+						Position string `json:"position"`
+					} `json:"resumes"`
+				} `json:"user"`
+				Recruiter struct {
+					Name string `json:"name"`
+				} `json:"recruiter"`
+			} `json:"interviews"`
+		} `json:"vacancies"`
+		Recruiters []struct {
+			Name string `json:"name"`
+		} `json:"recruiters"`
+	} `json:"company"`
+}
+
+// GraphQLCases /graphql stsenariylari. batches - Server.Batches.
+func GraphQLCases(batches *BatchCounter) []Case {
+	return []Case{
+		{Name: "graphql/nested company tree is batched", Run: func(t contract.T, api *Client) {
+			company := createCompany(api)
+			recruiters := []models.Recruiter{createRecruiter(api, company.ID), createRecruiter(api, company.ID)}
+
+			// bitta vakansiyali va uch vakansiyali daraxt bir xil sonli partiyalar bilan olinadi
+			var batchCalls []int64
+			for i := 0; i < 3; i++ {
+				vacancy := createVacancy(api, company.ID, "GraphQL Developer", 1)
+				for _, recruiter := range recruiters {
+					user := createUser(api, "1990-01-01", "m")
+					createResume(api, user.ID, "GraphQL Developer", 3)
+					createInterview(api, user.ID, vacancy.ID, recruiter.ID)
+				}
+				if i == 1 {
+					continue
+				}
+
+				batches.Reset()
+				var tree companyTree
+				resp := graphQL(api, http.StatusOK, &tree, companyTreeQuery, map[string]interface{}{"id": company.ID})
+				batchCalls = append(batchCalls, batches.Calls())
+				if len(resp.Errors) > 0 {
+					t.Errorf("unexpected errors: %+v", resp.Errors)
+				}
+				contract.ExpectEqual(t, tree.Company.Name, company.Name, "company name")
+				contract.ExpectEqual(t, len(tree.Company.Vacancies), i+1, "vacancies")
+				contract.ExpectEqual(t, len(tree.Company.Recruiters), 2, "recruiters")
+				for _, vacancy := range tree.Company.Vacancies {
+					contract.ExpectEqual(t, len(vacancy.Interviews), 2, "interviews of "+vacancy.Name)
+					for _, interview := range vacancy.Interviews {
+						contract.ExpectEqual(t, len(interview.User.Resumes), 1, "resumes of "+interview.User.Name)
+						if interview.Recruiter.Name == "" {
+							t.Errorf("interview of %s has no recruiter", interview.User.Name)
+						}
+					}
+				}
+			}
+			// vacanciesByCompany, recruitersByCompany, interviewsByVacancy, users, recruiters, resumesByUser
+			contract.ExpectEqual(t, batchCalls[0], int64(6), "batched lookups for 1 vacancy")
+			contract.ExpectEqual(t, batchCalls[1], int64(6), "batched lookups for 3 vacancies")
+		}},
+		{Name: "graphql/filters, first and missing records", Run: func(t contract.T, api *Client) {
+			h := newHiring(api, contract.UniqueName("GraphQL Position"))
+			createVacancy(api, h.Company.ID, h.Vacancy.Position, 5)
+			createInterview(api, h.User.ID, h.Vacancy.ID, h.Recruiter.ID)
+
+			var data struct {
+				Vacancies []struct {
+					ID      string `json:"id"`
+					MinExp  int    `json:"min_exp"`
+					Version int64  `json:"version"`
+					Company struct {
+						ID string `json:"id"`
+					} `json:"company"`
+				} `json:"vacancies"`
+				User struct {
+					Interviews []struct {
+						Vacancy struct {
+							Position string `json:"position"`
+						} `json:"vacancy"`
+					} `json:"interviews"`
+				} `json:"user"`
+				Missing *struct {
+					ID string `json:"id"`
+				} `json:"missing"`
+			}
+			query := fmt.Sprintf(`{
+				vacancies(position: %q, company_id: %q, first: 1) { id min_exp version company { id } }
+				user(id: %q) { interviews { vacancy { position } } }
+				missing: vacancy(id: %q) { id }
+			}`, h.Vacancy.Position, h.Company.ID, h.User.ID, uuid.New())
+			resp := graphQL(api, http.StatusOK, &data, query, nil)
+			contract.ExpectEqual(t, len(resp.Errors), 0, "errors")
+			contract.ExpectEqual(t, len(data.Vacancies), 1, "vacancies with first: 1")
+			contract.ExpectEqual(t, data.Vacancies[0].ID, h.Vacancy.ID.String(), "first vacancy")
+			contract.ExpectEqual(t, data.Vacancies[0].Version, h.Vacancy.Version, "version")
+			contract.ExpectEqual(t, data.Vacancies[0].Company.ID, h.Company.ID.String(), "vacancy.company")
+			contract.ExpectEqual(t, len(data.User.Interviews), 1, "user.interviews")
+			contract.ExpectEqual(t, data.User.Interviews[0].Vacancy.Position, h.Vacancy.Position, "interview.vacancy")
+			if data.Missing != nil {
+				t.Errorf("unknown vacancy: got %+v, want null", data.Missing)
+			}
+			// narx: vacancies 1*(1+1) + user 1+20*(1+1) (first berilmagan) + missing 1
+			contract.ExpectEqual(t, resp.Extensions.Cost.Cost, 44, "extensions.cost.cost")
+			contract.ExpectEqual(t, resp.Extensions.Cost.Depth, 3, "extensions.cost.depth")
+
+			resp = graphQL(api, http.StatusOK, nil, `{ companies(first: 500) { id } }`, nil)
+			expectGraphQLError(t, resp, "validation_failed", "first above the maximum")
+			contract.ExpectEqual(t, string(resp.Data), "null", "data for a failed non-null root field")
+		}},
+		{Name: "graphql/cost and depth limits", Run: func(t contract.T, api *Client) {
+			expensive := `{ companies(first: 50) { vacancies(first: 50) { interviews(first: 50) { id } } } }`
+			resp := graphQL(api, http.StatusBadRequest, nil, expensive, nil, Header{Name: "Accept-Language", Value: "ru"})
+			expectGraphQLError(t, resp, "query_too_expensive", "expensive query")
+			if !strings.Contains(resp.Errors[0].Message, "Стоимость запроса") {
+				t.Errorf("expensive query message %q is not localized", resp.Errors[0].Message)
+			}
+			if resp.Extensions.Cost.Cost <= resp.Extensions.Cost.MaxCost {
+				t.Errorf("cost %d is within the limit %d", resp.Extensions.Cost.Cost, resp.Extensions.Cost.MaxCost)
+			}
+
+			// o'zgaruvchi orqali berilgan first ham hisobga olinadi
+			variables := map[string]interface{}{"n": 100}
+			resp = graphQL(api, http.StatusBadRequest, nil, `query($n: Int) { users(first: $n) { interviews(first: $n) { id } } }`, variables)
+			expectGraphQLError(t, resp, "query_too_expensive", "expensive query with variables")
+
+			deep := `{ vacancies(first: 1) { company { vacancies(first: 1) { company { vacancies(first: 1) { company { vacancies(first: 1) { id } } } } } } } }`
+			resp = graphQL(api, http.StatusBadRequest, nil, deep, nil)
+			expectGraphQLError(t, resp, "query_too_deep", "deep query")
+
+			resp = graphQL(api, http.StatusBadRequest, nil, `{ companies { salary } }`, nil)
+			expectGraphQLError(t, resp, "graphql_validation_failed", "unknown field")
+			resp = graphQL(api, http.StatusBadRequest, nil, `{ vacancy(id: "not-a-uuid") { id } }`, nil)
+			expectGraphQLError(t, resp, "graphql_validation_failed", "invalid UUID literal")
+
+			api.ExpectError(http.StatusBadRequest, "validation_failed", http.MethodPost, "/graphql", map[string]string{})
+		}},
+	}
+}
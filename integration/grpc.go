@@ -0,0 +1,210 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"hrplatform/contract"
+	"hrplatform/grpcapi/hrpb"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCCases grpcapi stsenariylari. REST bilan bir xil repositorylar ishlatilgani
+// yozuvlarni bir API da yaratib, ikkinchisida o'qish orqali tekshiriladi.
+func GRPCCases(addr string) []Case {
+	return []Case{
+		{Name: "grpc/crud with versions and streaming", Run: func(t contract.T, api *Client) {
+			conn := dialGRPC(t, addr)
+			defer conn.Close()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			companies := hrpb.NewCompanyServiceClient(conn)
+			vacancies := hrpb.NewVacancyServiceClient(conn)
+
+			company, err := companies.CreateCompany(ctx, &hrpb.CreateCompanyRequest{Name: contract.UniqueName("gRPC Company"), Location: "Samarkand", Workers: 3})
+			expectNoError(t, err, "CreateCompany")
+			var created []*hrpb.Vacancy
+			for _, minExp := range []int32{1, 3} {
+				vacancy, err := vacancies.CreateVacancy(ctx, &hrpb.CreateVacancyRequest{Name: "gRPC", Position: "Go Developer", MinExp: minExp, CompanyId: company.Id, Description: "grpc"})
+				expectNoError(t, err, "CreateVacancy")
+				created = append(created, vacancy)
+			}
+
+			// gRPC orqali yaratilgan yozuv REST da ham ko'rinadi
+			var rest models.Vacancy
+			resp := api.Expect(http.StatusOK, &rest, http.MethodGet, "/vacancies/"+created[0].Id, nil)
+			contract.ExpectEqual(t, rest.Position, "Go Developer", "position over REST")
+			contract.ExpectEqual(t, resp.ETag(), `"1"`, "ETag over REST")
+
+			stream, err := vacancies.ListVacancies(ctx, &hrpb.ListVacanciesRequest{CompanyId: company.Id, MinExp: 2})
+			expectNoError(t, err, "ListVacancies")
+			var streamed []string
+			for {
+				vacancy, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				expectNoError(t, err, "ListVacancies.Recv")
+				streamed = append(streamed, vacancy.Id)
+			}
+			contract.ExpectEqual(t, len(streamed), 1, "vacancies with min_exp >= 2")
+
+			update := &hrpb.UpdateVacancyRequest{Id: created[0].Id, Name: "gRPC", Position: "Go Developer", MinExp: 2, CompanyId: company.Id, Description: "grpc"}
+			_, err = vacancies.UpdateVacancy(ctx, update)
+			expectGRPCError(t, err, codes.FailedPrecondition, "version_required", "UpdateVacancy without a version")
+			update.Version = created[0].Version + 1
+			_, err = vacancies.UpdateVacancy(ctx, update)
+			expectGRPCError(t, err, codes.Aborted, "version_mismatch", "UpdateVacancy with a stale version")
+			update.Version = created[0].Version
+			updated, err := vacancies.UpdateVacancy(ctx, update)
+			expectNoError(t, err, "UpdateVacancy")
+			contract.ExpectEqual(t, updated.MinExp, int32(2), "min_exp after UpdateVacancy")
+			contract.ExpectEqual(t, updated.Version, created[0].Version+1, "version after UpdateVacancy")
+
+			_, err = vacancies.DeleteVacancy(ctx, &hrpb.DeleteVacancyRequest{Id: updated.Id, Version: updated.Version})
+			expectNoError(t, err, "DeleteVacancy")
+			_, err = vacancies.GetVacancy(ctx, &hrpb.GetVacancyRequest{Id: updated.Id})
+			expectGRPCError(t, err, codes.NotFound, "vacancy_not_found", "GetVacancy after delete")
+			api.Expect(http.StatusNotFound, nil, http.MethodGet, "/vacancies/"+updated.Id, nil)
+		}},
+		{Name: "grpc/validation, locale and audit metadata", Run: func(t contract.T, api *Client) {
+			conn := dialGRPC(t, addr)
+			defer conn.Close()
+			users := hrpb.NewUserServiceClient(conn)
+			actor := uuid.NewString()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			ctx = metadata.AppendToOutgoingContext(ctx, "accept-language", "ru", "x-actor-id", actor, "x-request-id", "grpc-req-1")
+
+			var header metadata.MD
+			_, err := users.CreateUser(ctx, &hrpb.CreateUserRequest{Name: "X", Email: "not-an-email", PhoneNumber: contract.UniquePhone(), Birthday: "1990-01-01", Gender: "m"}, grpc.Header(&header))
+			st := expectGRPCError(t, err, codes.InvalidArgument, "validation_failed", "CreateUser with invalid fields")
+			contract.ExpectEqual(t, st.Message(), "Данные запроса не прошли проверку", "localized message")
+			contract.ExpectEqual(t, fieldViolations(st), "name,email", "field violations")
+			contract.ExpectEqual(t, first(header.Get("x-request-id")), "grpc-req-1", "x-request-id header")
+
+			_, err = users.GetUser(ctx, &hrpb.GetUserRequest{Id: "42"})
+			expectGRPCError(t, err, codes.InvalidArgument, "invalid_id", "GetUser with a malformed id")
+
+			user, err := users.CreateUser(ctx, &hrpb.CreateUserRequest{Name: contract.UniqueName("gRPC User"), Email: contract.UniqueEmail("grpc"), PhoneNumber: contract.UniquePhone(), Birthday: "1990-01-01", Gender: "f"})
+			expectNoError(t, err, "CreateUser")
+			contract.ExpectEqual(t, user.Birthday, "1990-01-01", "birthday")
+			_, err = users.CreateUser(ctx, &hrpb.CreateUserRequest{Name: "Copy", Email: user.Email, PhoneNumber: contract.UniquePhone(), Birthday: "1990-01-01", Gender: "f"})
+			expectGRPCError(t, err, codes.AlreadyExists, "user_email_taken", "CreateUser with a taken email")
+
+			var history []models.AuditEntry
+			api.Expect(http.StatusOK, &history, http.MethodGet, "/users/"+user.Id+"/history", nil)
+			if len(history) != 1 || history[0].Actor != actor || history[0].RequestID != "grpc-req-1" || history[0].Action != "create" {
+				t.Errorf("user history: got %+v, want one create entry by %s", history, actor)
+			}
+		}},
+		{Name: "grpc/interview scheduling follows eligibility rules", Run: func(t contract.T, api *Client) {
+			conn := dialGRPC(t, addr)
+			defer conn.Close()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			company, err := hrpb.NewCompanyServiceClient(conn).CreateCompany(ctx, &hrpb.CreateCompanyRequest{Name: contract.UniqueName("gRPC Hiring")})
+			expectNoError(t, err, "CreateCompany")
+			recruiter, err := hrpb.NewRecruiterServiceClient(conn).CreateRecruiter(ctx, &hrpb.CreateRecruiterRequest{Name: contract.UniqueName("Recruiter"), Email: contract.UniqueEmail("grpc-recruiter"), PhoneNumber: contract.UniquePhone(), Birthday: "1985-02-03", Gender: "m", CompanyId: company.Id})
+			expectNoError(t, err, "CreateRecruiter")
+			contract.ExpectEqual(t, recruiter.Birthday, "1985-02-03", "recruiter birthday")
+			position := contract.UniqueName("Analyst")
+			vacancy, err := hrpb.NewVacancyServiceClient(conn).CreateVacancy(ctx, &hrpb.CreateVacancyRequest{Name: "Analyst", Position: position, MinExp: 2, CompanyId: company.Id, Description: "grpc"})
+			expectNoError(t, err, "CreateVacancy")
+
+			users := hrpb.NewUserServiceClient(conn)
+			resumes := hrpb.NewResumeServiceClient(conn)
+			interviews := hrpb.NewInterviewServiceClient(conn)
+			designer, err := users.CreateUser(ctx, &hrpb.CreateUserRequest{Name: contract.UniqueName("Designer"), Email: contract.UniqueEmail("designer"), PhoneNumber: contract.UniquePhone(), Birthday: "1995-01-01", Gender: "m"})
+			expectNoError(t, err, "CreateUser")
+			_, err = resumes.CreateResume(ctx, &hrpb.CreateResumeRequest{Position: "Designer", Experience: 4, UserId: designer.Id})
+			expectNoError(t, err, "CreateResume")
+
+			request := &hrpb.CreateInterviewRequest{UserId: designer.Id, VacancyId: vacancy.Id, RecruiterId: recruiter.Id, InterviewDate: "2030-03-04 11:00:00"}
+			_, err = interviews.CreateInterview(ctx, request)
+			expectGRPCError(t, err, codes.InvalidArgument, "candidate_not_eligible", "CreateInterview for a candidate with another position")
+
+			senior, err := users.CreateUser(ctx, &hrpb.CreateUserRequest{Name: contract.UniqueName("Senior"), Email: contract.UniqueEmail("senior"), PhoneNumber: contract.UniquePhone(), Birthday: "1990-01-01", Gender: "f"})
+			expectNoError(t, err, "CreateUser")
+			_, err = resumes.CreateResume(ctx, &hrpb.CreateResumeRequest{Position: position, Experience: 5, Languages: []string{"uz", "en"}, UserId: senior.Id})
+			expectNoError(t, err, "CreateResume")
+			request.UserId = senior.Id
+			interview, err := interviews.CreateInterview(ctx, request)
+			expectNoError(t, err, "CreateInterview")
+			contract.ExpectEqual(t, interview.InterviewDate.AsTime().Format("2006-01-02 15:04:05"), "2030-03-04 11:00:00", "interview date")
+
+			stream, err := interviews.ListInterviews(ctx, &hrpb.ListInterviewsRequest{UserId: senior.Id})
+			expectNoError(t, err, "ListInterviews")
+			received, err := stream.Recv()
+			expectNoError(t, err, "ListInterviews.Recv")
+			contract.ExpectEqual(t, received.Id, interview.Id, "streamed interview")
+			if _, err := stream.Recv(); !errors.Is(err, io.EOF) {
+				t.Errorf("ListInterviews: expected the stream to end after one interview, got %v", err)
+			}
+
+			// Kompaniya o'chirilganda uning vakansiyasi va intervyusi ham o'chadi
+			_, err = hrpb.NewCompanyServiceClient(conn).DeleteCompany(ctx, &hrpb.DeleteCompanyRequest{Id: company.Id, Version: company.Version})
+			expectNoError(t, err, "DeleteCompany")
+			_, err = interviews.GetInterview(ctx, &hrpb.GetInterviewRequest{Id: interview.Id})
+			expectGRPCError(t, err, codes.NotFound, "interview_not_found", "GetInterview after the company is deleted")
+		}},
+	}
+}
+
+func dialGRPC(t contract.T, addr string) *grpc.ClientConn {
+	t.Helper()
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	expectNoError(t, err, "dial gRPC")
+	return conn
+}
+
+// expectGRPCError gRPC kodi va ErrorInfo.Reason ni tekshiradi
+func expectGRPCError(t contract.T, err error, code codes.Code, reason, what string) *status.Status {
+	t.Helper()
+	st := status.Convert(err)
+	got := ""
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			got = info.Reason
+		}
+	}
+	if st.Code() != code || got != reason {
+		t.Errorf("%s: got %s %q (%s), want %s %q", what, st.Code(), got, st.Message(), code, reason)
+	}
+	return st
+}
+
+// fieldViolations BadRequest dagi maydonlar, vergul bilan
+func fieldViolations(st *status.Status) string {
+	fields := ""
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				if fields != "" {
+					fields += ","
+				}
+				fields += violation.Field
+			}
+		}
+	}
+	return fields
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,244 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"hrplatform/client"
+	"hrplatform/contract"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+	"github.com/xuri/excelize/v2"
+)
+
+var importCases = []Case{
+	{Name: "imports/users from CSV with per-row errors and batches", Run: func(t contract.T, api *Client) {
+		ctx := context.Background()
+		sdk := client.New(api.BaseURL, client.WithHTTPClient(api.HTTP), client.WithLocale("ru"))
+		taken := createUser(api, "1990-01-01", "m")
+
+		emails := []string{contract.UniqueEmail("import"), contract.UniqueEmail("import"), contract.UniqueEmail("import")}
+		// Excel ru lokalida saqlagandek: BOM, ";" ajratuvchi, sana "DD.MM.YYYY"
+		csv := "\ufeffFull name;E-mail;Phone;Birthday;Gender\n" +
+			"Aziza Karimova;" + emails[0] + ";" + contract.UniquePhone() + ";1991-01-15;f\n" +
+			"Broken Email;not-an-email;" + contract.UniquePhone() + ";1991-01-15;f\n" +
+			"Bobur Aliyev;" + emails[1] + ";" + contract.UniquePhone() + ";02.03.1989;m\n" +
+			"Taken Email;" + taken.Email + ";" + contract.UniquePhone() + ";1991-01-15;m\n" +
+			";;;;\n" +
+			"Dilnoza Rahimova;" + emails[2] + ";" + contract.UniquePhone() + ";1993-07-20;\n"
+
+		report, err := sdk.ImportUsers(ctx, strings.NewReader(csv), client.ImportOptions{
+			Filename: "candidates.csv",
+			Mapping: &client.ImportMapping{
+				Columns:  map[string]string{"name": "full name", "email": "E-mail", "phone_number": "Phone"},
+				Defaults: map[string]string{"gender": "f"},
+			},
+			BatchSize: 2,
+		})
+		expectNoError(t, err, "ImportUsers")
+		contract.ExpectEqual(t, report.Entity, "user", "entity")
+		contract.ExpectEqual(t, report.Total, 5, "non-blank rows")
+		contract.ExpectEqual(t, report.Created, 3, "created")
+		contract.ExpectEqual(t, report.Failed, 2, "failed")
+		// [2, 4] va [5, 7]; 5-qator band email sababli chiqarilib 7-qator qayta yoziladi
+		contract.ExpectEqual(t, report.Batches, 2, "batches")
+		contract.ExpectEqual(t, report.RolledBack, false, "rolled back")
+		contract.ExpectEqual(t, importedRows(report), "2,4,7", "imported rows")
+
+		if len(report.Errors) != 2 {
+			t.Errorf("errors: got %+v, want rows 3 and 5", report.Errors)
+			return
+		}
+		contract.ExpectEqual(t, report.Errors[0].Row, 3, "invalid row")
+		contract.ExpectEqual(t, report.Errors[0].Code, "validation_failed", "invalid row code")
+		if len(report.Errors[0].Details) != 1 || report.Errors[0].Details[0].Field != "email" || report.Errors[0].Details[0].Message != "Некорректный адрес электронной почты" {
+			t.Errorf("row 3 details: got %+v, want a localized email error", report.Errors[0].Details)
+		}
+		contract.ExpectEqual(t, report.Errors[1].Row, 5, "conflicting row")
+		contract.ExpectEqual(t, report.Errors[1].Code, "user_email_taken", "conflicting row code")
+
+		for n, record := range report.Records {
+			var user models.User
+			api.Expect(http.StatusOK, &user, http.MethodGet, "/users/"+record.ID.String(), nil)
+			contract.ExpectEqual(t, user.Email, emails[n], "imported email")
+			var history []models.AuditEntry
+			api.Expect(http.StatusOK, &history, http.MethodGet, "/users/"+record.ID.String()+"/history", nil)
+			contract.ExpectEqual(t, len(history), 1, "audit entries of an imported user")
+		}
+		var bobur models.User
+		api.Expect(http.StatusOK, &bobur, http.MethodGet, "/users/"+report.Records[1].ID.String(), nil)
+		contract.ExpectEqual(t, bobur.Birthday.Format("2006-01-02"), "1989-03-02", "DD.MM.YYYY birthday")
+		var dilnoza models.User
+		api.Expect(http.StatusOK, &dilnoza, http.MethodGet, "/users/"+report.Records[2].ID.String(), nil)
+		contract.ExpectEqual(t, dilnoza.Gender, "f", "gender from defaults")
+	}},
+	{Name: "imports/vacancies from XLSX, all or nothing", Run: func(t contract.T, api *Client) {
+		ctx := context.Background()
+		sdk := client.New(api.BaseURL, client.WithHTTPClient(api.HTTP))
+		company := createCompany(api)
+		position := contract.UniqueName("Imported Position")
+		options := client.ImportOptions{
+			Filename: "vacancies.xlsx",
+			Mapping: &client.ImportMapping{
+				Columns:  map[string]string{"name": "Title", "min_exp": "Experience"},
+				Defaults: map[string]string{"company_id": company.ID.String()},
+			},
+			AllOrNothing: true,
+		}
+		header := []interface{}{"Title", "Position", "Experience", "Description", "company_id"}
+		vacancies := func() []models.Vacancy {
+			var list []models.Vacancy
+			api.Expect(http.StatusOK, &list, http.MethodGet, "/vacancies/?company_id="+company.ID.String(), nil)
+			return list
+		}
+
+		// bitta noto'g'ri qator: hech narsa yozilmaydi
+		file := xlsx(t, header,
+			[]interface{}{"Go developer", position, 3, "Backend services"},
+			[]interface{}{"Negative", position, -1, "Broken row"},
+		)
+		report, err := sdk.ImportVacancies(ctx, bytes.NewReader(file), options)
+		expectNoError(t, err, "ImportVacancies with an invalid row")
+		contract.ExpectEqual(t, report.RolledBack, true, "rolled back")
+		contract.ExpectEqual(t, report.Created, 0, "created")
+		if len(report.Errors) != 1 || report.Errors[0].Row != 3 || len(report.Errors[0].Details) != 1 || report.Errors[0].Details[0].Rule != "min" {
+			t.Errorf("errors: got %+v, want row 3 min_exp min", report.Errors)
+		}
+
+		// tranzaksiya ichidagi xato (topilmagan kompaniya) oldingi qatorlarni ham bekor qiladi
+		file = xlsx(t, header,
+			[]interface{}{"Go developer", position, 3, "Backend services"},
+			[]interface{}{"Elsewhere", position, 1, "Unknown company", uuid.NewString()},
+		)
+		report, err = sdk.ImportVacancies(ctx, bytes.NewReader(file), options)
+		expectNoError(t, err, "ImportVacancies with an unknown company")
+		contract.ExpectEqual(t, report.RolledBack, true, "rolled back")
+		if len(report.Errors) != 1 || report.Errors[0].Row != 3 || report.Errors[0].Code != "company_not_found" {
+			t.Errorf("errors: got %+v, want row 3 company_not_found", report.Errors)
+		}
+		contract.ExpectEqual(t, len(vacancies()), 0, "vacancies after rolled back imports")
+
+		file = xlsx(t, header,
+			[]interface{}{"Go developer", position, 3, "Backend services"},
+			[]interface{}{"Intern", position, nil, "No experience needed"},
+			[]interface{}{"Lead", position, 7.0, "Team lead"},
+		)
+		report, err = sdk.ImportVacancies(ctx, bytes.NewReader(file), options)
+		expectNoError(t, err, "ImportVacancies")
+		contract.ExpectEqual(t, report.RolledBack, false, "rolled back")
+		contract.ExpectEqual(t, report.Created, 3, "created")
+		contract.ExpectEqual(t, report.Batches, 1, "batches")
+		contract.ExpectEqual(t, len(report.Errors), 0, "errors")
+
+		minExp := map[string]int{}
+		for _, vacancy := range vacancies() {
+			minExp[vacancy.Name] = vacancy.MinExp
+			contract.ExpectEqual(t, vacancy.Position, position, "position")
+		}
+		contract.ExpectEqual(t, fmt.Sprint(minExp), "map[Go developer:3 Intern:0 Lead:7]", "min_exp by name")
+	}},
+	{Name: "imports/resumes resolve users by email", Run: func(t contract.T, api *Client) {
+		ctx := context.Background()
+		sdk := client.New(api.BaseURL, client.WithHTTPClient(api.HTTP), client.WithLocale("en"))
+		user := createUser(api, "1990-01-01", "f")
+		other := createUser(api, "1992-02-02", "m")
+		position := contract.UniqueName("Imported Resume")
+
+		csv := "position,experience,languages,user_email,user_id\n" +
+			position + ",4,\"uz, ru; en\"," + strings.ToUpper(user.Email) + ",\n" +
+			position + ",2,,," + other.ID.String() + "\n" +
+			position + ",1,,nobody-" + uuid.NewString() + "@contract.test,\n" +
+			position + ",three,,," + uuid.NewString() + "\n"
+		report, err := sdk.ImportResumes(ctx, strings.NewReader(csv), client.ImportOptions{Filename: "resumes.csv"})
+		expectNoError(t, err, "ImportResumes")
+		contract.ExpectEqual(t, report.Created, 2, "created")
+		contract.ExpectEqual(t, importedRows(report), "2,3", "imported rows")
+		if len(report.Errors) != 2 {
+			t.Errorf("errors: got %+v, want rows 4 and 5", report.Errors)
+			return
+		}
+		contract.ExpectEqual(t, fmt.Sprint(report.Errors[0].Details), "[{user_email No active user matches this value user_exists }]", "unknown email")
+		fields := map[string]string{}
+		for _, detail := range report.Errors[1].Details {
+			fields[detail.Field] = detail.Rule
+		}
+		contract.ExpectEqual(t, fmt.Sprint(fields), "map[experience:integer user_id:user_exists]", "row 5 details")
+
+		var resume models.Resume
+		api.Expect(http.StatusOK, &resume, http.MethodGet, "/resumes/"+report.Records[0].ID.String(), nil)
+		contract.ExpectEqual(t, resume.UserID, user.ID, "user resolved by email")
+		contract.ExpectEqual(t, strings.Join(resume.Languages, ","), "uz,ru,en", "languages")
+		contract.ExpectEqual(t, resume.Experience, 4, "experience")
+	}},
+	{Name: "imports/invalid files and mappings", Run: func(t contract.T, api *Client) {
+		ctx := context.Background()
+		sdk := client.New(api.BaseURL, client.WithHTTPClient(api.HTTP), client.WithRetries(1, 0))
+		csv := "name,email\nAziza,aziza@example.com\n"
+
+		errResp := api.ExpectError(http.StatusBadRequest, "validation_failed", http.MethodPost, "/imports/users", map[string]string{})
+		expectDetail(t, errResp, "file", "required", "")
+
+		_, err := sdk.ImportUsers(ctx, strings.NewReader(csv), client.ImportOptions{Mapping: &client.ImportMapping{Columns: map[string]string{"salary": "Salary"}}})
+		expectImportError(t, err, "invalid_import_mapping", "columns.salary", "oneof")
+
+		_, err = sdk.ImportUsers(ctx, strings.NewReader(csv), client.ImportOptions{Mapping: &client.ImportMapping{Columns: map[string]string{"phone_number": "Phone"}}})
+		expectImportError(t, err, "invalid_import_mapping", "columns.phone_number", "import_column")
+
+		_, err = sdk.ImportVacancies(ctx, strings.NewReader("not a zip archive"), client.ImportOptions{Filename: "vacancies.xlsx"})
+		expectImportError(t, err, "invalid_import_file", "", "")
+
+		_, err = sdk.ImportUsers(ctx, strings.NewReader(csv), client.ImportOptions{BatchSize: 5000})
+		expectImportError(t, err, "validation_failed", "batch_size", "max")
+	}},
+}
+
+// xlsx birinchi varag'ida sarlavha va qatorlari bo'lgan XLSX fayl yasaydi
+func xlsx(t contract.T, header []interface{}, rows ...[]interface{}) []byte {
+	t.Helper()
+	file := excelize.NewFile()
+	defer file.Close()
+	sheet := file.GetSheetName(0)
+	for n, row := range append([][]interface{}{header}, rows...) {
+		cell, _ := excelize.CoordinatesToCellName(1, n+1)
+		if err := file.SetSheetRow(sheet, cell, &row); err != nil {
+			t.Errorf("xlsx: %v", err)
+			t.FailNow()
+		}
+	}
+	buf, err := file.WriteToBuffer()
+	if err != nil {
+		t.Errorf("xlsx: %v", err)
+		t.FailNow()
+	}
+	return buf.Bytes()
+}
+
+// importedRows yaratilgan yozuvlar olingan qatorlar, vergul bilan
+func importedRows(report models.ImportReport) string {
+	rows := make([]string, len(report.Records))
+	for n, record := range report.Records {
+		rows[n] = fmt.Sprint(record.Row)
+	}
+	return strings.Join(rows, ",")
+}
+
+// expectImportError SDK xatoligining kodini va (field bo'sh bo'lmasa) yagona tafsilotini tekshiradi
+func expectImportError(t contract.T, err error, code, field, rule string) {
+	t.Helper()
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != code {
+		t.Errorf("got %v, want 400 %s", err, code)
+		return
+	}
+	if field == "" {
+		return
+	}
+	if len(apiErr.Details) != 1 || apiErr.Details[0].Field != field || apiErr.Details[0].Rule != rule {
+		t.Errorf("%s details: got %+v, want %s %s", code, apiErr.Details, field, rule)
+	}
+}
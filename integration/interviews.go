@@ -0,0 +1,92 @@
+package integration
+
+import (
+	"net/http"
+	"net/url"
+
+	"hrplatform/contract"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+var interviewCases = []Case{
+	{Name: "interviews/crud with etag", Run: func(t contract.T, api *Client) {
+		h := newHiring(api, contract.UniqueName("Frontend"))
+		interview := createInterview(api, h.User.ID, h.Vacancy.ID, h.Recruiter.ID)
+		path := "/interviews/" + interview.ID.String()
+
+		var got models.Interview
+		resp := api.Expect(http.StatusOK, &got, http.MethodGet, path, nil)
+		contract.ExpectEqual(t, got.InterviewDate.Format("2006-01-02 15:04:05"), "2030-01-02 10:00:00", "interview_date")
+
+		update := got.ToUpdate()
+		update.InterviewDate = "2030-02-03 11:30:00"
+		api.ExpectError(http.StatusPreconditionRequired, "if_match_required", http.MethodPut, path, update)
+		api.Expect(http.StatusOK, nil, http.MethodPut, path, update, IfMatch(resp.ETag()))
+
+		var patched models.Interview
+		resp = api.Expect(http.StatusOK, &patched, http.MethodPatch, path, `{"interview_date": "2030-03-04 09:00:00"}`, IfMatch(`"2"`))
+		contract.ExpectEqual(t, patched.InterviewDate.Format("2006-01-02 15:04:05"), "2030-03-04 09:00:00", "interview_date after patch")
+		api.ExpectError(http.StatusBadRequest, "validation_failed", http.MethodPatch, path, `{"interview_date": "tomorrow"}`, IfMatch(resp.ETag()))
+
+		api.Expect(http.StatusOK, nil, http.MethodDelete, path, nil, IfMatch(resp.ETag()))
+		api.ExpectError(http.StatusNotFound, "interview_not_found", http.MethodGet, path, nil)
+
+		actions := historyActions(api, path)
+		contract.ExpectEqual(t, len(actions), 4, "history entries")
+	}},
+	{Name: "interviews/candidate must be eligible", Run: func(t contract.T, api *Client) {
+		h := newHiring(api, contract.UniqueName("Support"))
+		create := func(userID uuid.UUID) models.CreateInterview {
+			return models.CreateInterview{UserID: userID, VacancyID: h.Vacancy.ID, RecruiterID: h.Recruiter.ID, InterviewDate: "2030-01-02 10:00:00"}
+		}
+
+		noResume := createUser(api, "1990-01-01", "f")
+		resp := api.ExpectError(http.StatusBadRequest, "candidate_not_eligible", http.MethodPost, "/interviews/", create(noResume.ID))
+		expectReasons(t, resp.Details, "resume_required")
+
+		minor := createUser(api, adultBirthday(16), "f")
+		createResume(api, minor.ID, h.Vacancy.Position, 3)
+		resp = api.ExpectError(http.StatusBadRequest, "candidate_not_eligible", http.MethodPost, "/interviews/", create(minor.ID))
+		expectReasons(t, resp.Details, "min_age")
+
+		mismatch := createUser(api, "1990-01-01", "m")
+		createResume(api, mismatch.ID, "Accountant", 3)
+		resp = api.ExpectError(http.StatusBadRequest, "candidate_not_eligible", http.MethodPost, "/interviews/", create(mismatch.ID))
+		expectReasons(t, resp.Details, "position_match")
+
+		api.ExpectError(http.StatusBadRequest, "validation_failed", http.MethodPost, "/interviews/", models.CreateInterview{
+			UserID: h.User.ID, VacancyID: h.Vacancy.ID, RecruiterID: h.Recruiter.ID, InterviewDate: "2030-01-02",
+		})
+	}},
+	{Name: "interviews/filter by company, position and experience", Run: func(t contract.T, api *Client) {
+		h := newHiring(api, contract.UniqueName("QA Engineer"))
+		interview := createInterview(api, h.User.ID, h.Vacancy.ID, h.Recruiter.ID)
+		other := newHiring(api, contract.UniqueName("QA Engineer"))
+		otherInterview := createInterview(api, other.User.ID, other.Vacancy.ID, other.Recruiter.ID)
+
+		var interviews []models.Interview
+		api.Expect(http.StatusOK, &interviews, http.MethodGet, "/interviews/?company_id="+h.Company.ID.String(), nil)
+		contract.ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{interview.ID}, []uuid.UUID{otherInterview.ID}, "company_id")
+
+		api.Expect(http.StatusOK, &interviews, http.MethodGet, "/interviews/?position="+url.QueryEscape(other.Vacancy.Position), nil)
+		contract.ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{otherInterview.ID}, []uuid.UUID{interview.ID}, "position")
+
+		api.Expect(http.StatusOK, &interviews, http.MethodGet, "/interviews/?experience=3&company_id="+h.Company.ID.String(), nil)
+		contract.ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{interview.ID}, nil, "experience=3")
+		api.Expect(http.StatusOK, &interviews, http.MethodGet, "/interviews/?experience=10&company_id="+h.Company.ID.String(), nil)
+		contract.ExpectMembers(t, interviewIDs(interviews), nil, []uuid.UUID{interview.ID}, "experience=10")
+
+		api.ExpectError(http.StatusBadRequest, "invalid_query", http.MethodGet, "/interviews/?experience=senior", nil)
+		api.ExpectError(http.StatusBadRequest, "invalid_id", http.MethodGet, "/interviews/?company_id=acme", nil)
+	}},
+}
+
+func interviewIDs(interviews []models.Interview) []uuid.UUID {
+	ids := make([]uuid.UUID, len(interviews))
+	for i, interview := range interviews {
+		ids[i] = interview.ID
+	}
+	return ids
+}
@@ -0,0 +1,32 @@
+package integration
+
+import (
+	"net/http"
+	"strings"
+
+	"hrplatform/contract"
+	"hrplatform/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DocsCases /openapi.json va /docs stsenariylari. Ular routerning marshrutlar jadvaliga
+// muhtoj, shuning uchun Cases dan alohida: cmd/integration Server.Routes ni uzatadi.
+func DocsCases(routes gin.RoutesInfo) []Case {
+	return []Case{
+		{Name: "openapi/document matches the router", Run: func(t contract.T, api *Client) {
+			var doc openapi.Document
+			api.Expect(http.StatusOK, &doc, http.MethodGet, "/openapi.json", nil)
+			contract.ExpectEqual(t, doc.OpenAPI, "3.0.3", "openapi version")
+			for _, problem := range openapi.Diff(routes, &doc) {
+				t.Errorf("%s", problem)
+			}
+		}},
+		{Name: "openapi/swagger ui", Run: func(t contract.T, api *Client) {
+			resp := api.Expect(http.StatusOK, nil, http.MethodGet, "/docs", nil)
+			if !strings.Contains(resp.Header.Get("Content-Type"), "text/html") || !strings.Contains(string(resp.Body), "/openapi.json") {
+				t.Errorf("GET /docs: expected a Swagger UI page for /openapi.json, got %s %.200s", resp.Header.Get("Content-Type"), resp.Body)
+			}
+		}},
+	}
+}
@@ -0,0 +1,171 @@
+package integration
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	"hrplatform/migrations"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+// DatabaseURLEnv berilgan bo'lsa vaqtinchalik baza shu serverda yaratiladi, aks holda
+// lokal initdb/pg_ctl bilan alohida klaster ishga tushiriladi
+const DatabaseURLEnv = "INTEGRATION_DATABASE_URL"
+
+// PGBinEnv - initdb va pg_ctl joylashgan katalog (PATH da topilmasa)
+const PGBinEnv = "PG_BIN"
+
+// Database - sinovlar uchun yaratilgan, migratsiyalar qo'llangan bo'sh baza.
+// Close bazani (yoki butun klasterni) o'chiradi.
+type Database struct {
+	URL string
+	DB  *sqlx.DB
+
+	cleanup []func() error
+}
+
+func (d *Database) Close() error {
+	var errs []error
+	if d.DB != nil {
+		errs = append(errs, d.DB.Close())
+	}
+	for i := len(d.cleanup) - 1; i >= 0; i-- {
+		errs = append(errs, d.cleanup[i]())
+	}
+	return errors.Join(errs...)
+}
+
+// StartPostgres vaqtinchalik Postgres bazasini tayyorlaydi va unga migrations.Schema ni qo'llaydi
+func StartPostgres(ctx context.Context) (*Database, error) {
+	d := &Database{}
+	var err error
+	if serverURL := os.Getenv(DatabaseURLEnv); serverURL != "" {
+		d.URL, err = d.createDatabase(ctx, serverURL)
+	} else {
+		d.URL, err = d.startCluster(ctx)
+	}
+	if err != nil {
+		d.Close()
+		return nil, err
+	}
+
+	d.DB, err = sqlx.ConnectContext(ctx, "postgres", d.URL)
+	if err != nil {
+		d.Close()
+		return nil, fmt.Errorf("vaqtinchalik bazaga ulanib bo'lmadi: %w", err)
+	}
+	if err := migrations.Apply(ctx, d.DB); err != nil {
+		d.Close()
+		return nil, fmt.Errorf("migratsiyalarni qo'llashda xatolik: %w", err)
+	}
+	return d, nil
+}
+
+// createDatabase mavjud serverda tasodifiy nomli baza yaratadi, Close uni DROP qiladi
+func (d *Database) createDatabase(ctx context.Context, serverURL string) (string, error) {
+	admin, err := sqlx.ConnectContext(ctx, "postgres", serverURL)
+	if err != nil {
+		return "", fmt.Errorf("%s ga ulanib bo'lmadi: %w", DatabaseURLEnv, err)
+	}
+
+	name := "hrplatform_it_" + randomSuffix()
+	if _, err := admin.ExecContext(ctx, "CREATE DATABASE "+name); err != nil {
+		admin.Close()
+		return "", fmt.Errorf("vaqtinchalik baza yaratilmadi: %w", err)
+	}
+	d.cleanup = append(d.cleanup, func() error {
+		defer admin.Close()
+		_, err := admin.Exec("DROP DATABASE IF EXISTS " + name)
+		return err
+	})
+
+	parsed, err := url.Parse(serverURL)
+	if err != nil {
+		return "", fmt.Errorf("%s URL ko'rinishida emas: %w", DatabaseURLEnv, err)
+	}
+	parsed.Path = "/" + name
+	return parsed.String(), nil
+}
+
+// startCluster vaqtinchalik katalogda initdb qiladi va Postgres ni faqat unix socket bilan
+// ishga tushiradi, shuning uchun port band bo'lishi muammo emas. Close serverni to'xtatib katalogni o'chiradi.
+func (d *Database) startCluster(ctx context.Context) (string, error) {
+	initdb, pgCtl, err := findPostgresBinaries()
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := os.MkdirTemp("", "hrplatform-pg-")
+	if err != nil {
+		return "", err
+	}
+	d.cleanup = append(d.cleanup, func() error { return os.RemoveAll(dir) })
+	data := filepath.Join(dir, "data")
+
+	initCmd := exec.CommandContext(ctx, initdb, "-D", data, "-U", "postgres", "-A", "trust", "-E", "UTF8", "--no-sync")
+	if out, err := initCmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("initdb: %w\n%s", err, out)
+	}
+
+	options := fmt.Sprintf("-c listen_addresses='' -k %s -c fsync=off -c synchronous_commit=off -c full_page_writes=off", dir)
+	startCmd := exec.CommandContext(ctx, pgCtl, "-D", data, "-l", filepath.Join(dir, "postgres.log"), "-o", options, "-w", "start")
+	if out, err := startCmd.CombinedOutput(); err != nil {
+		log, _ := os.ReadFile(filepath.Join(dir, "postgres.log"))
+		return "", fmt.Errorf("pg_ctl start: %w\n%s%s", err, out, log)
+	}
+	d.cleanup = append(d.cleanup, func() error {
+		out, err := exec.Command(pgCtl, "-D", data, "-m", "immediate", "-w", "stop").CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("pg_ctl stop: %w\n%s", err, out)
+		}
+		return nil
+	})
+
+	return fmt.Sprintf("host=%s user=postgres dbname=postgres sslmode=disable", dir), nil
+}
+
+// findPostgresBinaries initdb va pg_ctl ni PG_BIN, PATH va Debian/Ubuntu dagi
+// /usr/lib/postgresql/<versiya>/bin kataloglaridan (eng yangisidan boshlab) qidiradi
+func findPostgresBinaries() (string, string, error) {
+	var dirs []string
+	if bin := os.Getenv(PGBinEnv); bin != "" {
+		dirs = append(dirs, bin)
+	}
+	dirs = append(dirs, filepath.SplitList(os.Getenv("PATH"))...)
+	versioned, _ := filepath.Glob("/usr/lib/postgresql/*/bin")
+	sort.Sort(sort.Reverse(sort.StringSlice(versioned)))
+	dirs = append(dirs, versioned...)
+
+	for _, dir := range dirs {
+		initdb := filepath.Join(dir, "initdb")
+		pgCtl := filepath.Join(dir, "pg_ctl")
+		if isExecutable(initdb) && isExecutable(pgCtl) {
+			return initdb, pgCtl, nil
+		}
+	}
+	return "", "", fmt.Errorf("initdb va pg_ctl topilmadi (%s, PATH, /usr/lib/postgresql/*/bin): Postgres ni o'rnating yoki mavjud serverni %s orqali bering",
+		PGBinEnv, DatabaseURLEnv)
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir() && info.Mode()&0o111 != 0
+}
+
+func randomSuffix() string {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,95 @@
+package integration
+
+import (
+	"net/http"
+
+	"hrplatform/contract"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+var recruiterCases = []Case{
+	{Name: "recruiters/crud with etag", Run: func(t contract.T, api *Client) {
+		company := createCompany(api)
+		recruiter := createRecruiter(api, company.ID)
+		path := "/recruiters/" + recruiter.ID.String()
+
+		var got models.Recruiter
+		resp := api.Expect(http.StatusOK, &got, http.MethodGet, path, nil)
+		contract.ExpectEqual(t, got.Birthday.Format("2006-01-02"), "1988-03-04", "birthday")
+
+		update := got.ToUpdate()
+		update.Name = "Renamed Recruiter"
+		api.ExpectError(http.StatusPreconditionRequired, "if_match_required", http.MethodPut, path, update)
+		api.Expect(http.StatusOK, nil, http.MethodPut, path, update, IfMatch(resp.ETag()))
+
+		var patched models.Recruiter
+		resp = api.Expect(http.StatusOK, &patched, http.MethodPatch, path, `{"gender": "m"}`, IfMatch(`"2"`))
+		contract.ExpectEqual(t, patched.Name, "Renamed Recruiter", "name after patch")
+		contract.ExpectEqual(t, patched.Gender, "m", "gender after patch")
+
+		api.Expect(http.StatusOK, nil, http.MethodDelete, path, nil, IfMatch(resp.ETag()))
+		api.ExpectError(http.StatusNotFound, "recruiter_not_found", http.MethodGet, path, nil)
+
+		actions := historyActions(api, path)
+		contract.ExpectEqual(t, len(actions), 4, "history entries")
+	}},
+	{Name: "recruiters/validation and conflicts", Run: func(t contract.T, api *Client) {
+		company := createCompany(api)
+		recruiter := createRecruiter(api, company.ID)
+
+		api.ExpectError(http.StatusBadRequest, "validation_failed", http.MethodPost, "/recruiters/", models.CreateRecruiter{
+			Name: "R", Email: "nope", PhoneNumber: "1", Birthday: "1988-03-04", Gender: "x", CompanyID: company.ID,
+		})
+		api.ExpectError(http.StatusConflict, "recruiter_email_taken", http.MethodPost, "/recruiters/", models.CreateRecruiter{
+			Name: "Copy", Email: recruiter.Email, PhoneNumber: contract.UniquePhone(), Birthday: "1988-03-04", Gender: "f", CompanyID: company.ID,
+		})
+		api.ExpectError(http.StatusConflict, "recruiter_phone_taken", http.MethodPost, "/recruiters/", models.CreateRecruiter{
+			Name: "Copy", Email: contract.UniqueEmail("copy"), PhoneNumber: recruiter.PhoneNumber, Birthday: "1988-03-04", Gender: "f", CompanyID: company.ID,
+		})
+		api.ExpectError(http.StatusBadRequest, "company_not_found", http.MethodPost, "/recruiters/", models.CreateRecruiter{
+			Name: "Orphan", Email: contract.UniqueEmail("orphan"), PhoneNumber: contract.UniquePhone(), Birthday: "1988-03-04", Gender: "f", CompanyID: uuid.New(),
+		})
+		api.ExpectError(http.StatusBadRequest, "invalid_id", http.MethodGet, "/recruiters/42", nil)
+	}},
+	{Name: "recruiters/filter by company and gender", Run: func(t contract.T, api *Client) {
+		company := createCompany(api)
+		other := createCompany(api)
+		female := createRecruiter(api, company.ID)
+		foreign := createRecruiter(api, other.ID)
+
+		var male models.Recruiter
+		api.Expect(http.StatusCreated, &male, http.MethodPost, "/recruiters/", models.CreateRecruiter{
+			Name: contract.UniqueName("Recruiter"), Email: contract.UniqueEmail("recruiter"), PhoneNumber: contract.UniquePhone(),
+			Birthday: "1988-03-04", Gender: "m", CompanyID: company.ID,
+		})
+
+		var recruiters []models.Recruiter
+		api.Expect(http.StatusOK, &recruiters, http.MethodGet, "/recruiters/?company_id="+company.ID.String(), nil)
+		contract.ExpectMembers(t, recruiterIDs(recruiters), []uuid.UUID{female.ID, male.ID}, []uuid.UUID{foreign.ID}, "company_id")
+
+		api.Expect(http.StatusOK, &recruiters, http.MethodGet, "/recruiters/?gender=f&company_id="+company.ID.String(), nil)
+		contract.ExpectMembers(t, recruiterIDs(recruiters), []uuid.UUID{female.ID}, []uuid.UUID{male.ID, foreign.ID}, "gender=f&company_id")
+	}},
+	{Name: "recruiters/delete cascades to interviews", Run: func(t contract.T, api *Client) {
+		h := newHiring(api, contract.UniqueName("Tester"))
+		interview := createInterview(api, h.User.ID, h.Vacancy.ID, h.Recruiter.ID)
+		path := "/recruiters/" + h.Recruiter.ID.String()
+
+		resp := api.Expect(http.StatusOK, nil, http.MethodGet, path, nil)
+		api.Expect(http.StatusOK, nil, http.MethodDelete, path, nil, IfMatch(resp.ETag()))
+		api.ExpectError(http.StatusNotFound, "interview_not_found", http.MethodGet, "/interviews/"+interview.ID.String(), nil)
+
+		// vakansiya rekruiterga bog'liq emas va qoladi
+		api.Expect(http.StatusOK, nil, http.MethodGet, "/vacancies/"+h.Vacancy.ID.String(), nil)
+	}},
+}
+
+func recruiterIDs(recruiters []models.Recruiter) []uuid.UUID {
+	ids := make([]uuid.UUID, len(recruiters))
+	for i, recruiter := range recruiters {
+		ids[i] = recruiter.ID
+	}
+	return ids
+}
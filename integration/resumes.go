@@ -0,0 +1,76 @@
+package integration
+
+import (
+	"net/http"
+	"net/url"
+
+	"hrplatform/contract"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+var resumeCases = []Case{
+	{Name: "resumes/crud with etag", Run: func(t contract.T, api *Client) {
+		user := createUser(api, "1990-01-01", "m")
+		resume := createResume(api, user.ID, "Backend", 2, "uz", "en")
+		path := "/resumes/" + resume.ID.String()
+
+		var got models.Resume
+		resp := api.Expect(http.StatusOK, &got, http.MethodGet, path, nil)
+		contract.ExpectEqual(t, len(got.Languages), 2, "languages")
+
+		update := got.ToUpdate()
+		update.Experience = 4
+		api.ExpectError(http.StatusPreconditionRequired, "if_match_required", http.MethodPut, path, update)
+		api.Expect(http.StatusOK, nil, http.MethodPut, path, update, IfMatch(resp.ETag()))
+
+		var patched models.Resume
+		resp = api.Expect(http.StatusOK, &patched, http.MethodPatch, path, `{"description": "patched"}`, IfMatch(`"2"`))
+		contract.ExpectEqual(t, patched.Experience, 4, "experience after patch")
+		contract.ExpectEqual(t, patched.Description, "patched", "description after patch")
+
+		api.ExpectError(http.StatusPreconditionFailed, "version_mismatch", http.MethodDelete, path, nil, IfMatch(`"1"`))
+		api.Expect(http.StatusOK, nil, http.MethodDelete, path, nil, IfMatch(resp.ETag()))
+		api.ExpectError(http.StatusNotFound, "resume_not_found", http.MethodGet, path, nil)
+
+		actions := historyActions(api, path)
+		contract.ExpectEqual(t, len(actions), 4, "history entries")
+	}},
+	{Name: "resumes/validation and references", Run: func(t contract.T, api *Client) {
+		api.ExpectError(http.StatusBadRequest, "validation_failed", http.MethodPost, "/resumes/", models.CreateResume{Experience: 99, UserID: uuid.New()})
+		api.ExpectError(http.StatusBadRequest, "invalid_reference", http.MethodPost, "/resumes/", models.CreateResume{Position: "Backend", UserID: uuid.New()})
+		api.ExpectError(http.StatusBadRequest, "invalid_query", http.MethodGet, "/resumes/?min_exp=many", nil)
+	}},
+	{Name: "resumes/filter by position and min_exp", Run: func(t contract.T, api *Client) {
+		user := createUser(api, "1990-01-01", "m")
+		position := contract.UniqueName("Data Scientist")
+		senior := createResume(api, user.ID, position, 7)
+		junior := createResume(api, user.ID, position, 1)
+		other := createResume(api, user.ID, "Designer", 7)
+
+		var resumes []models.ResumeWithUser
+		api.Expect(http.StatusOK, &resumes, http.MethodGet, "/resumes/?position="+url.QueryEscape(position[5:]), nil)
+		contract.ExpectMembers(t, resumeIDs(resumes), []uuid.UUID{senior.ID, junior.ID}, []uuid.UUID{other.ID}, "position")
+
+		api.Expect(http.StatusOK, &resumes, http.MethodGet, "/resumes/?min_exp=5&position="+url.QueryEscape(position), nil)
+		contract.ExpectMembers(t, resumeIDs(resumes), []uuid.UUID{senior.ID}, []uuid.UUID{junior.ID, other.ID}, "position+min_exp")
+		for _, resume := range resumes {
+			if resume.ID == senior.ID {
+				contract.ExpectEqual(t, resume.UserEmail, user.Email, "user_email")
+			}
+		}
+
+		// qo'shtirnoq va foiz belgisi SQL ga emas, parametrga tushadi
+		api.Expect(http.StatusOK, &resumes, http.MethodGet, "/resumes/?position="+url.QueryEscape("' OR 1=1 --"), nil)
+		contract.ExpectMembers(t, resumeIDs(resumes), nil, []uuid.UUID{senior.ID, junior.ID, other.ID}, "quoted position")
+	}},
+}
+
+func resumeIDs(resumes []models.ResumeWithUser) []uuid.UUID {
+	ids := make([]uuid.UUID, len(resumes))
+	for i, resume := range resumes {
+		ids[i] = resume.ID
+	}
+	return ids
+}
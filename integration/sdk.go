@@ -0,0 +1,127 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/client"
+	"hrplatform/contract"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+var sdkCases = []Case{
+	{Name: "client/typed calls and errors", Run: func(t contract.T, api *Client) {
+		ctx := context.Background()
+		sdk := client.New(api.BaseURL, client.WithHTTPClient(api.HTTP), client.WithLocale("en"))
+
+		company, err := sdk.CreateCompany(ctx, models.CreateCompany{Name: contract.UniqueName("SDK Company"), Location: "Tashkent", Workers: 5})
+		expectNoError(t, err, "CreateCompany")
+		position := contract.UniqueName("SDK Engineer")
+		vacancy, err := sdk.CreateVacancy(ctx, models.CreateVacancy{Name: "SDK", Position: position, MinExp: 2, CompanyID: company.ID, Description: "sdk"})
+		expectNoError(t, err, "CreateVacancy")
+
+		vacancies, err := sdk.GetAllVacancies(ctx, client.GetAllVacanciesParams{CompanyID: company.ID, MinExp: 1})
+		expectNoError(t, err, "GetAllVacancies")
+		contract.ExpectMembers(t, vacancyIDs(vacancies), []uuid.UUID{vacancy.ID}, nil, "GetAllVacancies")
+
+		_, err = sdk.UpdateVacancy(ctx, vacancy.ID, vacancy.Version+5, vacancy.ToUpdate())
+		if !apperrors.Is(err, apperrors.KindPreconditionFailed) || !client.IsCode(err, "version_mismatch") {
+			t.Errorf("UpdateVacancy with a stale version: got %v, want version_mismatch", err)
+		}
+		patched, err := sdk.PatchVacancy(ctx, vacancy.ID, vacancy.Version, map[string]interface{}{"min_exp": 4})
+		expectNoError(t, err, "PatchVacancy")
+		contract.ExpectEqual(t, patched.MinExp, 4, "min_exp after PatchVacancy")
+
+		user, err := sdk.CreateUser(ctx, models.UserCreate{Name: contract.UniqueName("SDK User"), Email: contract.UniqueEmail("sdk"), PhoneNumber: contract.UniquePhone(), Birthday: "1991-05-06", Gender: "m"})
+		expectNoError(t, err, "CreateUser")
+		_, err = sdk.CreateUser(ctx, models.UserCreate{Name: "Copy", Email: user.Email, PhoneNumber: contract.UniquePhone(), Birthday: "1991-05-06", Gender: "m"})
+		var apiErr *client.Error
+		if !apperrors.Is(err, apperrors.KindConflict) || !client.IsCode(err, "user_email_taken") || !errors.As(err, &apiErr) || apiErr.RequestID == "" {
+			t.Errorf("CreateUser with a taken email: got %#v, want 409 user_email_taken with a request id", err)
+		}
+
+		check, err := sdk.CheckEligibility(ctx, vacancy.ID, client.EligibilityCheckRequest{UserID: user.ID})
+		expectNoError(t, err, "CheckEligibility")
+		contract.ExpectEqual(t, check.Eligible, false, "eligible without a resume")
+
+		_, err = sdk.GetInterviewByID(ctx, uuid.New())
+		if !apperrors.Is(err, apperrors.KindNotFound) || !client.IsCode(err, "interview_not_found") {
+			t.Errorf("GetInterviewByID: got %v, want interview_not_found", err)
+		}
+	}},
+	{Name: "client/retries with one idempotency key and sends the token", Run: func(t contract.T, api *Client) {
+		target, err := url.Parse(api.BaseURL)
+		expectNoError(t, err, "parse base url")
+		proxy := &flakyProxy{next: httputil.NewSingleHostReverseProxy(target), failures: 2}
+		server := httptest.NewServer(proxy)
+		defer server.Close()
+
+		sdk := client.New(server.URL, client.WithRetries(3, time.Millisecond), client.WithToken("secret-token"))
+		company, err := sdk.CreateCompany(context.Background(), models.CreateCompany{Name: contract.UniqueName("Flaky"), Workers: 1})
+		expectNoError(t, err, "CreateCompany through a flaky proxy")
+
+		proxy.mu.Lock()
+		defer proxy.mu.Unlock()
+		contract.ExpectEqual(t, len(proxy.keys), 3, "attempts")
+		for _, key := range proxy.keys {
+			if key == "" || key != proxy.keys[0] {
+				t.Errorf("Idempotency-Key must be the same on every attempt: %q", proxy.keys)
+				break
+			}
+		}
+		contract.ExpectEqual(t, proxy.authorization, "Bearer secret-token", "Authorization")
+
+		var companies []models.Company
+		api.Expect(http.StatusOK, &companies, http.MethodGet, "/companies/", nil)
+		found := 0
+		for _, c := range companies {
+			if c.Name == company.Name {
+				found++
+			}
+		}
+		contract.ExpectEqual(t, found, 1, "companies created")
+	}},
+}
+
+// flakyProxy birinchi failures ta so'rovga 503 qaytaradi (so'rovni serverga yetkazgandan
+// keyin, ya'ni javob yo'qolgandek), keyin esa javobni o'zgarishsiz uzatadi
+type flakyProxy struct {
+	next     http.Handler
+	failures int
+
+	mu            sync.Mutex
+	keys          []string
+	authorization string
+}
+
+func (p *flakyProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	p.keys = append(p.keys, r.Header.Get("Idempotency-Key"))
+	p.authorization = r.Header.Get("Authorization")
+	fail := len(p.keys) <= p.failures
+	p.mu.Unlock()
+
+	if fail {
+		p.next.ServeHTTP(httptest.NewRecorder(), r)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	p.next.ServeHTTP(w, r)
+}
+
+func expectNoError(t contract.T, err error, what string) {
+	t.Helper()
+	if err != nil {
+		t.Errorf("%s: %v", what, err)
+		t.FailNow()
+	}
+}
@@ -0,0 +1,196 @@
+// Package integration - HTTP darajasidagi end-to-end stsenariylar. Har bir stsenariy
+// api.SetupRouter ning to'liq zanjiri (middleware, handler, servis, repository) ustida
+// httptest server orqali ishlaydi. gRPC stsenariylari xuddi shu repositorylar ustidagi
+// grpcapi serveriga lokal port orqali ulanadi:
+//
+//	go run ./cmd/integration                   # vaqtinchalik Postgres (initdb/pg_ctl yoki INTEGRATION_DATABASE_URL)
+//	go run ./cmd/integration --storage=memory  # Postgressiz, memory repositorylar bilan
+//
+// Stsenariylar bitta bazani bo'lishadi, shuning uchun har biri o'z yozuvlarini yaratadi
+// va ro'yxatlarda faqat ularni tekshiradi.
+package integration
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"hrplatform/api"
+	"hrplatform/api/handlers"
+	"hrplatform/api/middleware"
+	"hrplatform/audit"
+	"hrplatform/expand"
+	"hrplatform/graphqlapi"
+	"hrplatform/grpcapi"
+	"hrplatform/importer"
+	"hrplatform/notification"
+	"hrplatform/postgres"
+	"hrplatform/service"
+	"hrplatform/validation"
+	"hrplatform/webhook"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+)
+
+// legacyDeprecation - prefikssiz eski yo'llar sarlavhalaridagi sanalar, versioning
+// stsenariysi ularni tekshiradi
+var legacyDeprecation = middleware.Deprecation{
+	Since:     time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+	Sunset:    time.Date(2027, 4, 19, 0, 0, 0, 0, time.UTC),
+	Successor: APIPrefix,
+}
+
+// Server - repos ustida ishlaydigan to'liq ilova. Webhooklar va bildirishnomalar
+// haqiqiy dispatcher va sender orqali o'tadi, lekin kanallar xabarlarni tashlab yuboradi.
+type Server struct {
+	*httptest.Server
+	Repos postgres.Repositories
+	// Routes - routerdagi marshrutlar, OpenAPI hujjati bilan solishtirish uchun
+	Routes gin.RoutesInfo
+	// GRPCAddr - o'sha repositorylar ustidagi gRPC serverining manzili
+	GRPCAddr string
+	// Batches - /graphql loaderlari va ?expand= repositorylarga qilgan partiyaviy murojaatlar soni
+	Batches *BatchCounter
+
+	grpcServer *grpc.Server
+	sender     *notification.Sender
+}
+
+var registerValidation sync.Once
+
+// NewServer main.go dagi bog'lanishlarni takrorlab routerni quradi va httptest serverini ishga tushiradi
+func NewServer(repos postgres.Repositories) (*Server, error) {
+	var err error
+	registerValidation.Do(func() { err = validation.Register() })
+	if err != nil {
+		return nil, err
+	}
+
+	drivers := map[notification.Channel]notification.Driver{
+		notification.ChannelEmail:    discardDriver{},
+		notification.ChannelSMS:      discardDriver{},
+		notification.ChannelTelegram: discardDriver{},
+	}
+	sender := notification.NewSender(drivers, 1, 100, 1, time.Millisecond)
+	batches := &BatchCounter{}
+	router, grpcServer, err := newApp(repos, sender, batches)
+	if err != nil {
+		sender.Close()
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		sender.Close()
+		return nil, err
+	}
+	go grpcServer.Serve(listener)
+
+	return &Server{
+		Server:     httptest.NewServer(router),
+		Repos:      repos,
+		Routes:     router.Routes(),
+		GRPCAddr:   listener.Addr().String(),
+		Batches:    batches,
+		grpcServer: grpcServer,
+		sender:     sender,
+	}, nil
+}
+
+// discardDriver xabarlarni jo'natmaydi: stsenariylar bildirishnomalarni emas, API ni tekshiradi
+type discardDriver struct{}
+
+func (discardDriver) Send(notification.Message) error { return nil }
+
+func (s *Server) Close() {
+	s.Server.Close()
+	s.grpcServer.Stop()
+	s.sender.Close()
+}
+
+// newApp main.go dagi kabi HTTP routerni va gRPC serverini bitta servislar to'plami ustida quradi.
+// GraphQL handleri va ?expand= batches hisoblaydigan repositorylar ustida ishlaydi.
+func newApp(repos postgres.Repositories, sender *notification.Sender, batches *BatchCounter) (*gin.Engine, *grpc.Server, error) {
+	auditRecorder := &audit.Recorder{Repository: repos.Audit}
+	dispatcher := &webhook.Dispatcher{
+		Repository:  repos.Webhooks,
+		Client:      &http.Client{Timeout: time.Second},
+		MaxAttempts: 1,
+		BaseDelay:   time.Millisecond,
+	}
+	notifier := &notification.Notifier{Users: repos.Users, Recruiters: repos.Recruiters, Vacancies: repos.Vacancies, Preferences: repos.Preferences, Sender: sender}
+
+	companyService := &service.CompanyService{
+		Companies:  repos.Companies,
+		Vacancies:  repos.Vacancies,
+		Recruiters: repos.Recruiters,
+		Interviews: repos.Interviews,
+		Webhooks:   repos.Webhooks,
+		UnitOfWork: repos.UnitOfWork,
+	}
+	vacancyService := &service.VacancyService{Vacancies: repos.Vacancies, Interviews: repos.Interviews, Companies: companyService, UnitOfWork: repos.UnitOfWork}
+	recruiterService := &service.RecruiterService{Recruiters: repos.Recruiters, Interviews: repos.Interviews, Companies: companyService, UnitOfWork: repos.UnitOfWork}
+	eligibilityService := &service.EligibilityService{
+		Rules:      repos.EligibilityRules,
+		Users:      repos.Users,
+		Resumes:    repos.Resumes,
+		Vacancies:  repos.Vacancies,
+		Companies:  companyService,
+		UnitOfWork: repos.UnitOfWork,
+	}
+	interviewService := &service.InterviewService{Interviews: repos.Interviews, Eligibility: eligibilityService, UnitOfWork: repos.UnitOfWork}
+
+	counted := countBatches(repos, batches)
+	expander := &expand.Expander{
+		Users:      counted.Users,
+		Resumes:    counted.Resumes,
+		Companies:  counted.Companies,
+		Recruiters: counted.Recruiters,
+		Vacancies:  counted.Vacancies,
+		Interviews: counted.Interviews,
+	}
+
+	userHandler := &handlers.UserHandler{UserRepository: repos.Users, Audit: auditRecorder, Expand: expander}
+	resumeHandler := &handlers.ResumeHandler{ResumeRepository: repos.Resumes, Audit: auditRecorder, Expand: expander}
+	recruiterHandler := &handlers.RecruiterHandler{RecruiterRepository: repos.Recruiters, Service: recruiterService, Audit: auditRecorder, Expand: expander}
+	companyHandler := &handlers.CompanyHandler{CompanyRepository: repos.Companies, Service: companyService, Audit: auditRecorder, Expand: expander}
+	interviewHandler := &handlers.InterviewHandler{InterviewRepository: repos.Interviews, VacancyRepository: repos.Vacancies, Service: interviewService, Webhooks: dispatcher, Notifier: notifier, Audit: auditRecorder, Expand: expander}
+	vacancyHandler := &handlers.VacancyHandler{VacancyRepository: repos.Vacancies, Service: vacancyService, Webhooks: dispatcher, Audit: auditRecorder, Expand: expander}
+	webhookHandler := &handlers.WebhookHandler{WebhookRepository: repos.Webhooks, Companies: companyService, Audit: auditRecorder}
+	notificationHandler := &handlers.NotificationHandler{PreferenceRepository: repos.Preferences, UserRepository: repos.Users, Audit: auditRecorder}
+	auditHandler := &handlers.AuditHandler{AuditRepository: repos.Audit}
+	eligibilityHandler := &handlers.EligibilityHandler{Service: eligibilityService, Audit: auditRecorder}
+	importHandler := &handlers.ImportHandler{Importer: &importer.Importer{Users: repos.Users, Resumes: repos.Resumes, Vacancies: vacancyService, UnitOfWork: repos.UnitOfWork, Audit: auditRecorder}}
+
+	schema, err := graphqlapi.NewSchema()
+	if err != nil {
+		return nil, nil, err
+	}
+	graphqlHandler := &graphqlapi.Handler{
+		Users:      counted.Users,
+		Resumes:    counted.Resumes,
+		Companies:  counted.Companies,
+		Recruiters: counted.Recruiters,
+		Vacancies:  counted.Vacancies,
+		Interviews: counted.Interviews,
+		Schema:     schema,
+		Limits:     graphqlapi.Limits{MaxCost: 1000, MaxDepth: 6},
+	}
+
+	router := api.SetupRouter(userHandler, resumeHandler, recruiterHandler, companyHandler, interviewHandler, vacancyHandler, webhookHandler, notificationHandler, auditHandler, eligibilityHandler, importHandler, graphqlHandler, legacyDeprecation, repos.Idempotency, 24*time.Hour, 10*time.Second, nil)
+
+	grpcServer := grpcapi.NewServer(
+		&grpcapi.UserServer{UserRepository: repos.Users, Audit: auditRecorder},
+		&grpcapi.ResumeServer{ResumeRepository: repos.Resumes, Audit: auditRecorder},
+		&grpcapi.CompanyServer{CompanyRepository: repos.Companies, Service: companyService, Audit: auditRecorder},
+		&grpcapi.RecruiterServer{RecruiterRepository: repos.Recruiters, Service: recruiterService, Audit: auditRecorder},
+		&grpcapi.VacancyServer{VacancyRepository: repos.Vacancies, Service: vacancyService, Webhooks: dispatcher, Audit: auditRecorder},
+		&grpcapi.InterviewServer{InterviewRepository: repos.Interviews, VacancyRepository: repos.Vacancies, Service: interviewService, Webhooks: dispatcher, Notifier: notifier, Audit: auditRecorder},
+		repos.Preferences,
+		10*time.Second,
+	)
+	return router, grpcServer, nil
+}
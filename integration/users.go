@@ -0,0 +1,170 @@
+package integration
+
+import (
+	"net/http"
+	"strings"
+
+	"hrplatform/contract"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+var userCases = []Case{
+	{Name: "users/crud with etag", Run: func(t contract.T, api *Client) {
+		user := createUser(api, "1990-01-01", "m")
+		path := "/users/" + user.ID.String()
+
+		var got models.User
+		resp := api.Expect(http.StatusOK, &got, http.MethodGet, path, nil)
+		contract.ExpectEqual(t, got.Email, user.Email, "email")
+		contract.ExpectEqual(t, resp.ETag(), `"1"`, "etag")
+
+		update := user.ToUpdate()
+		update.Name = "Renamed User"
+		api.ExpectError(http.StatusPreconditionRequired, "if_match_required", http.MethodPut, path, update)
+		api.ExpectError(http.StatusPreconditionFailed, "version_mismatch", http.MethodPut, path, update, IfMatch(`"7"`))
+		api.Expect(http.StatusOK, nil, http.MethodPut, path, update, IfMatch(resp.ETag()))
+
+		var patched models.User
+		resp = api.Expect(http.StatusOK, &patched, http.MethodPatch, path, `{"gender": "f"}`, IfMatch(`"2"`))
+		contract.ExpectEqual(t, patched.Name, "Renamed User", "name after patch")
+		contract.ExpectEqual(t, patched.Gender, "f", "gender after patch")
+		contract.ExpectEqual(t, resp.ETag(), `"3"`, "etag after patch")
+
+		api.Expect(http.StatusOK, nil, http.MethodDelete, path, nil, IfMatch(resp.ETag()))
+
+		var users []models.User
+		api.Expect(http.StatusOK, &users, http.MethodGet, "/users/", nil)
+		contract.ExpectMembers(t, userIDs(users), nil, []uuid.UUID{user.ID}, "GET /users after delete")
+
+		actions := historyActions(api, path)
+		for _, action := range []string{"create", "update", "delete"} {
+			if !hasAction(actions, action) {
+				t.Errorf("history: no %q entry in %v", action, actions)
+			}
+		}
+	}},
+	{Name: "users/validation and errors", Run: func(t contract.T, api *Client) {
+		resp := api.ExpectError(http.StatusBadRequest, "validation_failed", http.MethodPost, "/users/", models.UserCreate{
+			Name: "A", Email: "not-an-email", PhoneNumber: "123", Birthday: "2999-01-01", Gender: "x",
+		})
+		fields := map[string]bool{}
+		for _, detail := range resp.Details {
+			fields[detail.Field] = true
+		}
+		for _, field := range []string{"name", "email", "phone_number", "birthday", "gender"} {
+			if !fields[field] {
+				t.Errorf("validation_failed: no detail for %s in %+v", field, resp.Details)
+			}
+		}
+
+		api.ExpectError(http.StatusBadRequest, "invalid_json", http.MethodPost, "/users/", `{"name":`)
+		api.ExpectError(http.StatusNotFound, "user_not_found", http.MethodGet, "/users/"+uuid.NewString(), nil)
+		api.ExpectError(http.StatusBadRequest, "invalid_id", http.MethodGet, "/users/not-a-uuid", nil)
+	}},
+	{Name: "users/unique email and phone", Run: func(t contract.T, api *Client) {
+		user := createUser(api, "1990-01-01", "f")
+
+		api.ExpectError(http.StatusConflict, "user_email_taken", http.MethodPost, "/users/", models.UserCreate{
+			Name: "Copy", Email: strings.ToUpper(user.Email), PhoneNumber: contract.UniquePhone(), Birthday: "1990-01-01", Gender: "f",
+		})
+		api.ExpectError(http.StatusConflict, "user_phone_taken", http.MethodPost, "/users/", models.UserCreate{
+			Name: "Copy", Email: contract.UniqueEmail("copy"), PhoneNumber: user.PhoneNumber[4:], Birthday: "1990-01-01", Gender: "f",
+		})
+	}},
+	{Name: "users/filter by age and gender", Run: func(t contract.T, api *Client) {
+		male := createUser(api, adultBirthday(31), "m")
+		female := createUser(api, adultBirthday(31), "f")
+		older := createUser(api, adultBirthday(45), "m")
+
+		var users []models.User
+		api.Expect(http.StatusOK, &users, http.MethodGet, "/users/?age=31", nil)
+		contract.ExpectMembers(t, userIDs(users), []uuid.UUID{male.ID, female.ID}, []uuid.UUID{older.ID}, "age=31")
+
+		api.Expect(http.StatusOK, &users, http.MethodGet, "/users/?age=31&gender=f", nil)
+		contract.ExpectMembers(t, userIDs(users), []uuid.UUID{female.ID}, []uuid.UUID{male.ID, older.ID}, "age=31&gender=f")
+	}},
+	{Name: "users/duplicates and merge", Run: func(t contract.T, api *Client) {
+		name := contract.UniqueName("Twin")
+		var first, second models.User
+		api.Expect(http.StatusCreated, &first, http.MethodPost, "/users/", models.UserCreate{
+			Name: name, Email: contract.UniqueEmail("twin"), PhoneNumber: contract.UniquePhone(), Birthday: "1992-02-02", Gender: "m",
+		})
+		api.Expect(http.StatusCreated, &second, http.MethodPost, "/users/", models.UserCreate{
+			Name: strings.ToLower(name), Email: contract.UniqueEmail("twin"), PhoneNumber: contract.UniquePhone(), Birthday: "1992-02-02", Gender: "m",
+		})
+		resume := createResume(api, first.ID, "Backend", 2)
+
+		var groups []models.DuplicateGroup
+		api.Expect(http.StatusOK, &groups, http.MethodGet, "/users/duplicates", nil)
+		found := false
+		for _, group := range groups {
+			if group.Reason == "name_birthday" && containsUser(group.Users, first.ID) {
+				found = true
+				contract.ExpectMembers(t, userIDs(group.Users), []uuid.UUID{first.ID, second.ID}, nil, "duplicate group")
+			}
+		}
+		if !found {
+			t.Errorf("GET /users/duplicates: no name_birthday group for %s", first.ID)
+		}
+
+		api.ExpectError(http.StatusBadRequest, "merge_same_user", http.MethodPost, "/users/merge", models.MergeUsers{SourceID: first.ID, TargetID: first.ID})
+
+		var result models.MergeResult
+		api.Expect(http.StatusOK, &result, http.MethodPost, "/users/merge", models.MergeUsers{SourceID: first.ID, TargetID: second.ID})
+		contract.ExpectEqual(t, result.MovedResumes, int64(1), "moved_resumes")
+
+		var resumes []models.Resume
+		api.Expect(http.StatusOK, &resumes, http.MethodGet, "/users/"+second.ID.String()+"/myresume", nil)
+		if len(resumes) != 1 || resumes[0].ID != resume.ID {
+			t.Errorf("GET myresume after merge: got %+v, want resume %s", resumes, resume.ID)
+		}
+	}},
+	{Name: "users/interviews, resumes and notification preferences", Run: func(t contract.T, api *Client) {
+		h := newHiring(api, contract.UniqueName("Go Developer"))
+		interview := createInterview(api, h.User.ID, h.Vacancy.ID, h.Recruiter.ID)
+		path := "/users/" + h.User.ID.String()
+
+		var interviews []models.Interview
+		api.Expect(http.StatusOK, &interviews, http.MethodGet, path+"/myInterview", nil)
+		contract.ExpectMembers(t, interviewIDs(interviews), []uuid.UUID{interview.ID}, nil, "myInterview")
+
+		var resumes []models.Resume
+		api.Expect(http.StatusOK, &resumes, http.MethodGet, path+"/myresume", nil)
+		contract.ExpectEqual(t, len(resumes), 1, "myresume")
+
+		var preference models.NotificationPreference
+		api.Expect(http.StatusOK, &preference, http.MethodGet, path+"/notification-preferences", nil)
+		contract.ExpectEqual(t, preference.PreferredChannel, "email", "default channel")
+
+		api.Expect(http.StatusOK, &preference, http.MethodPut, path+"/notification-preferences", map[string]interface{}{
+			"locale": "ru", "preferred_channel": "sms", "muted_kinds": []string{},
+		})
+		contract.ExpectEqual(t, preference.Locale, "ru", "locale")
+		api.ExpectError(http.StatusBadRequest, "unknown_channel", http.MethodPut, path+"/notification-preferences", map[string]interface{}{"preferred_channel": "pigeon"})
+
+		api.Expect(http.StatusOK, &preference, http.MethodPost, path+"/notification-preferences/opt-in", models.ChannelOptIn{Channel: "sms"})
+		contract.ExpectEqual(t, preference.SMSEnabled, true, "sms opt-in")
+		api.Expect(http.StatusOK, &preference, http.MethodPost, path+"/notification-preferences/opt-out", models.ChannelOptIn{Channel: "sms"})
+		contract.ExpectEqual(t, preference.SMSEnabled, false, "sms opt-out")
+		api.ExpectError(http.StatusConflict, "telegram_not_linked", http.MethodPost, path+"/notification-preferences/opt-in", models.ChannelOptIn{Channel: "telegram"})
+	}},
+}
+
+func userIDs(users []models.User) []uuid.UUID {
+	ids := make([]uuid.UUID, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+	return ids
+}
+
+func containsUser(users []models.User, id uuid.UUID) bool {
+	for _, user := range users {
+		if user.ID == id {
+			return true
+		}
+	}
+	return false
+}
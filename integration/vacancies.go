@@ -0,0 +1,81 @@
+package integration
+
+import (
+	"net/http"
+	"net/url"
+
+	"hrplatform/contract"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+var vacancyCases = []Case{
+	{Name: "vacancies/crud with etag", Run: func(t contract.T, api *Client) {
+		company := createCompany(api)
+		vacancy := createVacancy(api, company.ID, "Backend", 2)
+		path := "/vacancies/" + vacancy.ID.String()
+
+		var got models.Vacancy
+		resp := api.Expect(http.StatusOK, &got, http.MethodGet, path, nil)
+		contract.ExpectEqual(t, got.MinExp, 2, "min_exp")
+
+		update := got.ToUpdate()
+		update.MinExp = 3
+		api.ExpectError(http.StatusPreconditionFailed, "version_mismatch", http.MethodPut, path, update, IfMatch(`"9"`))
+		api.Expect(http.StatusOK, nil, http.MethodPut, path, update, IfMatch(resp.ETag()))
+
+		var patched models.Vacancy
+		resp = api.Expect(http.StatusOK, &patched, http.MethodPatch, path, `{"description": "patched"}`, IfMatch(`"2"`))
+		contract.ExpectEqual(t, patched.MinExp, 3, "min_exp after patch")
+		contract.ExpectEqual(t, patched.Description, "patched", "description after patch")
+
+		api.Expect(http.StatusOK, nil, http.MethodDelete, path, nil, IfMatch(resp.ETag()))
+		api.ExpectError(http.StatusNotFound, "vacancy_not_found", http.MethodGet, path, nil)
+
+		actions := historyActions(api, path)
+		contract.ExpectEqual(t, len(actions), 4, "history entries")
+	}},
+	{Name: "vacancies/validation", Run: func(t contract.T, api *Client) {
+		company := createCompany(api)
+		api.ExpectError(http.StatusBadRequest, "validation_failed", http.MethodPost, "/vacancies/", models.CreateVacancy{
+			Name: "V", Position: "Backend", MinExp: 99, CompanyID: company.ID,
+		})
+		api.ExpectError(http.StatusBadRequest, "company_not_found", http.MethodPost, "/vacancies/", models.CreateVacancy{
+			Name: "V", Position: "Backend", CompanyID: uuid.New(), Description: "x",
+		})
+		api.ExpectError(http.StatusBadRequest, "invalid_query", http.MethodGet, "/vacancies/?min_exp=lots", nil)
+	}},
+	{Name: "vacancies/filter by position, min_exp and company", Run: func(t contract.T, api *Client) {
+		company := createCompany(api)
+		position := contract.UniqueName("Mobile Developer")
+		senior := createVacancy(api, company.ID, position, 5)
+		junior := createVacancy(api, company.ID, position, 0)
+		elsewhere := createVacancy(api, createCompany(api).ID, position, 5)
+
+		var vacancies []models.Vacancy
+		api.Expect(http.StatusOK, &vacancies, http.MethodGet, "/vacancies/?position="+url.QueryEscape(position), nil)
+		contract.ExpectMembers(t, vacancyIDs(vacancies), []uuid.UUID{senior.ID, junior.ID, elsewhere.ID}, nil, "position")
+
+		api.Expect(http.StatusOK, &vacancies, http.MethodGet, "/vacancies/?min_exp=3&company_id="+company.ID.String(), nil)
+		contract.ExpectMembers(t, vacancyIDs(vacancies), []uuid.UUID{senior.ID}, []uuid.UUID{junior.ID, elsewhere.ID}, "min_exp&company_id")
+	}},
+	{Name: "vacancies/delete cascades to interviews", Run: func(t contract.T, api *Client) {
+		h := newHiring(api, contract.UniqueName("DevOps"))
+		interview := createInterview(api, h.User.ID, h.Vacancy.ID, h.Recruiter.ID)
+		path := "/vacancies/" + h.Vacancy.ID.String()
+
+		resp := api.Expect(http.StatusOK, nil, http.MethodGet, path, nil)
+		api.Expect(http.StatusOK, nil, http.MethodDelete, path, nil, IfMatch(resp.ETag()))
+		api.ExpectError(http.StatusNotFound, "interview_not_found", http.MethodGet, "/interviews/"+interview.ID.String(), nil)
+		api.Expect(http.StatusOK, nil, http.MethodGet, "/recruiters/"+h.Recruiter.ID.String(), nil)
+	}},
+}
+
+func vacancyIDs(vacancies []models.Vacancy) []uuid.UUID {
+	ids := make([]uuid.UUID, len(vacancies))
+	for i, vacancy := range vacancies {
+		ids[i] = vacancy.ID
+	}
+	return ids
+}
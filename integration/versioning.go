@@ -0,0 +1,59 @@
+package integration
+
+import (
+	"fmt"
+	"net/http"
+
+	"hrplatform/contract"
+	"hrplatform/models"
+	"hrplatform/openapi"
+
+	"github.com/google/uuid"
+)
+
+var versioningCases = []Case{
+	{Name: "versioning/v1 routes are current", Run: func(t contract.T, api *Client) {
+		user := createUser(api, "1990-01-01", "m")
+		resp := api.Expect(http.StatusOK, nil, http.MethodGet, "/users/"+user.ID.String(), nil)
+		for _, name := range []string{"Deprecation", "Sunset"} {
+			if value := resp.Header.Get(name); value != "" {
+				t.Errorf("GET %s/users/:id: unexpected %s header %q", APIPrefix, name, value)
+			}
+		}
+
+		var doc openapi.Document
+		api.Expect(http.StatusOK, &doc, http.MethodGet, "/openapi.json", nil)
+		if len(doc.Servers) != 1 || doc.Servers[0].URL != APIPrefix {
+			t.Errorf("openapi servers: got %+v, want one server %s", doc.Servers, APIPrefix)
+		}
+	}},
+	{Name: "versioning/unversioned routes are deprecated aliases", Run: func(t contract.T, api *Client) {
+		legacy := *api
+		legacy.Prefix = ""
+		user := createUser(api, "1990-01-01", "f")
+
+		var got models.User
+		resp := legacy.Expect(http.StatusOK, &got, http.MethodGet, "/users/"+user.ID.String(), nil)
+		contract.ExpectEqual(t, got.ID, user.ID, "user read through the unversioned path")
+		expectDeprecated(t, resp, "/users/"+user.ID.String())
+
+		// yozish va xatolik javoblarida ham sarlavhalar bor
+		var created models.Company
+		resp = legacy.Expect(http.StatusCreated, &created, http.MethodPost, "/companies/", models.CreateCompany{Name: contract.UniqueName("Legacy"), Location: "Tashkent", Workers: 3})
+		expectDeprecated(t, resp, "/companies/")
+		api.Expect(http.StatusOK, nil, http.MethodGet, "/companies/"+created.ID.String(), nil)
+
+		missing := "/vacancies/" + uuid.NewString()
+		resp = legacy.Do(http.MethodGet, missing, nil)
+		contract.ExpectEqual(t, resp.Status, http.StatusNotFound, "status of a missing vacancy")
+		expectDeprecated(t, resp, missing)
+	}},
+}
+
+// expectDeprecated prefikssiz yo'l javobidagi Deprecation, Sunset va Link sarlavhalarini tekshiradi
+func expectDeprecated(t contract.T, resp Response, path string) {
+	t.Helper()
+	contract.ExpectEqual(t, resp.Header.Get("Deprecation"), fmt.Sprintf("@%d", legacyDeprecation.Since.Unix()), "Deprecation of "+path)
+	contract.ExpectEqual(t, resp.Header.Get("Sunset"), "Mon, 19 Apr 2027 00:00:00 GMT", "Sunset of "+path)
+	contract.ExpectEqual(t, resp.Header.Get("Link"), fmt.Sprintf("<%s%s>; rel=\"successor-version\"", APIPrefix, path), "Link of "+path)
+}
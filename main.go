@@ -1,11 +1,28 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"hrplatform/api"
 	"hrplatform/api/handlers"
+	"hrplatform/api/middleware"
+	"hrplatform/audit"
 	"hrplatform/config"
+	"hrplatform/expand"
+	"hrplatform/graphqlapi"
+	"hrplatform/grpcapi"
+	"hrplatform/importer"
+	"hrplatform/memory"
+	"hrplatform/notification"
 	"hrplatform/postgres"
+	"hrplatform/reminder"
+	"hrplatform/service"
+	"hrplatform/validation"
+	"hrplatform/webhook"
 	"log"
+	"net"
+	"net/http"
+	"time"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 )
@@ -14,30 +31,196 @@ func main() {
 	// Konfiguratsiyani yuklash
 	cfg := config.Load()
 
-	// Malumotlar bazasiga ulani
-	db, err := sqlx.Connect("postgres", cfg.DatabaseURL)
-	if err != nil {
-		log.Fatalf("Ma'lumotlar bazasiga ulanishda xatolik: %v", err)
+	// Ma'lumotlar ombori: Postgres yoki xotira (lokal demo uchun, --storage=memory)
+	storage := flag.String("storage", cfg.Storage, "ma'lumotlar ombori: postgres yoki memory")
+	flag.Parse()
+
+	var repos postgres.Repositories
+	switch *storage {
+	case "postgres":
+		// Malumotlar bazasiga ulani
+		db, err := sqlx.Connect("postgres", cfg.DatabaseURL)
+		if err != nil {
+			log.Fatalf("Ma'lumotlar bazasiga ulanishda xatolik: %v", err)
+		}
+		repos = postgres.NewRepositories(db, cfg.TxMaxAttempts)
+	case "memory":
+		log.Println("Ma'lumotlar xotirada saqlanadi va server to'xtaganda yo'qoladi")
+		repos = memory.NewRepositories()
+	default:
+		log.Fatalf("Noma'lum storage %q: postgres yoki memory bo'lishi kerak", *storage)
 	}
 
 	// Repositorylarni yaratish
-	userRepo := &postgres.PostgresUserRepository{DB: db}
-	resumeRepo := &postgres.PostgresResumeRepository{DB: db}
-	recruiterRepo := &postgres.PostgresRecruiterRepository{DB: db}
-	companyRepo := &postgres.PostgresCompanyRepository{DB: db}
-	interviewRepo := &postgres.PostgresInterviewRepository{DB: db}
-	vacancyRepo := &postgres.PostgresVacancyRepository{DB: db}
+	userRepo := repos.Users
+	resumeRepo := repos.Resumes
+	recruiterRepo := repos.Recruiters
+	companyRepo := repos.Companies
+	interviewRepo := repos.Interviews
+	vacancyRepo := repos.Vacancies
+	webhookRepo := repos.Webhooks
+	preferenceRepo := repos.Preferences
+	auditRepo := repos.Audit
+	auditRecorder := &audit.Recorder{Repository: auditRepo}
+	idempotencyRepo := repos.Idempotency
+	eligibilityRepo := repos.EligibilityRules
+	unitOfWork := repos.UnitOfWork
+
+	// Webhook dispatcher
+	dispatcher := &webhook.Dispatcher{
+		Repository:  webhookRepo,
+		Client:      &http.Client{Timeout: cfg.WebhookTimeout},
+		MaxAttempts: cfg.WebhookMaxAttempts,
+		BaseDelay:   cfg.WebhookBaseDelay,
+	}
+
+	// Bildirishnomalar: har bir kanal uchun o'z driveri
+	drivers := map[notification.Channel]notification.Driver{}
+	switch cfg.NotifyDriver {
+	case "smtp":
+		drivers[notification.ChannelEmail] = &notification.SMTPDriver{Host: cfg.SMTPHost, Port: cfg.SMTPPort, Username: cfg.SMTPUser, Password: cfg.SMTPPassword, From: cfg.SMTPFrom}
+	case "file":
+		drivers[notification.ChannelEmail] = &notification.FileDriver{Dir: cfg.NotifyOutboxDir, From: cfg.SMTPFrom}
+	default:
+		drivers[notification.ChannelEmail] = &notification.LogDriver{}
+	}
+	switch cfg.SMSDriver {
+	case "eskiz":
+		drivers[notification.ChannelSMS] = &notification.EskizSMSDriver{BaseURL: cfg.EskizBaseURL, Token: cfg.EskizToken, From: cfg.EskizFrom, Client: &http.Client{Timeout: 10 * time.Second}}
+	case "log":
+		drivers[notification.ChannelSMS] = &notification.LogDriver{}
+	}
+	switch cfg.TelegramDriver {
+	case "bot":
+		drivers[notification.ChannelTelegram] = &notification.TelegramDriver{Token: cfg.TelegramBotToken, Client: &http.Client{Timeout: 10 * time.Second}}
+	case "log":
+		drivers[notification.ChannelTelegram] = &notification.LogDriver{}
+	}
+	sender := notification.NewSender(drivers, cfg.NotifyWorkers, cfg.NotifyQueueSize, cfg.NotifyMaxAttempts, time.Second)
+	defer sender.Close()
+	notifier := &notification.Notifier{Users: userRepo, Recruiters: recruiterRepo, Vacancies: vacancyRepo, Preferences: preferenceRepo, Sender: sender}
+
+	// Intervyu eslatmalari workeri
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if cfg.RemindersEnabled && repos.Reminders == nil {
+		log.Printf("Intervyu eslatmalari %s omborida ishlamaydi, worker ishga tushirilmadi", *storage)
+	} else if cfg.RemindersEnabled {
+		scheduler := &reminder.Scheduler{
+			Reminders: repos.Reminders,
+			Notifier:  notifier,
+			Interval:  cfg.ReminderInterval,
+		}
+		go scheduler.Run(ctx)
+	}
+
+	// Muddati o'tgan Idempotency-Key yozuvlarini tozalash
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if _, err := idempotencyRepo.DeleteExpired(ctx, now); err != nil {
+					log.Printf("Idempotency kalitlarini tozalashda xatolik: %v", err)
+				}
+			}
+		}
+	}()
+
+	// Servislar: biznes qoidalari
+	companyService := &service.CompanyService{
+		Companies:  companyRepo,
+		Vacancies:  vacancyRepo,
+		Recruiters: recruiterRepo,
+		Interviews: interviewRepo,
+		Webhooks:   webhookRepo,
+		UnitOfWork: unitOfWork,
+	}
+	vacancyService := &service.VacancyService{Vacancies: vacancyRepo, Interviews: interviewRepo, Companies: companyService, UnitOfWork: unitOfWork}
+	recruiterService := &service.RecruiterService{Recruiters: recruiterRepo, Interviews: interviewRepo, Companies: companyService, UnitOfWork: unitOfWork}
+	eligibilityService := &service.EligibilityService{
+		Rules:      eligibilityRepo,
+		Users:      userRepo,
+		Resumes:    resumeRepo,
+		Vacancies:  vacancyRepo,
+		Companies:  companyService,
+		UnitOfWork: unitOfWork,
+	}
+	interviewService := &service.InterviewService{Interviews: interviewRepo, Eligibility: eligibilityService, UnitOfWork: unitOfWork}
 
 	// Handlerlarni yaratish
-	userHandler := &handlers.UserHandler{UserRepository: userRepo}
-	resumeHandler := &handlers.ResumeHandler{ResumeRepository: resumeRepo}
-	recruiterHandler := &handlers.RecruiterHandler{RecruiterRepository: recruiterRepo}
-	companyHandler := &handlers.CompanyHandler{CompanyRepository: companyRepo}
-	interviewHandler := &handlers.InterviewHandler{InterviewRepository: interviewRepo}
-	vacancyHandler := &handlers.VacancyHandler{VacancyRepository: vacancyRepo}
+	// ?expand= bog'langan yozuvlarni repositorylarning partiyaviy metodlari bilan oladi
+	expander := &expand.Expander{Users: userRepo, Resumes: resumeRepo, Companies: companyRepo, Recruiters: recruiterRepo, Vacancies: vacancyRepo, Interviews: interviewRepo}
+	userHandler := &handlers.UserHandler{UserRepository: userRepo, Audit: auditRecorder, Expand: expander}
+	resumeHandler := &handlers.ResumeHandler{ResumeRepository: resumeRepo, Audit: auditRecorder, Expand: expander}
+	recruiterHandler := &handlers.RecruiterHandler{RecruiterRepository: recruiterRepo, Service: recruiterService, Audit: auditRecorder, Expand: expander}
+	companyHandler := &handlers.CompanyHandler{CompanyRepository: companyRepo, Service: companyService, Audit: auditRecorder, Expand: expander}
+	interviewHandler := &handlers.InterviewHandler{InterviewRepository: interviewRepo, VacancyRepository: vacancyRepo, Service: interviewService, Webhooks: dispatcher, Notifier: notifier, Audit: auditRecorder, Expand: expander}
+	vacancyHandler := &handlers.VacancyHandler{VacancyRepository: vacancyRepo, Service: vacancyService, Webhooks: dispatcher, Audit: auditRecorder, Expand: expander}
+	webhookHandler := &handlers.WebhookHandler{WebhookRepository: webhookRepo, Companies: companyService, Audit: auditRecorder}
+	notificationHandler := &handlers.NotificationHandler{
+		PreferenceRepository:  preferenceRepo,
+		UserRepository:        userRepo,
+		TelegramBotName:       cfg.TelegramBotName,
+		TelegramWebhookSecret: cfg.TelegramWebhookSecret,
+		Audit:                 auditRecorder,
+	}
+	auditHandler := &handlers.AuditHandler{AuditRepository: auditRepo}
+	eligibilityHandler := &handlers.EligibilityHandler{Service: eligibilityService, Audit: auditRecorder}
+	// CSV/XLSX importi POST endpointlari bilan bir xil repository va servislardan foydalanadi
+	importHandler := &handlers.ImportHandler{Importer: &importer.Importer{Users: userRepo, Resumes: resumeRepo, Vacancies: vacancyService, UnitOfWork: unitOfWork, Audit: auditRecorder}}
 
 	// Gin routerni sozlash
-	router := api.SetupRouter(userHandler, resumeHandler, recruiterHandler, companyHandler, interviewHandler, vacancyHandler)
+	if err := validation.Register(); err != nil {
+		log.Fatalf("Validatorni sozlashda xatolik: %v", err)
+	}
+
+	// GraphQL o'sha repositorylar ustida, faqat o'qish uchun
+	graphqlSchema, err := graphqlapi.NewSchema()
+	if err != nil {
+		log.Fatalf("GraphQL sxemasini qurishda xatolik: %v", err)
+	}
+	graphqlHandler := &graphqlapi.Handler{
+		Users:      userRepo,
+		Resumes:    resumeRepo,
+		Companies:  companyRepo,
+		Recruiters: recruiterRepo,
+		Vacancies:  vacancyRepo,
+		Interviews: interviewRepo,
+		Schema:     graphqlSchema,
+		Limits:     graphqlapi.Limits{MaxCost: cfg.GraphQLMaxCost, MaxDepth: cfg.GraphQLMaxDepth},
+	}
+
+	// prefikssiz eski yo'llar /api/v1 ga ko'chgan, Sunset dan keyin olib tashlanadi
+	legacyDeprecation := middleware.Deprecation{Since: cfg.LegacyAPIDeprecated, Sunset: cfg.LegacyAPISunset, Successor: "/api/v1"}
+	router := api.SetupRouter(userHandler, resumeHandler, recruiterHandler, companyHandler, interviewHandler, vacancyHandler, webhookHandler, notificationHandler, auditHandler, eligibilityHandler, importHandler, graphqlHandler, legacyDeprecation, idempotencyRepo, cfg.IdempotencyTTL, cfg.RequestTimeout, cfg.RouteTimeouts)
+
+	// gRPC server alohida portda, o'sha repository va servislar ustida
+	if cfg.GRPCPort != "" {
+		grpcServer := grpcapi.NewServer(
+			&grpcapi.UserServer{UserRepository: userRepo, Audit: auditRecorder},
+			&grpcapi.ResumeServer{ResumeRepository: resumeRepo, Audit: auditRecorder},
+			&grpcapi.CompanyServer{CompanyRepository: companyRepo, Service: companyService, Audit: auditRecorder},
+			&grpcapi.RecruiterServer{RecruiterRepository: recruiterRepo, Service: recruiterService, Audit: auditRecorder},
+			&grpcapi.VacancyServer{VacancyRepository: vacancyRepo, Service: vacancyService, Webhooks: dispatcher, Audit: auditRecorder},
+			&grpcapi.InterviewServer{InterviewRepository: interviewRepo, VacancyRepository: vacancyRepo, Service: interviewService, Webhooks: dispatcher, Notifier: notifier, Audit: auditRecorder},
+			preferenceRepo,
+			cfg.RequestTimeout,
+		)
+		listener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+		if err != nil {
+			log.Fatalf("gRPC portini ochishda xatolik: %v", err)
+		}
+		go func() {
+			if err := grpcServer.Serve(listener); err != nil {
+				log.Fatalf("gRPC serverini ishga tushirishda xatolik: %v", err)
+			}
+		}()
+		defer grpcServer.GracefulStop()
+	}
 
 	// Serverni ishga tushirish
 	if err := router.Run(":" + cfg.HTTPPort); err != nil {
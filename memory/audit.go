@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"context"
+
+	"hrplatform/models"
+)
+
+// MemoryAuditRepository - faqat qo'shish mumkin bo'lgan jurnal, xuddi audit_log jadvali kabi
+type MemoryAuditRepository struct {
+	Store *Store
+}
+
+func (r *MemoryAuditRepository) CreateEntry(ctx context.Context, entry models.AuditEntry) error {
+	defer r.Store.lock(ctx)()
+	r.Store.audit = append(r.Store.audit, entry)
+	return nil
+}
+
+// GetEntries yozuvlarni eng yangisidan boshlab qaytaradi. Bo'sh parametrlar filtrlanmaydi.
+func (r *MemoryAuditRepository) GetEntries(ctx context.Context, entityType, entityID string) ([]models.AuditEntry, error) {
+	defer r.Store.lock(ctx)()
+	entries := []models.AuditEntry{}
+	for i := len(r.Store.audit) - 1; i >= 0; i-- {
+		entry := r.Store.audit[i]
+		if (entityType == "" || entry.EntityType == entityType) && (entityID == "" || entry.EntityID == entityID) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
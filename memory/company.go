@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+type MemoryCompanyRepository struct {
+	Store *Store
+}
+
+func (r *MemoryCompanyRepository) CreateCompany(ctx context.Context, companyCreate models.CreateCompany) (models.Company, error) {
+	company := models.Company{
+		ID:        uuid.New(),
+		Name:      companyCreate.Name,
+		Location:  companyCreate.Location,
+		Workers:   companyCreate.Workers,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		DeletedAt: 0,
+		Version:   1,
+	}
+
+	defer r.Store.lock(ctx)()
+	r.Store.companies[company.ID] = company
+	return company, nil
+}
+
+func (r *MemoryCompanyRepository) GetCompanyByID(ctx context.Context, id string) (models.Company, error) {
+	companyID, err := parseID(id)
+	if err != nil {
+		return models.Company{}, err
+	}
+
+	defer r.Store.lock(ctx)()
+	company, ok := r.Store.companies[companyID]
+	if !ok {
+		return models.Company{}, apperrors.NotFound("company_not_found", "Company not found")
+	}
+	return company, nil
+}
+
+func (r *MemoryCompanyRepository) GetAllCompanies(ctx context.Context) ([]models.Company, error) {
+	defer r.Store.lock(ctx)()
+	companies := make([]models.Company, 0, len(r.Store.companies))
+	for _, company := range r.Store.companies {
+		if company.DeletedAt == 0 {
+			companies = append(companies, company)
+		}
+	}
+	sort.Slice(companies, func(i, j int) bool {
+		return createdBefore(companies[i].CreatedAt, companies[j].CreatedAt, companies[i].ID, companies[j].ID)
+	})
+	if len(companies) == 0 {
+		return nil, nil
+	}
+	return companies, nil
+}
+
+func (r *MemoryCompanyRepository) GetCompaniesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Company, error) {
+	wanted := idSet(ids)
+
+	defer r.Store.lock(ctx)()
+	var companies []models.Company
+	for id, company := range r.Store.companies {
+		if wanted[id] {
+			companies = append(companies, company)
+		}
+	}
+	sort.Slice(companies, func(i, j int) bool {
+		return createdBefore(companies[i].CreatedAt, companies[j].CreatedAt, companies[i].ID, companies[j].ID)
+	})
+	return companies, nil
+}
+
+// UpdateCompany faqat faol va versiyasi companyUpdate.Version ga teng kompaniyani yozadi
+func (r *MemoryCompanyRepository) UpdateCompany(ctx context.Context, companyUpdate models.UpdateCompany) error {
+	defer r.Store.lock(ctx)()
+	company, ok := r.Store.companies[companyUpdate.ID]
+	if !ok || company.DeletedAt != 0 || company.Version != companyUpdate.Version {
+		return versionMismatch()
+	}
+
+	company.Name = companyUpdate.Name
+	company.Location = companyUpdate.Location
+	company.Workers = companyUpdate.Workers
+	company.UpdatedAt = time.Now()
+	company.Version++
+	r.Store.companies[company.ID] = company
+	return nil
+}
+
+// DeleteCompany kompaniyani soft delete qiladi, bog'liq yozuvlar service.CompanyService da o'chiriladi
+func (r *MemoryCompanyRepository) DeleteCompany(ctx context.Context, id string) error {
+	companyID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	defer r.Store.lock(ctx)()
+	if company, ok := r.Store.companies[companyID]; ok {
+		company.DeletedAt = time.Now().Unix()
+		company.UpdatedAt = time.Now()
+		company.Version++
+		r.Store.companies[companyID] = company
+	}
+	return nil
+}
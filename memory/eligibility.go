@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+type MemoryEligibilityRuleRepository struct {
+	Store *Store
+}
+
+func (r *MemoryEligibilityRuleRepository) GetCompanyRules(ctx context.Context, companyID uuid.UUID) ([]models.EligibilityRule, error) {
+	defer r.Store.lock(ctx)()
+	return r.Store.rules(func(rule models.EligibilityRule) bool {
+		return rule.CompanyID.Valid && rule.CompanyID.UUID == companyID
+	}), nil
+}
+
+func (r *MemoryEligibilityRuleRepository) GetVacancyRules(ctx context.Context, vacancyID uuid.UUID) ([]models.EligibilityRule, error) {
+	defer r.Store.lock(ctx)()
+	return r.Store.rules(func(rule models.EligibilityRule) bool {
+		return rule.VacancyID.Valid && rule.VacancyID.UUID == vacancyID
+	}), nil
+}
+
+func (r *MemoryEligibilityRuleRepository) ReplaceCompanyRules(ctx context.Context, companyID uuid.UUID, rules []models.EligibilityRule) error {
+	defer r.Store.lock(ctx)()
+	if _, ok := r.Store.companies[companyID]; !ok {
+		return invalidReference()
+	}
+	for id, rule := range r.Store.eligibility {
+		if rule.CompanyID.Valid && rule.CompanyID.UUID == companyID {
+			delete(r.Store.eligibility, id)
+		}
+	}
+	for _, rule := range rules {
+		rule.CompanyID = uuid.NullUUID{UUID: companyID, Valid: true}
+		rule.VacancyID = uuid.NullUUID{}
+		r.Store.eligibility[rule.ID] = rule
+	}
+	return nil
+}
+
+func (r *MemoryEligibilityRuleRepository) ReplaceVacancyRules(ctx context.Context, vacancyID uuid.UUID, rules []models.EligibilityRule) error {
+	defer r.Store.lock(ctx)()
+	if _, ok := r.Store.vacancies[vacancyID]; !ok {
+		return invalidReference()
+	}
+	for id, rule := range r.Store.eligibility {
+		if rule.VacancyID.Valid && rule.VacancyID.UUID == vacancyID {
+			delete(r.Store.eligibility, id)
+		}
+	}
+	for _, rule := range rules {
+		rule.CompanyID = uuid.NullUUID{}
+		rule.VacancyID = uuid.NullUUID{UUID: vacancyID, Valid: true}
+		r.Store.eligibility[rule.ID] = rule
+	}
+	return nil
+}
+
+// rules match ga mos qoidalarni position bo'yicha tartiblab qaytaradi
+func (s *Store) rules(match func(models.EligibilityRule) bool) []models.EligibilityRule {
+	rules := []models.EligibilityRule{}
+	for _, rule := range s.eligibility {
+		if match(rule) {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Position < rules[j].Position })
+	return rules
+}
@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"hrplatform/models"
+)
+
+// idempotencyID - idempotency_keys jadvalining birlamchi kaliti (key, method, path)
+type idempotencyID struct {
+	key, method, path string
+}
+
+type MemoryIdempotencyRepository struct {
+	Store *Store
+}
+
+// Reserve kalit yangi yoki muddati o'tgan bo'lsa uni band qiladi (true), aks holda mavjud yozuvni qaytaradi
+func (r *MemoryIdempotencyRepository) Reserve(ctx context.Context, record models.IdempotencyKey) (models.IdempotencyKey, bool, error) {
+	id := idempotencyID{record.Key, record.Method, record.Path}
+
+	defer r.Store.lock(ctx)()
+	if existing, ok := r.Store.idempotency[id]; ok && existing.ExpiresAt.After(record.CreatedAt) {
+		return existing, false, nil
+	}
+	record.StatusCode = 0
+	record.ContentType = ""
+	record.ResponseBody = nil
+	r.Store.idempotency[id] = record
+	return record, true, nil
+}
+
+func (r *MemoryIdempotencyRepository) Complete(ctx context.Context, record models.IdempotencyKey) error {
+	id := idempotencyID{record.Key, record.Method, record.Path}
+
+	defer r.Store.lock(ctx)()
+	existing, ok := r.Store.idempotency[id]
+	if !ok {
+		return nil
+	}
+	existing.StatusCode = record.StatusCode
+	existing.ContentType = record.ContentType
+	existing.ResponseBody = append([]byte(nil), record.ResponseBody...)
+	r.Store.idempotency[id] = existing
+	return nil
+}
+
+func (r *MemoryIdempotencyRepository) Release(ctx context.Context, key, method, path string) error {
+	defer r.Store.lock(ctx)()
+	delete(r.Store.idempotency, idempotencyID{key, method, path})
+	return nil
+}
+
+func (r *MemoryIdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	defer r.Store.lock(ctx)()
+	var deleted int64
+	for id, record := range r.Store.idempotency {
+		if !record.ExpiresAt.After(now) {
+			delete(r.Store.idempotency, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
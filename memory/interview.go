@@ -0,0 +1,204 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+type MemoryInterviewRepository struct {
+	Store *Store
+}
+
+func (r *MemoryInterviewRepository) CreateInterview(ctx context.Context, interviewCreate models.CreateInterview) (models.Interview, error) {
+	interviewDate, err := time.Parse("2006-01-02 15:04:05", interviewCreate.InterviewDate)
+	if err != nil {
+		return models.Interview{}, apperrors.Validation("invalid_date", "Invalid date format").WithField("interview_date", "expected YYYY-MM-DD HH:MM:SS")
+	}
+
+	interview := models.Interview{
+		ID:            uuid.New(),
+		UserID:        interviewCreate.UserID,
+		VacancyID:     interviewCreate.VacancyID,
+		RecruiterID:   interviewCreate.RecruiterID,
+		InterviewDate: interviewDate,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+		DeletedAt:     0,
+		Version:       1,
+	}
+
+	defer r.Store.lock(ctx)()
+	if err := r.Store.checkInterviewReferences(interview); err != nil {
+		return models.Interview{}, err
+	}
+	r.Store.interviews[interview.ID] = interview
+	return interview, nil
+}
+
+func (r *MemoryInterviewRepository) GetInterviewByID(ctx context.Context, id uuid.UUID) (models.Interview, error) {
+	defer r.Store.lock(ctx)()
+	interview, ok := r.Store.interviews[id]
+	if !ok {
+		return models.Interview{}, apperrors.NotFound("interview_not_found", "Interview not found")
+	}
+	return interview, nil
+}
+
+func (r *MemoryInterviewRepository) GetInterviewsByUserID(ctx context.Context, userID uuid.UUID) ([]models.Interview, error) {
+	defer r.Store.lock(ctx)()
+	var interviews []models.Interview
+	for _, interview := range r.Store.sortedInterviews() {
+		if interview.UserID == userID {
+			interviews = append(interviews, interview)
+		}
+	}
+	return interviews, nil
+}
+
+// GetInterviewsByVacancyIDs GetAllInterviews kabi faqat o'chirilmagan intervyularni qaytaradi
+func (r *MemoryInterviewRepository) GetInterviewsByVacancyIDs(ctx context.Context, vacancyIDs []uuid.UUID) ([]models.Interview, error) {
+	wanted := idSet(vacancyIDs)
+
+	defer r.Store.lock(ctx)()
+	var interviews []models.Interview
+	for _, interview := range r.Store.sortedInterviews() {
+		if interview.DeletedAt == 0 && wanted[interview.VacancyID] {
+			interviews = append(interviews, interview)
+		}
+	}
+	return interviews, nil
+}
+
+// GetInterviewsByUserIDs GetAllInterviews kabi faqat o'chirilmagan intervyularni qaytaradi
+func (r *MemoryInterviewRepository) GetInterviewsByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]models.Interview, error) {
+	wanted := idSet(userIDs)
+
+	defer r.Store.lock(ctx)()
+	var interviews []models.Interview
+	for _, interview := range r.Store.sortedInterviews() {
+		if interview.DeletedAt == 0 && wanted[interview.UserID] {
+			interviews = append(interviews, interview)
+		}
+	}
+	return interviews, nil
+}
+
+// GetAllInterviews o'chirilmagan intervyularni qaytaradi. Filtrlar Postgres dagi ichki so'rovlar bilan bir xil:
+// "company_id" - rekruiter shu kompaniyada, "position" - vakansiya lavozimi (ILIKE),
+// "experience" - nomzodning kamida bitta rezyumesida tajriba >= qiymat.
+func (r *MemoryInterviewRepository) GetAllInterviews(ctx context.Context, filter map[string]interface{}) ([]models.Interview, error) {
+	defer r.Store.lock(ctx)()
+	var interviews []models.Interview
+	for _, interview := range r.Store.sortedInterviews() {
+		if interview.DeletedAt != 0 {
+			continue
+		}
+		if companyID, ok := filter["company_id"].(uuid.UUID); ok {
+			recruiter, found := r.Store.recruiters[interview.RecruiterID]
+			if !found || recruiter.CompanyID != companyID {
+				continue
+			}
+		}
+		if position, ok := filter["position"].(string); ok {
+			vacancy, found := r.Store.vacancies[interview.VacancyID]
+			if !found || !containsFold(vacancy.Position, position) {
+				continue
+			}
+		}
+		if experience, ok := filter["experience"].(int); ok && !r.Store.hasExperience(interview.UserID, experience) {
+			continue
+		}
+		interviews = append(interviews, interview)
+	}
+	return interviews, nil
+}
+
+// UpdateInterview faqat versiyasi interviewUpdate.Version ga teng intervyuni yozadi
+func (r *MemoryInterviewRepository) UpdateInterview(ctx context.Context, interviewUpdate models.UpdateInterview) error {
+	interviewDate, err := time.Parse("2006-01-02 15:04:05", interviewUpdate.InterviewDate)
+	if err != nil {
+		return apperrors.Validation("invalid_date", "Invalid date format").WithField("interview_date", "expected YYYY-MM-DD HH:MM:SS")
+	}
+
+	defer r.Store.lock(ctx)()
+	interview, ok := r.Store.interviews[interviewUpdate.ID]
+	if !ok || interview.Version != interviewUpdate.Version {
+		return versionMismatch()
+	}
+
+	interview.UserID = interviewUpdate.UserID
+	interview.VacancyID = interviewUpdate.VacancyID
+	interview.RecruiterID = interviewUpdate.RecruiterID
+	interview.InterviewDate = interviewDate
+	if err := r.Store.checkInterviewReferences(interview); err != nil {
+		return err
+	}
+	interview.UpdatedAt = time.Now()
+	interview.Version++
+	r.Store.interviews[interview.ID] = interview
+	return nil
+}
+
+func (r *MemoryInterviewRepository) DeleteInterview(ctx context.Context, id uuid.UUID) error {
+	defer r.Store.lock(ctx)()
+	delete(r.Store.interviews, id)
+	return nil
+}
+
+func (r *MemoryInterviewRepository) DeleteInterviewsByVacancyID(ctx context.Context, vacancyID uuid.UUID) error {
+	defer r.Store.lock(ctx)()
+	for id, interview := range r.Store.interviews {
+		if interview.VacancyID == vacancyID {
+			delete(r.Store.interviews, id)
+		}
+	}
+	return nil
+}
+
+func (r *MemoryInterviewRepository) DeleteInterviewsByRecruiterID(ctx context.Context, recruiterID uuid.UUID) error {
+	defer r.Store.lock(ctx)()
+	for id, interview := range r.Store.interviews {
+		if interview.RecruiterID == recruiterID {
+			delete(r.Store.interviews, id)
+		}
+	}
+	return nil
+}
+
+// checkInterviewReferences interviews jadvalidagi user_id, vacancy_id va recruiter_id tashqi kalitlarini takrorlaydi
+func (s *Store) checkInterviewReferences(interview models.Interview) error {
+	_, userOK := s.users[interview.UserID]
+	_, vacancyOK := s.vacancies[interview.VacancyID]
+	_, recruiterOK := s.recruiters[interview.RecruiterID]
+	if !userOK || !vacancyOK || !recruiterOK {
+		return invalidReference()
+	}
+	return nil
+}
+
+// hasExperience foydalanuvchining kamida bitta rezyumesida tajriba minExp dan kam emasligini tekshiradi
+func (s *Store) hasExperience(userID uuid.UUID, minExp int) bool {
+	for _, resume := range s.resumes {
+		if resume.UserID == userID && resume.Experience >= minExp {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) sortedInterviews() []models.Interview {
+	interviews := make([]models.Interview, 0, len(s.interviews))
+	for _, interview := range s.interviews {
+		interviews = append(interviews, interview)
+	}
+	sort.Slice(interviews, func(i, j int) bool {
+		return createdBefore(interviews[i].CreatedAt, interviews[j].CreatedAt, interviews[i].ID, interviews[j].ID)
+	})
+	return interviews
+}
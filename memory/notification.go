@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type MemoryNotificationPreferenceRepository struct {
+	Store *Store
+}
+
+// GetPreference saqlanmagan bo'lsa PostgresNotificationPreferenceRepository dagi standart qiymatni qaytaradi
+func (r *MemoryNotificationPreferenceRepository) GetPreference(ctx context.Context, userID uuid.UUID) (models.NotificationPreference, error) {
+	defer r.Store.lock(ctx)()
+	preference, ok := r.Store.preferences[userID]
+	if !ok {
+		return models.NotificationPreference{
+			UserID:           userID,
+			Locale:           "uz",
+			PreferredChannel: "email",
+			EmailEnabled:     true,
+			MutedKinds:       pq.StringArray{},
+		}, nil
+	}
+	return preference, nil
+}
+
+func (r *MemoryNotificationPreferenceRepository) UpsertPreference(ctx context.Context, preference models.NotificationPreference) (models.NotificationPreference, error) {
+	if preference.MutedKinds == nil {
+		preference.MutedKinds = pq.StringArray{}
+	}
+	preference.UpdatedAt = time.Now()
+
+	defer r.Store.lock(ctx)()
+	if _, ok := r.Store.users[preference.UserID]; !ok {
+		return models.NotificationPreference{}, invalidReference()
+	}
+	r.Store.preferences[preference.UserID] = preference
+	return preference, nil
+}
+
+func (r *MemoryNotificationPreferenceRepository) GetPreferenceByTelegramChatID(ctx context.Context, chatID string) (models.NotificationPreference, error) {
+	defer r.Store.lock(ctx)()
+	for _, preference := range r.Store.preferences {
+		if preference.TelegramChatID == chatID {
+			return preference, nil
+		}
+	}
+	return models.NotificationPreference{}, apperrors.NotFound("telegram_chat_not_linked", "Telegram chat is not linked to any user")
+}
@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/eligibility"
+	"hrplatform/models"
+	"hrplatform/utils"
+
+	"github.com/google/uuid"
+)
+
+type MemoryRecruiterRepository struct {
+	Store *Store
+}
+
+func (r *MemoryRecruiterRepository) CreateRecruiter(ctx context.Context, recruiterCreate models.CreateRecruiter) (models.Recruiter, error) {
+	birthday, err := time.Parse(time.RFC3339, recruiterCreate.Birthday)
+	if err != nil {
+		return models.Recruiter{}, apperrors.Validation("invalid_date", "Invalid birthday format").WithField("birthday", "expected RFC 3339 date")
+	}
+
+	recruiter := models.Recruiter{
+		ID:          uuid.New(),
+		Name:        recruiterCreate.Name,
+		Email:       strings.TrimSpace(recruiterCreate.Email),
+		PhoneNumber: utils.NormalizePhone(recruiterCreate.PhoneNumber),
+		Birthday:    birthday,
+		Gender:      recruiterCreate.Gender,
+		CompanyID:   recruiterCreate.CompanyID,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		DeletedAt:   0,
+		Version:     1,
+	}
+
+	defer r.Store.lock(ctx)()
+	if err := r.Store.checkRecruiterUnique(recruiter); err != nil {
+		return models.Recruiter{}, err
+	}
+	if _, ok := r.Store.companies[recruiter.CompanyID]; !ok {
+		return models.Recruiter{}, invalidReference()
+	}
+	r.Store.recruiters[recruiter.ID] = recruiter
+	return recruiter, nil
+}
+
+func (r *MemoryRecruiterRepository) GetRecruiterByID(ctx context.Context, id string) (models.Recruiter, error) {
+	recruiterID, err := parseID(id)
+	if err != nil {
+		return models.Recruiter{}, err
+	}
+
+	defer r.Store.lock(ctx)()
+	recruiter, ok := r.Store.recruiters[recruiterID]
+	if !ok {
+		return models.Recruiter{}, apperrors.NotFound("recruiter_not_found", "Recruiter not found")
+	}
+	return recruiter, nil
+}
+
+// GetAllRecruiters bo'sh (0, "") parametrlarni filtrlamaydi
+func (r *MemoryRecruiterRepository) GetAllRecruiters(ctx context.Context, age int, gender string, companyID string) ([]models.Recruiter, error) {
+	var companyFilter uuid.UUID
+	if companyID != "" {
+		parsed, err := parseID(strings.TrimSpace(companyID))
+		if err != nil {
+			return nil, err
+		}
+		companyFilter = parsed
+	}
+	now := time.Now()
+
+	defer r.Store.lock(ctx)()
+	var recruiters []models.Recruiter
+	for _, recruiter := range r.Store.sortedRecruiters() {
+		if age > 0 && eligibility.AgeAt(recruiter.Birthday, now) != age {
+			continue
+		}
+		if gender != "" && recruiter.Gender != gender {
+			continue
+		}
+		if companyID != "" && recruiter.CompanyID != companyFilter {
+			continue
+		}
+		recruiters = append(recruiters, recruiter)
+	}
+	return recruiters, nil
+}
+
+func (r *MemoryRecruiterRepository) GetRecruitersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Recruiter, error) {
+	wanted := idSet(ids)
+
+	defer r.Store.lock(ctx)()
+	var recruiters []models.Recruiter
+	for _, recruiter := range r.Store.sortedRecruiters() {
+		if wanted[recruiter.ID] {
+			recruiters = append(recruiters, recruiter)
+		}
+	}
+	return recruiters, nil
+}
+
+func (r *MemoryRecruiterRepository) GetRecruitersByCompanyIDs(ctx context.Context, companyIDs []uuid.UUID) ([]models.Recruiter, error) {
+	wanted := idSet(companyIDs)
+
+	defer r.Store.lock(ctx)()
+	var recruiters []models.Recruiter
+	for _, recruiter := range r.Store.sortedRecruiters() {
+		if wanted[recruiter.CompanyID] {
+			recruiters = append(recruiters, recruiter)
+		}
+	}
+	return recruiters, nil
+}
+
+// UpdateRecruiter faqat versiyasi recruiterUpdate.Version ga teng yozuvni yozadi
+func (r *MemoryRecruiterRepository) UpdateRecruiter(ctx context.Context, recruiterUpdate models.UpdateRecruiter) error {
+	birthday, err := time.Parse(time.RFC3339, recruiterUpdate.Birthday)
+	if err != nil {
+		return apperrors.Validation("invalid_date", "Invalid date format for birthday").WithField("birthday", "expected RFC 3339 date")
+	}
+
+	defer r.Store.lock(ctx)()
+	recruiter, ok := r.Store.recruiters[recruiterUpdate.ID]
+	if !ok || recruiter.Version != recruiterUpdate.Version {
+		return versionMismatch()
+	}
+
+	recruiter.Name = recruiterUpdate.Name
+	recruiter.Email = strings.TrimSpace(recruiterUpdate.Email)
+	recruiter.PhoneNumber = utils.NormalizePhone(recruiterUpdate.PhoneNumber)
+	recruiter.Birthday = birthday
+	recruiter.Gender = recruiterUpdate.Gender
+	recruiter.CompanyID = recruiterUpdate.CompanyID
+	if err := r.Store.checkRecruiterUnique(recruiter); err != nil {
+		return err
+	}
+	if _, ok := r.Store.companies[recruiter.CompanyID]; !ok {
+		return invalidReference()
+	}
+	recruiter.UpdatedAt = time.Now()
+	recruiter.Version++
+	r.Store.recruiters[recruiter.ID] = recruiter
+	return nil
+}
+
+// DeleteRecruiter yozuvni butunlay o'chiradi. Intervyular unga bog'langan bo'lsa
+// Postgres dagi kabi tashqi kalit xatoligi qaytariladi.
+func (r *MemoryRecruiterRepository) DeleteRecruiter(ctx context.Context, id string) error {
+	recruiterID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	defer r.Store.lock(ctx)()
+	for _, interview := range r.Store.interviews {
+		if interview.RecruiterID == recruiterID {
+			return invalidReference()
+		}
+	}
+	delete(r.Store.recruiters, recruiterID)
+	return nil
+}
+
+// checkRecruiterUnique recruiters_email_unique_idx (LOWER(email)) va recruiters_phone_unique_idx
+// indekslarini takrorlaydi. Rekruiterlar butunlay o'chiriladi, shuning uchun barcha yozuvlar hisobga olinadi.
+func (s *Store) checkRecruiterUnique(recruiter models.Recruiter) error {
+	for _, other := range s.recruiters {
+		if other.ID == recruiter.ID {
+			continue
+		}
+		if strings.EqualFold(other.Email, recruiter.Email) {
+			return apperrors.Conflict("recruiter_email_taken", "Recruiter with email '"+recruiter.Email+"' already exists").WithArg("Email", recruiter.Email).WithField("email", "already taken")
+		}
+		if other.PhoneNumber == recruiter.PhoneNumber {
+			return apperrors.Conflict("recruiter_phone_taken", "Recruiter with phone number '"+recruiter.PhoneNumber+"' already exists").WithArg("Phone", recruiter.PhoneNumber).WithField("phone_number", "already taken")
+		}
+	}
+	return nil
+}
+
+func (s *Store) sortedRecruiters() []models.Recruiter {
+	recruiters := make([]models.Recruiter, 0, len(s.recruiters))
+	for _, recruiter := range s.recruiters {
+		recruiters = append(recruiters, recruiter)
+	}
+	sort.Slice(recruiters, func(i, j int) bool {
+		return createdBefore(recruiters[i].CreatedAt, recruiters[j].CreatedAt, recruiters[i].ID, recruiters[j].ID)
+	})
+	return recruiters
+}
@@ -0,0 +1,176 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type MemoryResumeRepository struct {
+	Store *Store
+}
+
+func (r *MemoryResumeRepository) CreateResume(ctx context.Context, resumeCreate models.CreateResume) (models.Resume, error) {
+	resume := models.Resume{
+		ID:          uuid.New(),
+		Position:    resumeCreate.Position,
+		Experience:  resumeCreate.Experience,
+		Description: resumeCreate.Description,
+		Languages:   languages(resumeCreate.Languages),
+		UserID:      resumeCreate.UserID,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		DeletedAt:   0,
+		Version:     1,
+	}
+
+	defer r.Store.lock(ctx)()
+	if _, ok := r.Store.users[resume.UserID]; !ok {
+		return models.Resume{}, invalidReference()
+	}
+	r.Store.resumes[resume.ID] = resume
+	return resume, nil
+}
+
+func (r *MemoryResumeRepository) GetResumeByID(ctx context.Context, id uuid.UUID) (models.Resume, error) {
+	defer r.Store.lock(ctx)()
+	resume, ok := r.Store.resumes[id]
+	if !ok {
+		return models.Resume{}, apperrors.NotFound("resume_not_found", "Resume not found")
+	}
+	return resume, nil
+}
+
+func (r *MemoryResumeRepository) GetResumesByUserID(ctx context.Context, userID uuid.UUID) ([]models.Resume, error) {
+	defer r.Store.lock(ctx)()
+	return r.Store.resumesByUser(userID), nil
+}
+
+func (r *MemoryResumeRepository) GetResumesByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]models.Resume, error) {
+	wanted := idSet(userIDs)
+
+	defer r.Store.lock(ctx)()
+	var resumes []models.Resume
+	for _, resume := range r.Store.sortedResumes() {
+		if wanted[resume.UserID] {
+			resumes = append(resumes, resume)
+		}
+	}
+	return resumes, nil
+}
+
+// GetAllResumes o'chirilmagan rezyumelarni egasining ismi va emaili bilan qaytaradi.
+// "position" katta-kichik harfsiz qism-satr (ILIKE), "min_exp" esa experience >= qiymat.
+func (r *MemoryResumeRepository) GetAllResumes(ctx context.Context, filter map[string]interface{}) ([]models.ResumeWithUser, error) {
+	defer r.Store.lock(ctx)()
+	var resumes []models.ResumeWithUser
+	for _, resume := range r.Store.sortedResumes() {
+		if resume.DeletedAt != 0 {
+			continue
+		}
+		user, ok := r.Store.users[resume.UserID]
+		if !ok {
+			continue
+		}
+		if position, ok := filter["position"].(string); ok && !containsFold(resume.Position, position) {
+			continue
+		}
+		if minExp, ok := filter["min_exp"].(int); ok && resume.Experience < minExp {
+			continue
+		}
+		resumes = append(resumes, models.ResumeWithUser{
+			ID:          resume.ID,
+			Position:    resume.Position,
+			Experience:  resume.Experience,
+			Description: resume.Description,
+			Languages:   resume.Languages,
+			UserID:      resume.UserID,
+			UserName:    user.Name,
+			UserEmail:   user.Email,
+			CreatedAt:   resume.CreatedAt,
+			UpdatedAt:   resume.UpdatedAt,
+			DeletedAt:   resume.DeletedAt,
+			Version:     resume.Version,
+		})
+	}
+	return resumes, nil
+}
+
+// UpdateResume faqat versiyasi resumeUpdate.Version ga teng rezyumeni yozadi
+func (r *MemoryResumeRepository) UpdateResume(ctx context.Context, resumeUpdate models.UpdateResume) error {
+	resumeID, err := parseID(resumeUpdate.ID)
+	if err != nil {
+		return err
+	}
+
+	defer r.Store.lock(ctx)()
+	resume, ok := r.Store.resumes[resumeID]
+	if !ok || resume.Version != resumeUpdate.Version {
+		return versionMismatch()
+	}
+	if _, ok := r.Store.users[resumeUpdate.UserID]; !ok {
+		return invalidReference()
+	}
+
+	resume.Position = resumeUpdate.Position
+	resume.Experience = resumeUpdate.Experience
+	resume.Description = resumeUpdate.Description
+	resume.Languages = languages(resumeUpdate.Languages)
+	resume.UserID = resumeUpdate.UserID
+	resume.UpdatedAt = time.Now()
+	resume.Version++
+	r.Store.resumes[resumeID] = resume
+	return nil
+}
+
+func (r *MemoryResumeRepository) DeleteResume(ctx context.Context, id string) error {
+	resumeID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	defer r.Store.lock(ctx)()
+	delete(r.Store.resumes, resumeID)
+	return nil
+}
+
+func (s *Store) resumesByUser(userID uuid.UUID) []models.Resume {
+	var resumes []models.Resume
+	for _, resume := range s.sortedResumes() {
+		if resume.UserID == userID {
+			resumes = append(resumes, resume)
+		}
+	}
+	return resumes
+}
+
+func (s *Store) sortedResumes() []models.Resume {
+	resumes := make([]models.Resume, 0, len(s.resumes))
+	for _, resume := range s.resumes {
+		resumes = append(resumes, resume)
+	}
+	sort.Slice(resumes, func(i, j int) bool {
+		return createdBefore(resumes[i].CreatedAt, resumes[j].CreatedAt, resumes[i].ID, resumes[j].ID)
+	})
+	return resumes
+}
+
+// languages bazadagi NOT NULL DEFAULT '{}' kabi nil o'rniga bo'sh ro'yxat saqlaydi
+func languages(values []string) pq.StringArray {
+	if values == nil {
+		return pq.StringArray{}
+	}
+	return append(pq.StringArray{}, values...)
+}
+
+// containsFold Postgres dagi ILIKE '%substr%' ning o'xshashi
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
@@ -0,0 +1,207 @@
+// Package memory - postgres paketidagi repository interfeyslarining xotiradagi amalga oshirilishi.
+// Testlar va Postgressiz lokal demo (--storage=memory) uchun. Filtrlar, versiya tekshiruvi,
+// unikal indekslar va tashqi kalitlar Postgres bilan bir xil xatoliklarni qaytaradi;
+// bu contract paketidagi umumiy testlar bilan tekshiriladi.
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+// Store - barcha jadvallar. Repositorylar bitta Store ni bo'lishadi, shuning uchun
+// GetUserInterviews yoki MergeUsers kabi bir nechta jadvalga tegadigan metodlar ishlaydi.
+type Store struct {
+	mu sync.Mutex
+
+	users       map[uuid.UUID]models.User
+	resumes     map[uuid.UUID]models.Resume
+	recruiters  map[uuid.UUID]models.Recruiter
+	companies   map[uuid.UUID]models.Company
+	vacancies   map[uuid.UUID]models.Vacancy
+	interviews  map[uuid.UUID]models.Interview
+	webhooks    map[uuid.UUID]models.Webhook
+	deliveries  []models.WebhookDelivery
+	preferences map[uuid.UUID]models.NotificationPreference
+	audit       []models.AuditEntry
+	idempotency map[idempotencyID]models.IdempotencyKey
+	eligibility map[uuid.UUID]models.EligibilityRule
+}
+
+func NewStore() *Store {
+	return &Store{
+		users:       make(map[uuid.UUID]models.User),
+		resumes:     make(map[uuid.UUID]models.Resume),
+		recruiters:  make(map[uuid.UUID]models.Recruiter),
+		companies:   make(map[uuid.UUID]models.Company),
+		vacancies:   make(map[uuid.UUID]models.Vacancy),
+		interviews:  make(map[uuid.UUID]models.Interview),
+		webhooks:    make(map[uuid.UUID]models.Webhook),
+		preferences: make(map[uuid.UUID]models.NotificationPreference),
+		idempotency: make(map[idempotencyID]models.IdempotencyKey),
+		eligibility: make(map[uuid.UUID]models.EligibilityRule),
+	}
+}
+
+// NewRepositories yangi bo'sh Store ustida barcha repositorylarni yaratadi.
+// Eslatmalar workeri Postgres advisory lockiga tayanadi, shuning uchun Reminders nil.
+func NewRepositories() postgres.Repositories {
+	store := NewStore()
+	return postgres.Repositories{
+		Users:            &MemoryUserRepository{Store: store},
+		Resumes:          &MemoryResumeRepository{Store: store},
+		Recruiters:       &MemoryRecruiterRepository{Store: store},
+		Companies:        &MemoryCompanyRepository{Store: store},
+		Vacancies:        &MemoryVacancyRepository{Store: store},
+		Interviews:       &MemoryInterviewRepository{Store: store},
+		Webhooks:         &MemoryWebhookRepository{Store: store},
+		Preferences:      &MemoryNotificationPreferenceRepository{Store: store},
+		Audit:            &MemoryAuditRepository{Store: store},
+		Idempotency:      &MemoryIdempotencyRepository{Store: store},
+		EligibilityRules: &MemoryEligibilityRuleRepository{Store: store},
+		UnitOfWork:       &MemoryUnitOfWork{Store: store},
+	}
+}
+
+type txKey struct{}
+
+// lock Store ni qulflaydi va qulfni ochadigan funksiyani qaytaradi. ctx shu Store dagi
+// UnitOfWork tranzaksiyasiga tegishli bo'lsa qulf allaqachon olingan, hech narsa qilinmaydi.
+//
+//	defer r.Store.lock(ctx)()
+func (s *Store) lock(ctx context.Context) func() {
+	if ctx.Value(txKey{}) == s {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// MemoryUnitOfWork fn ni Store ning eksklyuziv qulfi ostida bajaradi, fn xatolik qaytarsa
+// barcha jadvallar Do boshidagi holatiga qaytariladi. Bu SERIALIZABLE dan ham qat'iy,
+// shuning uchun qayta urinishlar kerak emas.
+type MemoryUnitOfWork struct {
+	Store *Store
+}
+
+func (u *MemoryUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(txKey{}) == u.Store {
+		return fn(ctx)
+	}
+	if err := ctx.Err(); err != nil {
+		return apperrors.Timeout(err)
+	}
+
+	u.Store.mu.Lock()
+	defer u.Store.mu.Unlock()
+
+	snapshot := u.Store.clone()
+	if err := fn(context.WithValue(ctx, txKey{}, u.Store)); err != nil {
+		u.Store.restore(snapshot)
+		return err
+	}
+	return nil
+}
+
+// clone jadvallarning nusxasini oladi. Yozuvlar qiymat sifatida saqlanadi va joyida
+// o'zgartirilmaydi, shuning uchun sayoz nusxa yetarli.
+func (s *Store) clone() *Store {
+	c := &Store{
+		users:       make(map[uuid.UUID]models.User, len(s.users)),
+		resumes:     make(map[uuid.UUID]models.Resume, len(s.resumes)),
+		recruiters:  make(map[uuid.UUID]models.Recruiter, len(s.recruiters)),
+		companies:   make(map[uuid.UUID]models.Company, len(s.companies)),
+		vacancies:   make(map[uuid.UUID]models.Vacancy, len(s.vacancies)),
+		interviews:  make(map[uuid.UUID]models.Interview, len(s.interviews)),
+		webhooks:    make(map[uuid.UUID]models.Webhook, len(s.webhooks)),
+		deliveries:  append([]models.WebhookDelivery(nil), s.deliveries...),
+		preferences: make(map[uuid.UUID]models.NotificationPreference, len(s.preferences)),
+		audit:       append([]models.AuditEntry(nil), s.audit...),
+		idempotency: make(map[idempotencyID]models.IdempotencyKey, len(s.idempotency)),
+		eligibility: make(map[uuid.UUID]models.EligibilityRule, len(s.eligibility)),
+	}
+	for k, v := range s.users {
+		c.users[k] = v
+	}
+	for k, v := range s.resumes {
+		c.resumes[k] = v
+	}
+	for k, v := range s.recruiters {
+		c.recruiters[k] = v
+	}
+	for k, v := range s.companies {
+		c.companies[k] = v
+	}
+	for k, v := range s.vacancies {
+		c.vacancies[k] = v
+	}
+	for k, v := range s.interviews {
+		c.interviews[k] = v
+	}
+	for k, v := range s.webhooks {
+		c.webhooks[k] = v
+	}
+	for k, v := range s.preferences {
+		c.preferences[k] = v
+	}
+	for k, v := range s.idempotency {
+		c.idempotency[k] = v
+	}
+	for k, v := range s.eligibility {
+		c.eligibility[k] = v
+	}
+	return c
+}
+
+func (s *Store) restore(snapshot *Store) {
+	s.users = snapshot.users
+	s.resumes = snapshot.resumes
+	s.recruiters = snapshot.recruiters
+	s.companies = snapshot.companies
+	s.vacancies = snapshot.vacancies
+	s.interviews = snapshot.interviews
+	s.webhooks = snapshot.webhooks
+	s.deliveries = snapshot.deliveries
+	s.preferences = snapshot.preferences
+	s.audit = snapshot.audit
+	s.idempotency = snapshot.idempotency
+	s.eligibility = snapshot.eligibility
+}
+
+// idSet "= ANY($1)" so'rovlarining o'xshashi uchun ID lar to'plamini quradi
+func idSet(ids []uuid.UUID) map[uuid.UUID]bool {
+	set := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
+// Quyidagi xatoliklar postgres.dbError Postgres kodlaridan hosil qiladigan xatoliklar bilan bir xil
+
+// parseID matnli ID ni o'qiydi. Postgres noto'g'ri UUID uchun 22P02 qaytaradi.
+func parseID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		appErr := apperrors.Validation("invalid_id", "Invalid ID format")
+		appErr.Err = err
+		return uuid.Nil, appErr
+	}
+	return parsed, nil
+}
+
+// invalidReference tashqi kalit buzilganda qaytariladi (23503)
+func invalidReference() error {
+	return apperrors.Validation("invalid_reference", "Referenced record does not exist")
+}
+
+// versionMismatch compare-and-set UPDATE birorta ham qatorni yangilamaganda qaytariladi
+func versionMismatch() error {
+	return apperrors.PreconditionFailed("version_mismatch", "The record was modified by another request")
+}
@@ -0,0 +1,274 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/eligibility"
+	"hrplatform/models"
+	"hrplatform/postgres"
+	"hrplatform/utils"
+
+	"github.com/google/uuid"
+)
+
+type MemoryUserRepository struct {
+	Store *Store
+}
+
+func (r *MemoryUserRepository) CreateUser(ctx context.Context, userCreate models.UserCreate) (models.User, error) {
+	birthday, err := time.Parse("2006-01-02", userCreate.Birthday)
+	if err != nil {
+		return models.User{}, apperrors.Validation("invalid_date", "Invalid date format").WithField("birthday", "expected YYYY-MM-DD")
+	}
+
+	user := models.User{
+		ID:          uuid.New(),
+		Name:        userCreate.Name,
+		Email:       strings.TrimSpace(userCreate.Email),
+		PhoneNumber: utils.NormalizePhone(userCreate.PhoneNumber),
+		Birthday:    birthday,
+		Gender:      userCreate.Gender,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		DeletedAt:   0,
+		Version:     1,
+	}
+
+	defer r.Store.lock(ctx)()
+	if err := r.Store.checkUserUnique(user); err != nil {
+		return models.User{}, err
+	}
+	r.Store.users[user.ID] = user
+	return user, nil
+}
+
+func (r *MemoryUserRepository) GetUserByID(ctx context.Context, id string) (models.User, error) {
+	userID, err := parseID(id)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	defer r.Store.lock(ctx)()
+	user, ok := r.Store.users[userID]
+	if !ok {
+		return models.User{}, apperrors.NotFound("user_not_found", "User not found")
+	}
+	return user, nil
+}
+
+func (r *MemoryUserRepository) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.User, error) {
+	wanted := idSet(ids)
+
+	defer r.Store.lock(ctx)()
+	var users []models.User
+	for _, user := range r.Store.sortedUsers() {
+		if wanted[user.ID] {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (r *MemoryUserRepository) GetUsersByEmails(ctx context.Context, emails []string) ([]models.User, error) {
+	wanted := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		wanted[strings.ToLower(strings.TrimSpace(email))] = true
+	}
+
+	defer r.Store.lock(ctx)()
+	var users []models.User
+	for _, user := range r.Store.sortedUsers() {
+		if user.DeletedAt == 0 && wanted[strings.ToLower(user.Email)] {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// GetAllUsers faol foydalanuvchilarni qaytaradi. "age" (int) va "gender" (string) filtrlari
+// Postgres dagi EXTRACT(YEAR FROM AGE(birthday)) va gender = ... bilan bir xil ishlaydi.
+func (r *MemoryUserRepository) GetAllUsers(ctx context.Context, filters map[string]interface{}) ([]models.User, error) {
+	age, hasAge := filters["age"].(int)
+	gender, hasGender := filters["gender"].(string)
+	now := time.Now()
+
+	defer r.Store.lock(ctx)()
+	var users []models.User
+	for _, user := range r.Store.sortedUsers() {
+		if user.DeletedAt != 0 {
+			continue
+		}
+		if hasAge && eligibility.AgeAt(user.Birthday, now) != age {
+			continue
+		}
+		if hasGender && user.Gender != gender {
+			continue
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// UpdateUser faqat faol va versiyasi userUpdate.Version ga teng foydalanuvchini yozadi
+func (r *MemoryUserRepository) UpdateUser(ctx context.Context, userUpdate models.UserUpdate) error {
+	birthday, err := time.Parse("2006-01-02", userUpdate.Birthday)
+	if err != nil {
+		return apperrors.Validation("invalid_date", "Invalid date format").WithField("birthday", "expected YYYY-MM-DD")
+	}
+
+	defer r.Store.lock(ctx)()
+	user, ok := r.Store.users[userUpdate.ID]
+	if !ok || user.DeletedAt != 0 || user.Version != userUpdate.Version {
+		return versionMismatch()
+	}
+
+	user.Name = userUpdate.Name
+	user.Email = strings.TrimSpace(userUpdate.Email)
+	user.PhoneNumber = utils.NormalizePhone(userUpdate.PhoneNumber)
+	user.Birthday = birthday
+	user.Gender = userUpdate.Gender
+	if err := r.Store.checkUserUnique(user); err != nil {
+		return err
+	}
+	user.UpdatedAt = time.Now()
+	user.Version++
+	r.Store.users[user.ID] = user
+	return nil
+}
+
+// DeleteUser soft delete qiladi. Postgres dagi kabi versiya o'zgarmaydi va yozuv
+// topilmasa xatolik qaytarilmaydi.
+func (r *MemoryUserRepository) DeleteUser(ctx context.Context, id string) error {
+	userID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	defer r.Store.lock(ctx)()
+	if user, ok := r.Store.users[userID]; ok {
+		user.DeletedAt = time.Now().Unix()
+		r.Store.users[userID] = user
+	}
+	return nil
+}
+
+func (r *MemoryUserRepository) GetUserInterviews(ctx context.Context, userID uuid.UUID) ([]models.Interview, error) {
+	defer r.Store.lock(ctx)()
+	var interviews []models.Interview
+	for _, interview := range r.Store.sortedInterviews() {
+		if interview.UserID == userID {
+			interviews = append(interviews, interview)
+		}
+	}
+	return interviews, nil
+}
+
+func (r *MemoryUserRepository) GetUserResume(ctx context.Context, userID uuid.UUID) ([]models.Resume, error) {
+	defer r.Store.lock(ctx)()
+	return r.Store.resumesByUser(userID), nil
+}
+
+func (r *MemoryUserRepository) FindDuplicates(ctx context.Context) ([]models.DuplicateGroup, error) {
+	defer r.Store.lock(ctx)()
+	var users []models.User
+	for _, user := range r.Store.sortedUsers() {
+		if user.DeletedAt == 0 {
+			users = append(users, user)
+		}
+	}
+	return postgres.GroupDuplicates(users), nil
+}
+
+// MergeUsers PostgresUserRepository.MergeUsers bilan bir xil: rezyume, intervyu va bildirishnoma
+// sozlamalari target ga o'tadi, source soft delete qilinadi. Hammasi bitta qulf ostida.
+func (r *MemoryUserRepository) MergeUsers(ctx context.Context, sourceID, targetID uuid.UUID) (models.MergeResult, error) {
+	defer r.Store.lock(ctx)()
+	source, sourceOK := r.Store.users[sourceID]
+	target, targetOK := r.Store.users[targetID]
+	if !sourceOK || !targetOK || source.DeletedAt != 0 || target.DeletedAt != 0 || sourceID == targetID {
+		return models.MergeResult{}, apperrors.NotFound("user_not_found", "User not found")
+	}
+
+	now := time.Now()
+	result := models.MergeResult{MergedUserID: sourceID}
+	for id, resume := range r.Store.resumes {
+		if resume.UserID == sourceID {
+			resume.UserID = targetID
+			resume.UpdatedAt = now
+			resume.Version++
+			r.Store.resumes[id] = resume
+			result.MovedResumes++
+		}
+	}
+	for id, interview := range r.Store.interviews {
+		if interview.UserID == sourceID {
+			interview.UserID = targetID
+			interview.UpdatedAt = now
+			interview.Version++
+			r.Store.interviews[id] = interview
+			result.MovedInterviews++
+		}
+	}
+
+	// target ning o'z sozlamalari bo'lsa ular saqlanadi, aks holda source niki o'tkaziladi
+	if preference, ok := r.Store.preferences[sourceID]; ok {
+		if _, exists := r.Store.preferences[targetID]; !exists {
+			preference.UserID = targetID
+			r.Store.preferences[targetID] = preference
+		}
+		delete(r.Store.preferences, sourceID)
+	}
+
+	source.DeletedAt = now.Unix()
+	source.UpdatedAt = now
+	source.Version++
+	r.Store.users[sourceID] = source
+
+	target.UpdatedAt = now
+	target.Version++
+	r.Store.users[targetID] = target
+
+	result.User = target
+	return result, nil
+}
+
+// checkUserUnique users_email_unique_idx (LOWER(email)) va users_phone_unique_idx indekslarini
+// takrorlaydi. Ikkalasi ham faqat faol foydalanuvchilar orasida.
+func (s *Store) checkUserUnique(user models.User) error {
+	for _, other := range s.users {
+		if other.ID == user.ID || other.DeletedAt != 0 {
+			continue
+		}
+		if strings.EqualFold(other.Email, user.Email) {
+			return apperrors.Conflict("user_email_taken", "User with email '"+user.Email+"' already exists").WithArg("Email", user.Email).WithField("email", "already taken")
+		}
+		if other.PhoneNumber == user.PhoneNumber {
+			return apperrors.Conflict("user_phone_taken", "User with phone number '"+user.PhoneNumber+"' already exists").WithArg("Phone", user.PhoneNumber).WithField("phone_number", "already taken")
+		}
+	}
+	return nil
+}
+
+// sortedUsers foydalanuvchilarni yaratilish tartibida qaytaradi
+func (s *Store) sortedUsers() []models.User {
+	users := make([]models.User, 0, len(s.users))
+	for _, user := range s.users {
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return createdBefore(users[i].CreatedAt, users[j].CreatedAt, users[i].ID, users[j].ID)
+	})
+	return users
+}
+
+// createdBefore yozuvlarni yaratilish vaqti, teng bo'lsa ID bo'yicha tartiblaydi
+func createdBefore(a, b time.Time, aID, bID uuid.UUID) bool {
+	if !a.Equal(b) {
+		return a.Before(b)
+	}
+	return aID.String() < bID.String()
+}
@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+)
+
+type MemoryVacancyRepository struct {
+	Store *Store
+}
+
+func (r *MemoryVacancyRepository) CreateVacancy(ctx context.Context, vacancyCreate models.CreateVacancy) (models.Vacancy, error) {
+	vacancy := models.Vacancy{
+		ID:          uuid.New(),
+		Name:        vacancyCreate.Name,
+		Position:    vacancyCreate.Position,
+		MinExp:      vacancyCreate.MinExp,
+		CompanyID:   vacancyCreate.CompanyID,
+		Description: vacancyCreate.Description,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		DeletedAt:   0,
+		Version:     1,
+	}
+
+	defer r.Store.lock(ctx)()
+	if _, ok := r.Store.companies[vacancy.CompanyID]; !ok {
+		return models.Vacancy{}, invalidReference()
+	}
+	r.Store.vacancies[vacancy.ID] = vacancy
+	return vacancy, nil
+}
+
+func (r *MemoryVacancyRepository) GetVacancyByID(ctx context.Context, id uuid.UUID) (models.Vacancy, error) {
+	defer r.Store.lock(ctx)()
+	vacancy, ok := r.Store.vacancies[id]
+	if !ok {
+		return models.Vacancy{}, apperrors.NotFound("vacancy_not_found", "Vacancy not found")
+	}
+	return vacancy, nil
+}
+
+// GetAllVacancies "position" (ILIKE), "min_exp" (min_exp >= qiymat) va "company_id" bo'yicha filtrlaydi
+func (r *MemoryVacancyRepository) GetAllVacancies(ctx context.Context, filter map[string]interface{}) ([]models.Vacancy, error) {
+	defer r.Store.lock(ctx)()
+	var vacancies []models.Vacancy
+	for _, vacancy := range r.Store.sortedVacancies() {
+		if position, ok := filter["position"].(string); ok && !containsFold(vacancy.Position, position) {
+			continue
+		}
+		if minExp, ok := filter["min_exp"].(int); ok && vacancy.MinExp < minExp {
+			continue
+		}
+		if companyID, ok := filter["company_id"].(uuid.UUID); ok && vacancy.CompanyID != companyID {
+			continue
+		}
+		vacancies = append(vacancies, vacancy)
+	}
+	return vacancies, nil
+}
+
+func (r *MemoryVacancyRepository) GetVacanciesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Vacancy, error) {
+	wanted := idSet(ids)
+
+	defer r.Store.lock(ctx)()
+	var vacancies []models.Vacancy
+	for _, vacancy := range r.Store.sortedVacancies() {
+		if wanted[vacancy.ID] {
+			vacancies = append(vacancies, vacancy)
+		}
+	}
+	return vacancies, nil
+}
+
+func (r *MemoryVacancyRepository) GetVacanciesByCompanyIDs(ctx context.Context, companyIDs []uuid.UUID) ([]models.Vacancy, error) {
+	wanted := idSet(companyIDs)
+
+	defer r.Store.lock(ctx)()
+	var vacancies []models.Vacancy
+	for _, vacancy := range r.Store.sortedVacancies() {
+		if wanted[vacancy.CompanyID] {
+			vacancies = append(vacancies, vacancy)
+		}
+	}
+	return vacancies, nil
+}
+
+// UpdateVacancy faqat versiyasi vacancyUpdate.Version ga teng vakansiyani yozadi
+func (r *MemoryVacancyRepository) UpdateVacancy(ctx context.Context, vacancyUpdate models.UpdateVacancy) error {
+	defer r.Store.lock(ctx)()
+	vacancy, ok := r.Store.vacancies[vacancyUpdate.ID]
+	if !ok || vacancy.Version != vacancyUpdate.Version {
+		return versionMismatch()
+	}
+	if _, ok := r.Store.companies[vacancyUpdate.CompanyID]; !ok {
+		return invalidReference()
+	}
+
+	vacancy.Name = vacancyUpdate.Name
+	vacancy.Position = vacancyUpdate.Position
+	vacancy.MinExp = vacancyUpdate.MinExp
+	vacancy.CompanyID = vacancyUpdate.CompanyID
+	vacancy.Description = vacancyUpdate.Description
+	vacancy.UpdatedAt = time.Now()
+	vacancy.Version++
+	r.Store.vacancies[vacancy.ID] = vacancy
+	return nil
+}
+
+// DeleteVacancy vakansiyani butunlay o'chiradi. Intervyular bog'langan bo'lsa tashqi kalit
+// xatoligi qaytariladi, moslik qoidalari esa ON DELETE CASCADE kabi birga o'chadi.
+func (r *MemoryVacancyRepository) DeleteVacancy(ctx context.Context, id uuid.UUID) error {
+	defer r.Store.lock(ctx)()
+	for _, interview := range r.Store.interviews {
+		if interview.VacancyID == id {
+			return invalidReference()
+		}
+	}
+	for ruleID, rule := range r.Store.eligibility {
+		if rule.VacancyID.Valid && rule.VacancyID.UUID == id {
+			delete(r.Store.eligibility, ruleID)
+		}
+	}
+	delete(r.Store.vacancies, id)
+	return nil
+}
+
+func (s *Store) sortedVacancies() []models.Vacancy {
+	vacancies := make([]models.Vacancy, 0, len(s.vacancies))
+	for _, vacancy := range s.vacancies {
+		vacancies = append(vacancies, vacancy)
+	}
+	sort.Slice(vacancies, func(i, j int) bool {
+		return createdBefore(vacancies[i].CreatedAt, vacancies[j].CreatedAt, vacancies[i].ID, vacancies[j].ID)
+	})
+	return vacancies
+}
@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type MemoryWebhookRepository struct {
+	Store *Store
+}
+
+func (r *MemoryWebhookRepository) CreateWebhook(ctx context.Context, webhookCreate models.CreateWebhook) (models.Webhook, error) {
+	webhook := models.Webhook{
+		ID:        uuid.New(),
+		CompanyID: webhookCreate.CompanyID,
+		URL:       webhookCreate.URL,
+		Secret:    webhookCreate.Secret,
+		Events:    append(pq.StringArray{}, webhookCreate.Events...),
+		Active:    true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		DeletedAt: 0,
+		Version:   1,
+	}
+
+	defer r.Store.lock(ctx)()
+	if _, ok := r.Store.companies[webhook.CompanyID]; !ok {
+		return models.Webhook{}, invalidReference()
+	}
+	r.Store.webhooks[webhook.ID] = webhook
+	return webhook, nil
+}
+
+func (r *MemoryWebhookRepository) GetWebhookByID(ctx context.Context, id uuid.UUID) (models.Webhook, error) {
+	defer r.Store.lock(ctx)()
+	webhook, ok := r.Store.webhooks[id]
+	if !ok || webhook.DeletedAt != 0 {
+		return models.Webhook{}, apperrors.NotFound("webhook_not_found", "Webhook not found")
+	}
+	return webhook, nil
+}
+
+func (r *MemoryWebhookRepository) GetAllWebhooks(ctx context.Context, companyID string) ([]models.Webhook, error) {
+	var companyFilter uuid.UUID
+	if companyID != "" {
+		parsed, err := parseID(companyID)
+		if err != nil {
+			return nil, err
+		}
+		companyFilter = parsed
+	}
+
+	defer r.Store.lock(ctx)()
+	webhooks := []models.Webhook{}
+	for _, webhook := range r.Store.sortedWebhooks() {
+		if webhook.DeletedAt != 0 || (companyID != "" && webhook.CompanyID != companyFilter) {
+			continue
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, nil
+}
+
+func (r *MemoryWebhookRepository) GetSubscribedWebhooks(ctx context.Context, companyID uuid.UUID, event string) ([]models.Webhook, error) {
+	defer r.Store.lock(ctx)()
+	var webhooks []models.Webhook
+	for _, webhook := range r.Store.sortedWebhooks() {
+		if webhook.CompanyID != companyID || !webhook.Active || webhook.DeletedAt != 0 {
+			continue
+		}
+		for _, subscribed := range webhook.Events {
+			if subscribed == event {
+				webhooks = append(webhooks, webhook)
+				break
+			}
+		}
+	}
+	return webhooks, nil
+}
+
+func (r *MemoryWebhookRepository) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	defer r.Store.lock(ctx)()
+	if webhook, ok := r.Store.webhooks[id]; ok {
+		webhook.Active = false
+		webhook.DeletedAt = time.Now().Unix()
+		r.Store.webhooks[id] = webhook
+	}
+	return nil
+}
+
+func (r *MemoryWebhookRepository) DeleteWebhooksByCompanyID(ctx context.Context, companyID uuid.UUID) error {
+	defer r.Store.lock(ctx)()
+	for id, webhook := range r.Store.webhooks {
+		if webhook.CompanyID == companyID && webhook.DeletedAt == 0 {
+			webhook.Active = false
+			webhook.DeletedAt = time.Now().Unix()
+			r.Store.webhooks[id] = webhook
+		}
+	}
+	return nil
+}
+
+func (r *MemoryWebhookRepository) CreateDelivery(ctx context.Context, delivery models.WebhookDelivery) error {
+	defer r.Store.lock(ctx)()
+	if _, ok := r.Store.webhooks[delivery.WebhookID]; !ok {
+		return invalidReference()
+	}
+	r.Store.deliveries = append(r.Store.deliveries, delivery)
+	return nil
+}
+
+// GetDeliveries urinishlarni eng yangisidan boshlab qaytaradi
+func (r *MemoryWebhookRepository) GetDeliveries(ctx context.Context, webhookID uuid.UUID) ([]models.WebhookDelivery, error) {
+	defer r.Store.lock(ctx)()
+	deliveries := []models.WebhookDelivery{}
+	for i := len(r.Store.deliveries) - 1; i >= 0; i-- {
+		if r.Store.deliveries[i].WebhookID == webhookID {
+			deliveries = append(deliveries, r.Store.deliveries[i])
+		}
+	}
+	return deliveries, nil
+}
+
+func (s *Store) sortedWebhooks() []models.Webhook {
+	webhooks := make([]models.Webhook, 0, len(s.webhooks))
+	for _, webhook := range s.webhooks {
+		webhooks = append(webhooks, webhook)
+	}
+	sort.Slice(webhooks, func(i, j int) bool {
+		return createdBefore(webhooks[i].CreatedAt, webhooks[j].CreatedAt, webhooks[i].ID, webhooks[j].ID)
+	})
+	return webhooks
+}
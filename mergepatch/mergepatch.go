@@ -0,0 +1,53 @@
+// Package mergepatch JSON Merge Patch (RFC 7396) ni amalga oshiradi.
+package mergepatch
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ContentType - merge patch so'rovlari uchun media turi
+const ContentType = "application/merge-patch+json"
+
+// ErrInvalidPatch patch JSON sifatida o'qilmaganda qaytariladi
+var ErrInvalidPatch = errors.New("mergepatch: patch is not valid JSON")
+
+// Apply patchni doc ustiga qo'llaydi (RFC 7396, 2-bo'lim):
+//   - patch obyekt bo'lmasa natija patchning o'zi bo'ladi;
+//   - obyekt ichidagi null kalitni o'chiradi;
+//   - obyekt qiymatlar rekursiv birlashtiriladi, qolganlari almashtiriladi.
+func Apply(doc, patch []byte) ([]byte, error) {
+	var patchValue interface{}
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return nil, ErrInvalidPatch
+	}
+
+	var docValue interface{}
+	if len(doc) > 0 {
+		if err := json.Unmarshal(doc, &docValue); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(merge(docValue, patchValue))
+}
+
+func merge(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = merge(targetObject[key], value)
+	}
+	return targetObject
+}
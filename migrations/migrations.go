@@ -0,0 +1,20 @@
+// Package migrations - bazaning to'liq sxemasi. query.sql bo'sh bazaga bir marta qo'llanadi;
+// mavjud bazalar uchun ALTER buyruqlari fayl oxirida izoh sifatida turadi.
+package migrations
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/jmoiron/sqlx"
+)
+
+//go:embed query.sql
+var Schema string
+
+// Apply sxemani bo'sh bazaga qo'llaydi. Argumentsiz Exec oddiy so'rov protokolida ketadi,
+// shuning uchun fayldagi barcha buyruqlar (funksiya va triggerlar ham) bitta chaqiriqda bajariladi.
+func Apply(ctx context.Context, db *sqlx.DB) error {
+	_, err := db.ExecContext(ctx, Schema)
+	return err
+}
@@ -8,7 +8,8 @@ CREATE TABLE users (
     gender VARCHAR(1) CHECK (gender IN ('m', 'f')), -- ENUM o'rniga CHECK constraint
     created_at TIMESTAMP DEFAULT NOW(),
     updated_at TIMESTAMP DEFAULT NOW(),
-    deleted_at BIGINT DEFAULT 0
+    deleted_at BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1 -- optimistik blokirovka (ETag / If-Match)
 );
 
 -- nega check constraint bo'ldi? 
@@ -27,10 +28,12 @@ CREATE TABLE resumes (
     position VARCHAR,
     experience INT,
     description TEXT,
+    languages TEXT[] NOT NULL DEFAULT '{}', -- nomzod biladigan tillar (eligibility qoidalari uchun)
     user_id UUID REFERENCES users(id)
     created_at TIMESTAMP DEFAULT NOW(),
     updated_at TIMESTAMP DEFAULT NOW(),
-    deleted_at BIGINT DEFAULT 0
+    deleted_at BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1 -- optimistik blokirovka (ETag / If-Match)
 );
 
 -- Kompaniyalar jadvali
@@ -41,7 +44,8 @@ CREATE TABLE companies (
     workers INT
     created_at TIMESTAMP DEFAULT NOW(),
     updated_at TIMESTAMP DEFAULT NOW(),
-    deleted_at BIGINT DEFAULT 0
+    deleted_at BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1 -- optimistik blokirovka (ETag / If-Match)
 );
 
 -- Rekruiterlar jadvali
@@ -55,7 +59,8 @@ CREATE TABLE recruiters (
     company_id UUID REFERENCES companies(id),
     created_at TIMESTAMP DEFAULT NOW(),
     updated_at TIMESTAMP DEFAULT NOW(),
-    deleted_at BIGINT DEFAULT 0
+    deleted_at BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1 -- optimistik blokirovka (ETag / If-Match)
 );
 
 -- Vakansiyalar jadvali
@@ -68,7 +73,8 @@ CREATE TABLE vacancies (
     description TEXT,
     created_at TIMESTAMP DEFAULT NOW(),
     updated_at TIMESTAMP DEFAULT NOW(),
-    deleted_at BIGINT DEFAULT 0
+    deleted_at BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1 -- optimistik blokirovka (ETag / If-Match)
 );
 
 -- Intervyular jadvali
@@ -80,10 +86,135 @@ CREATE TABLE interviews (
     interview_date TIMESTAMP,
     created_at TIMESTAMP DEFAULT NOW(),
     updated_at TIMESTAMP DEFAULT NOW(),
-    deleted_at BIGINT DEFAULT 0
+    deleted_at BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1 -- optimistik blokirovka (ETag / If-Match)
 );
 
 
+-- Webhooklar jadvali
+CREATE TABLE webhooks (
+    id UUID PRIMARY KEY,
+    company_id UUID REFERENCES companies(id),
+    url VARCHAR NOT NULL,
+    secret VARCHAR NOT NULL,
+    events TEXT[] NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at BIGINT DEFAULT 0,
+    version BIGINT NOT NULL DEFAULT 1 -- optimistik blokirovka (ETag / If-Match)
+);
+
+-- Webhook yuborish urinishlari jadvali (har bir urinish alohida yoziladi)
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY,
+    webhook_id UUID REFERENCES webhooks(id),
+    event_id UUID NOT NULL,
+    event VARCHAR NOT NULL,
+    payload TEXT NOT NULL,
+    attempt INT NOT NULL,
+    status_code INT NOT NULL DEFAULT 0,
+    response_body TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    success BOOLEAN NOT NULL DEFAULT FALSE,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id, created_at DESC);
+
+
+-- Bildirishnoma sozlamalari jadvali
+CREATE TABLE notification_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id),
+    locale VARCHAR(2) NOT NULL DEFAULT 'uz',
+    preferred_channel VARCHAR NOT NULL DEFAULT 'email' CHECK (preferred_channel IN ('email', 'sms', 'telegram')),
+    email_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    sms_enabled BOOLEAN NOT NULL DEFAULT FALSE, -- SMS va Telegram faqat opt-in orqali yoqiladi
+    telegram_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    telegram_chat_id VARCHAR NOT NULL DEFAULT '',
+    muted_kinds TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+
+-- Yuborilgan intervyu eslatmalari (qayta ishga tushganda takrorlanmasligi uchun)
+CREATE TABLE interview_reminders (
+    interview_id UUID REFERENCES interviews(id) ON DELETE CASCADE,
+    reminder VARCHAR NOT NULL, -- '24h', '1h'
+    sent_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (interview_id, reminder)
+);
+
+
+-- Audit jurnali: faqat qo'shish mumkin, UPDATE va DELETE trigger orqali taqiqlangan
+CREATE TABLE audit_log (
+    id UUID PRIMARY KEY,
+    actor VARCHAR NOT NULL,
+    entity_type VARCHAR NOT NULL,
+    entity_id VARCHAR NOT NULL,
+    action VARCHAR NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    before JSONB,
+    after JSONB,
+    diff JSONB,
+    request_id VARCHAR NOT NULL DEFAULT '',
+    ip VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX audit_log_entity_idx ON audit_log (entity_type, entity_id, created_at DESC);
+
+CREATE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update_delete
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+
+-- Idempotency-Key bilan kelgan POST so'rovlar va ularning javoblari
+CREATE TABLE idempotency_keys (
+    key VARCHAR(255) NOT NULL,
+    method VARCHAR NOT NULL,
+    path VARCHAR NOT NULL,
+    request_hash VARCHAR NOT NULL,
+    status_code INT NOT NULL DEFAULT 0, -- 0: so'rov hali bajarilmoqda
+    content_type VARCHAR NOT NULL DEFAULT '',
+    response_body BYTEA NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (key, method, path)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+-- Email katta-kichik harfdan qat'i nazar, telefon esa normallashtirilgan ko'rinishda (+998XXXXXXXXX) unikal.
+-- Foydalanuvchilar soft delete qilinadi, shuning uchun faqat faol yozuvlar hisobga olinadi.
+-- Mavjud bazada indekslarni yaratishdan oldin GET /users/duplicates orqali dublikatlarni birlashtiring.
+CREATE UNIQUE INDEX users_email_unique_idx ON users (LOWER(email)) WHERE deleted_at = 0;
+CREATE UNIQUE INDEX users_phone_unique_idx ON users (phone_number) WHERE deleted_at = 0;
+CREATE UNIQUE INDEX recruiters_email_unique_idx ON recruiters (LOWER(email));
+CREATE UNIQUE INDEX recruiters_phone_unique_idx ON recruiters (phone_number);
+
+-- Nomzodning vakansiyaga mosligi qoidalari (kompaniya yoki vakansiya darajasida).
+-- Qoida saqlanmagan bo'lsa standart qoidalar ishlaydi: 18+ yosh va lavozimning aniq mosligi.
+CREATE TABLE eligibility_rules (
+    id UUID PRIMARY KEY,
+    company_id UUID REFERENCES companies(id),
+    vacancy_id UUID REFERENCES vacancies(id) ON DELETE CASCADE,
+    type VARCHAR NOT NULL CHECK (type IN ('min_age', 'max_age', 'min_experience', 'position_match', 'required_languages')),
+    params JSONB NOT NULL DEFAULT '{}',
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK ((company_id IS NULL) <> (vacancy_id IS NULL))
+);
+
+CREATE UNIQUE INDEX eligibility_rules_company_type_idx ON eligibility_rules (company_id, type) WHERE company_id IS NOT NULL;
+CREATE UNIQUE INDEX eligibility_rules_vacancy_type_idx ON eligibility_rules (vacancy_id, type) WHERE vacancy_id IS NOT NULL;
+
 -- ALTER TABLE resumes
 -- ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT now();
 
@@ -101,3 +232,14 @@ CREATE TABLE interviews (
 
 -- ALTER TABLE companies
 -- ADD COLUMN deleted_at BIGINT NOT NULL DEFAULT 0;
+
+-- Mavjud bazalar uchun: optimistik blokirovka versiyasi
+-- ALTER TABLE users ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- ALTER TABLE resumes ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- ALTER TABLE companies ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- ALTER TABLE recruiters ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- ALTER TABLE vacancies ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- ALTER TABLE interviews ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- ALTER TABLE webhooks ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- ALTER TABLE resumes ADD COLUMN languages TEXT[] NOT NULL DEFAULT '{}';
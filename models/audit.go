@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx/types"
+)
+
+// AuditEntry - o'zgartirish haqida yozuv. Jadval faqat qo'shish uchun (append-only)
+type AuditEntry struct {
+	ID         uuid.UUID      `db:"id" json:"id"`
+	Actor      string         `db:"actor" json:"actor"`
+	EntityType string         `db:"entity_type" json:"entity_type"`
+	EntityID   string         `db:"entity_id" json:"entity_id"`
+	Action     string         `db:"action" json:"action"`
+	Before     types.JSONText `db:"before" json:"before"`
+	After      types.JSONText `db:"after" json:"after"`
+	Diff       types.JSONText `db:"diff" json:"diff"`
+	RequestID  string         `db:"request_id" json:"request_id"`
+	IP         string         `db:"ip" json:"ip"`
+	CreatedAt  time.Time      `db:"created_at" json:"created_at"`
+}
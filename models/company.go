@@ -14,15 +14,28 @@ type Company struct {
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 	DeletedAt int64     `db:"deleted_at" json:"deleted_at"`
+	Version   int64     `db:"version" json:"version"`
 }
 type CreateCompany struct {
-	Name      string    `db:"name" json:"name"`
-	Location  string    `db:"location" json:"location"`
-	Workers   int       `db:"workers" json:"workers"`
+	Name      string    `db:"name" json:"name" binding:"required,max=200"`
+	Location  string    `db:"location" json:"location" binding:"max=200"`
+	Workers   int       `db:"workers" json:"workers" binding:"min=0"`
 }
+// UpdateCompany - PUT uchun to'liq almashtirish, PATCH esa ToUpdate natijasi ustiga quriladi
 type UpdateCompany struct {
 	ID        uuid.UUID `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
-	Location  string    `db:"location" json:"location"`
-	Workers   int       `db:"workers" json:"workers"`
+	Name      string    `db:"name" json:"name" binding:"required,max=200"`
+	Location  string    `db:"location" json:"location" binding:"max=200"`
+	Workers   int       `db:"workers" json:"workers" binding:"min=0"`
+	Version   int64     `db:"version" json:"-"` // If-Match dan olingan kutilgan versiya
+}
+
+// ToUpdate kompaniyaning joriy holatini UpdateCompany ko'rinishida qaytaradi
+func (c Company) ToUpdate() UpdateCompany {
+	return UpdateCompany{
+		ID:       c.ID,
+		Name:     c.Name,
+		Location: c.Location,
+		Workers:  c.Workers,
+	}
 }
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx/types"
+)
+
+// EligibilityRule - kompaniya yoki vakansiya uchun saqlangan nomzodga qo'yiladigan qoida.
+// CompanyID va VacancyID dan aynan bittasi to'ldirilgan bo'ladi.
+type EligibilityRule struct {
+	ID        uuid.UUID      `db:"id" json:"id"`
+	CompanyID uuid.NullUUID  `db:"company_id" json:"company_id"`
+	VacancyID uuid.NullUUID  `db:"vacancy_id" json:"vacancy_id"`
+	Type      string         `db:"type" json:"type"`
+	Params    types.JSONText `db:"params" json:"params"`
+	Position  int            `db:"position" json:"position"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// IdempotencyKey - Idempotency-Key bilan kelgan POST so'rov va unga berilgan javob.
+// StatusCode 0 bo'lsa so'rov hali bajarilmoqda.
+type IdempotencyKey struct {
+	Key          string    `db:"key" json:"key"`
+	Method       string    `db:"method" json:"method"`
+	Path         string    `db:"path" json:"path"`
+	RequestHash  string    `db:"request_hash" json:"request_hash"`
+	StatusCode   int       `db:"status_code" json:"status_code"`
+	ContentType  string    `db:"content_type" json:"content_type"`
+	ResponseBody []byte    `db:"response_body" json:"-"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+	ExpiresAt    time.Time `db:"expires_at" json:"expires_at"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"hrplatform/apperrors"
+
+	"github.com/google/uuid"
+)
+
+// ImportReport - CSV/XLSX faylidan ommaviy import natijasi. Qator raqamlari fayldagi
+// kabi: sarlavha 1-qator, birinchi ma'lumot qatori 2-qator.
+type ImportReport struct {
+	Entity     string           `json:"entity"`
+	Total      int              `json:"total"`       // bo'sh bo'lmagan ma'lumot qatorlari
+	Created    int              `json:"created"`     // yozilgan qatorlar
+	Failed     int              `json:"failed"`      // xatolik sababli yozilmagan qatorlar
+	Batches    int              `json:"batches"`     // muvaffaqiyatli tranzaksiyalar soni
+	RolledBack bool             `json:"rolled_back"` // all_or_nothing: xatolik tufayli hech narsa yozilmadi
+	Records    []ImportedRecord `json:"records"`
+	Errors     []ImportRowError `json:"errors"`
+}
+
+// ImportedRecord - yaratilgan yozuv va u olingan qator
+type ImportedRecord struct {
+	Row int       `json:"row"`
+	ID  uuid.UUID `json:"id"`
+}
+
+// ImportRowError - bitta qatordagi xatolik, Details maydonlar bo'yicha (so'rov tilida)
+type ImportRowError struct {
+	Row     int                    `json:"row"`
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Details []apperrors.FieldError `json:"details,omitempty"`
+}
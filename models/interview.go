@@ -15,19 +15,33 @@ type Interview struct {
 	CreatedAt     time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
 	DeletedAt     int64     `db:"deleted_at" json:"deleted_at"`
+	Version       int64     `db:"version" json:"version"`
 }
 
 type CreateInterview struct {
-	UserID        uuid.UUID `db:"user_id" json:"user_id"`
-	VacancyID     uuid.UUID `db:"vacancy_id" json:"vacancy_id"`
-	RecruiterID   uuid.UUID `db:"recruiter_id" json:"recruiter_id"`
-	InterviewDate string    `db:"interview_date" json:"interview_date"`
+	UserID        uuid.UUID `db:"user_id" json:"user_id" binding:"required"`
+	VacancyID     uuid.UUID `db:"vacancy_id" json:"vacancy_id" binding:"required"`
+	RecruiterID   uuid.UUID `db:"recruiter_id" json:"recruiter_id" binding:"required"`
+	InterviewDate string    `db:"interview_date" json:"interview_date" binding:"required,datetime=2006-01-02 15:04:05"`
 }
 
+// UpdateInterview - PUT uchun to'liq almashtirish, PATCH esa ToUpdate natijasi ustiga quriladi
 type UpdateInterview struct {
 	ID            uuid.UUID `db:"id" json:"id"`
-	UserID        uuid.UUID `db:"user_id" json:"user_id"`
-	VacancyID     uuid.UUID `db:"vacancy_id" json:"vacancy_id"`
-	RecruiterID   uuid.UUID `db:"recruiter_id" json:"recruiter_id"`
-	InterviewDate string    `db:"interview_date" json:"interview_date"`
+	UserID        uuid.UUID `db:"user_id" json:"user_id" binding:"required"`
+	VacancyID     uuid.UUID `db:"vacancy_id" json:"vacancy_id" binding:"required"`
+	RecruiterID   uuid.UUID `db:"recruiter_id" json:"recruiter_id" binding:"required"`
+	InterviewDate string    `db:"interview_date" json:"interview_date" binding:"required,datetime=2006-01-02 15:04:05"`
+	Version       int64     `db:"version" json:"-"` // If-Match dan olingan kutilgan versiya
+}
+
+// ToUpdate intervyuning joriy holatini UpdateInterview ko'rinishida qaytaradi
+func (i Interview) ToUpdate() UpdateInterview {
+	return UpdateInterview{
+		ID:            i.ID,
+		UserID:        i.UserID,
+		VacancyID:     i.VacancyID,
+		RecruiterID:   i.RecruiterID,
+		InterviewDate: i.InterviewDate.Format("2006-01-02 15:04:05"),
+	}
 }
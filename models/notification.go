@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// NotificationPreference - foydalanuvchining bildirishnoma sozlamalari
+type NotificationPreference struct {
+	UserID           uuid.UUID      `db:"user_id" json:"user_id"`
+	Locale           string         `db:"locale" json:"locale"`
+	PreferredChannel string         `db:"preferred_channel" json:"preferred_channel"`
+	EmailEnabled     bool           `db:"email_enabled" json:"email_enabled"`
+	SMSEnabled       bool           `db:"sms_enabled" json:"sms_enabled"`
+	TelegramEnabled  bool           `db:"telegram_enabled" json:"telegram_enabled"`
+	TelegramChatID   string         `db:"telegram_chat_id" json:"telegram_chat_id"`
+	MutedKinds       pq.StringArray `db:"muted_kinds" json:"muted_kinds"`
+	UpdatedAt        time.Time      `db:"updated_at" json:"updated_at"`
+}
+
+type UpdateNotificationPreference struct {
+	Locale           *string  `json:"locale" binding:"omitempty,oneof=uz ru en"`
+	PreferredChannel *string  `json:"preferred_channel"`
+	EmailEnabled     *bool    `json:"email_enabled"`
+	MutedKinds       []string `json:"muted_kinds"`
+}
+
+// ChannelOptIn - kanalga obuna bo'lish yoki obunani bekor qilish so'rovi
+type ChannelOptIn struct {
+	Channel string `json:"channel" binding:"required"`
+}
@@ -17,6 +17,7 @@ type Recruiter struct {
 	CreatedAt   time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
 	DeletedAt   int64     `db:"deleted_at" json:"deleted_at"`
+	Version     int64     `db:"version" json:"version"`
 }
 
 type GetRecruiter struct {
@@ -30,23 +31,39 @@ type GetRecruiter struct {
 	CreatedAt   time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
 	DeletedAt   int64     `db:"deleted_at" json:"deleted_at"`
+	Version     int64     `db:"version" json:"version"`
 }
 
 type CreateRecruiter struct {
-	Name        string    `json:"name"`
-	Email       string    `json:"email"`
-	PhoneNumber string    `json:"phone_number"`
-	Birthday    string    `json:"birthday"` // Change to string to match the JSON input
-	Gender      string    `json:"gender"`
-	CompanyID   uuid.UUID `json:"company_id"`
+	Name        string    `json:"name" binding:"required,min=2,max=100"`
+	Email       string    `json:"email" binding:"required,email,max=254"`
+	PhoneNumber string    `json:"phone_number" binding:"required,phone"`
+	Birthday    string    `json:"birthday" binding:"required,pastdate"` // Change to string to match the JSON input
+	Gender      string    `json:"gender" binding:"required,gender"`
+	CompanyID   uuid.UUID `json:"company_id" binding:"required"`
 }
 
+// UpdateRecruiter - PUT uchun to'liq almashtirish, PATCH esa ToUpdate natijasi ustiga quriladi
 type UpdateRecruiter struct {
-	ID          uuid.UUID  `db:"id" json:"id"`
-	Name        *string    `db:"name" json:"name"`
-	Email       *string    `db:"email" json:"email"`
-	PhoneNumber *string    `db:"phone_number" json:"phone_number"`
-	Birthday    *string    `json:"birthday"` 
-	Gender      *string    `json:"gender"`
-	CompanyID   *uuid.UUID `json:"company_id"`
+	ID          uuid.UUID `db:"id" json:"id"`
+	Name        string    `db:"name" json:"name" binding:"required,min=2,max=100"`
+	Email       string    `db:"email" json:"email" binding:"required,email,max=254"`
+	PhoneNumber string    `db:"phone_number" json:"phone_number" binding:"required,phone"`
+	Birthday    string    `db:"birthday" json:"birthday" binding:"required,pastdate"`
+	Gender      string    `db:"gender" json:"gender" binding:"required,gender"`
+	CompanyID   uuid.UUID `db:"company_id" json:"company_id" binding:"required"`
+	Version     int64     `db:"version" json:"-"` // If-Match dan olingan kutilgan versiya
+}
+
+// ToUpdate yollanma xodimning joriy holatini UpdateRecruiter ko'rinishida qaytaradi
+func (r Recruiter) ToUpdate() UpdateRecruiter {
+	return UpdateRecruiter{
+		ID:          r.ID,
+		Name:        r.Name,
+		Email:       r.Email,
+		PhoneNumber: r.PhoneNumber,
+		Birthday:    r.Birthday.Format("2006-01-02"),
+		Gender:      r.Gender,
+		CompanyID:   r.CompanyID,
+	}
 }
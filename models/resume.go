@@ -4,43 +4,64 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Resume struct {
-	ID          uuid.UUID `db:"id" json:"id"`
-	Position    string    `db:"position" json:"position"`
-	Experience  int       `db:"experience" json:"experience"`
-	Description string    `db:"description" json:"description"`
-	UserID      uuid.UUID `db:"user_id" json:"user_id"`
-	CreatedAt   time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
-	DeletedAt   int64     `db:"deleted_at" json:"deleted_at"`
+	ID          uuid.UUID      `db:"id" json:"id"`
+	Position    string         `db:"position" json:"position"`
+	Experience  int            `db:"experience" json:"experience"`
+	Description string         `db:"description" json:"description"`
+	Languages   pq.StringArray `db:"languages" json:"languages"` // nomzod biladigan tillar, masalan ["uz", "en"]
+	UserID      uuid.UUID      `db:"user_id" json:"user_id"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
+	DeletedAt   int64          `db:"deleted_at" json:"deleted_at"`
+	Version     int64          `db:"version" json:"version"`
 }
 
 type CreateResume struct {
-	Position    string    `db:"position" json:"position"`
-	Experience  int       `db:"experience" json:"experience"`
-	Description string    `db:"description" json:"description"`
-	UserID      uuid.UUID `db:"user_id" json:"user_id"`
+	Position    string    `db:"position" json:"position" binding:"required,max=100"`
+	Experience  int       `db:"experience" json:"experience" binding:"min=0,max=60"`
+	Description string    `db:"description" json:"description" binding:"max=5000"`
+	Languages   []string  `db:"languages" json:"languages" binding:"max=20,dive,min=2,max=35"`
+	UserID      uuid.UUID `db:"user_id" json:"user_id" binding:"required"`
 }
 
+// UpdateResume - PUT uchun to'liq almashtirish, PATCH esa ToUpdate natijasi ustiga quriladi
 type UpdateResume struct {
 	ID          string    `db:"id" json:"id"`
-	Position    string    `db:"position" json:"position"`
-	Experience  int       `db:"experience" json:"experience"`
-	Description string    `db:"description" json:"description"`
-	UserID      uuid.UUID `db:"user_id" json:"user_id"`
+	Position    string    `db:"position" json:"position" binding:"required,max=100"`
+	Experience  int       `db:"experience" json:"experience" binding:"min=0,max=60"`
+	Description string    `db:"description" json:"description" binding:"max=5000"`
+	Languages   []string  `db:"languages" json:"languages" binding:"max=20,dive,min=2,max=35"`
+	UserID      uuid.UUID `db:"user_id" json:"user_id" binding:"required"`
+	Version     int64     `db:"version" json:"-"` // If-Match dan olingan kutilgan versiya
+}
+
+// ToUpdate rezyumening joriy holatini UpdateResume ko'rinishida qaytaradi
+func (r Resume) ToUpdate() UpdateResume {
+	return UpdateResume{
+		ID:          r.ID.String(),
+		Position:    r.Position,
+		Experience:  r.Experience,
+		Description: r.Description,
+		Languages:   r.Languages,
+		UserID:      r.UserID,
+	}
 }
 
 type ResumeWithUser struct {
-	ID          uuid.UUID `db:"id" json:"id"`
-	Position    string    `db:"position" json:"position"`
-	Experience  int       `db:"experience" json:"experience"`
-	Description string    `db:"description" json:"description"`
-	UserID      uuid.UUID `db:"user_id" json:"user_id"`
-	UserName    string    `db:"user_name" json:"user_name"`
-	UserEmail   string    `db:"user_email" json:"user_email"`
-	CreatedAt   time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
-	DeletedAt   int64     `db:"deleted_at" json:"deleted_at"`
+	ID          uuid.UUID      `db:"id" json:"id"`
+	Position    string         `db:"position" json:"position"`
+	Experience  int            `db:"experience" json:"experience"`
+	Description string         `db:"description" json:"description"`
+	Languages   pq.StringArray `db:"languages" json:"languages"`
+	UserID      uuid.UUID      `db:"user_id" json:"user_id"`
+	UserName    string         `db:"user_name" json:"user_name"`
+	UserEmail   string         `db:"user_email" json:"user_email"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
+	DeletedAt   int64          `db:"deleted_at" json:"deleted_at"`
+	Version     int64          `db:"version" json:"version"`
 }
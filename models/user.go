@@ -16,21 +16,58 @@ type User struct {
 	CreatedAt   time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
 	DeletedAt   int64     `db:"deleted_at" json:"deleted_at"`
+	Version     int64     `db:"version" json:"version"`
 }
 
+// Validatsiya qoidalari `binding` tegida, qoidalar ro'yxati validation paketida
 type UserCreate struct {
-	Name        string `db:"name" json:"name"`
-	Email       string `db:"email" json:"email"`
-	PhoneNumber string `db:"phone_number" json:"phone_number"`
-	Birthday    string `db:"birthday" json:"birthday"`
-	Gender      string `db:"gender" json:"gender"`
+	Name        string `db:"name" json:"name" binding:"required,min=2,max=100"`
+	Email       string `db:"email" json:"email" binding:"required,email,max=254"`
+	PhoneNumber string `db:"phone_number" json:"phone_number" binding:"required,phone"`
+	Birthday    string `db:"birthday" json:"birthday" binding:"required,pastdate"`
+	Gender      string `db:"gender" json:"gender" binding:"required,gender"`
 }
 
+// UserUpdate - PUT uchun to'liq almashtirish. PATCH esa joriy yozuvdan ToUpdate
+// orqali olingan qiymat ustiga merge patch qo'llab shu strukturani hosil qiladi.
 type UserUpdate struct {
 	ID          uuid.UUID `db:"id" json:"id"`
-	Name        string    `db:"name" json:"name"`
-	Email       string    `db:"email" json:"email"`
-	PhoneNumber string    `db:"phone_number" json:"phone_number"`
-	Birthday    string    `db:"birthday" json:"birthday"`
-	Gender      string    `db:"gender" json:"gender"`
+	Name        string    `db:"name" json:"name" binding:"required,min=2,max=100"`
+	Email       string    `db:"email" json:"email" binding:"required,email,max=254"`
+	PhoneNumber string    `db:"phone_number" json:"phone_number" binding:"required,phone"`
+	Birthday    string    `db:"birthday" json:"birthday" binding:"required,pastdate"`
+	Gender      string    `db:"gender" json:"gender" binding:"required,gender"`
+	Version     int64     `db:"version" json:"-"` // If-Match dan olingan kutilgan versiya
+}
+
+// ToUpdate foydalanuvchining joriy holatini UserUpdate ko'rinishida qaytaradi
+func (u User) ToUpdate() UserUpdate {
+	return UserUpdate{
+		ID:          u.ID,
+		Name:        u.Name,
+		Email:       u.Email,
+		PhoneNumber: u.PhoneNumber,
+		Birthday:    u.Birthday.Format("2006-01-02"),
+		Gender:      u.Gender,
+	}
+}
+
+// DuplicateGroup - bir xil email, telefon yoki ism+tug'ilgan sanaga ega faol foydalanuvchilar
+type DuplicateGroup struct {
+	Reason string `json:"reason"` // "email", "phone" yoki "name_birthday"
+	Key    string `json:"key"`    // guruhlash kaliti (kichik harfdagi email, normallashtirilgan telefon ...)
+	Users  []User `json:"users"`
+}
+
+// MergeUsers - source foydalanuvchining rezyume va intervyularini target ga o'tkazib, source ni o'chirish
+type MergeUsers struct {
+	SourceID uuid.UUID `json:"source_id" binding:"required"`
+	TargetID uuid.UUID `json:"target_id" binding:"required"`
+}
+
+type MergeResult struct {
+	User            User      `json:"user"`
+	MergedUserID    uuid.UUID `json:"merged_user_id"`
+	MovedResumes    int64     `json:"moved_resumes"`
+	MovedInterviews int64     `json:"moved_interviews"`
 }
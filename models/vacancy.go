@@ -16,20 +16,36 @@ type Vacancy struct {
 	CreatedAt   time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
 	DeletedAt   int64     `db:"deleted_at" json:"deleted_at"`
+	Version     int64     `db:"version" json:"version"`
 }
 type CreateVacancy struct {
 	ID          uuid.UUID `db:"id" json:"id"`
-	Name        string    `db:"name" json:"name"`
-	Position    string    `db:"position" json:"position"`
-	MinExp      int       `db:"min_exp" json:"min_exp"`
-	CompanyID   uuid.UUID `db:"company_id" json:"company_id"`
-	Description string    `db:"description" json:"description"`
+	Name        string    `db:"name" json:"name" binding:"required,max=200"`
+	Position    string    `db:"position" json:"position" binding:"required,max=100"`
+	MinExp      int       `db:"min_exp" json:"min_exp" binding:"min=0,max=60"`
+	CompanyID   uuid.UUID `db:"company_id" json:"company_id" binding:"required"`
+	Description string    `db:"description" json:"description" binding:"required,max=5000"`
 }
 
+// UpdateVacancy - PUT uchun to'liq almashtirish, PATCH esa ToUpdate natijasi ustiga quriladi
 type UpdateVacancy struct {
 	ID          uuid.UUID `db:"id" json:"id"`
-	Name        string    `db:"name" json:"name"`
-	Position    string    `db:"position" json:"position"`
-	MinExp      int       `db:"min_exp" json:"min_exp"`
-	CompanyID   uuid.UUID `db:"company_id" json:"company_id"`
+	Name        string    `db:"name" json:"name" binding:"required,max=200"`
+	Position    string    `db:"position" json:"position" binding:"required,max=100"`
+	MinExp      int       `db:"min_exp" json:"min_exp" binding:"min=0,max=60"`
+	CompanyID   uuid.UUID `db:"company_id" json:"company_id" binding:"required"`
+	Description string    `db:"description" json:"description" binding:"max=5000"`
+	Version     int64     `db:"version" json:"-"` // If-Match dan olingan kutilgan versiya
+}
+
+// ToUpdate vakansiyaning joriy holatini UpdateVacancy ko'rinishida qaytaradi
+func (v Vacancy) ToUpdate() UpdateVacancy {
+	return UpdateVacancy{
+		ID:          v.ID,
+		Name:        v.Name,
+		Position:    v.Position,
+		MinExp:      v.MinExp,
+		CompanyID:   v.CompanyID,
+		Description: v.Description,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type Webhook struct {
+	ID        uuid.UUID      `db:"id" json:"id"`
+	CompanyID uuid.UUID      `db:"company_id" json:"company_id"`
+	URL       string         `db:"url" json:"url"`
+	Secret    string         `db:"secret" json:"secret,omitempty"`
+	Events    pq.StringArray `db:"events" json:"events"`
+	Active    bool           `db:"active" json:"active"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at" json:"updated_at"`
+	DeletedAt int64          `db:"deleted_at" json:"deleted_at"`
+	Version   int64          `db:"version" json:"version"`
+}
+
+type CreateWebhook struct {
+	CompanyID uuid.UUID `json:"company_id" binding:"required"`
+	URL       string    `json:"url" binding:"required,http_url"`
+	Secret    string    `json:"secret" binding:"omitempty,min=16,max=256"` // bo'sh bo'lsa server o'zi generatsiya qiladi
+	Events    []string  `json:"events" binding:"required,min=1"`
+}
+
+// WebhookDelivery - webhookni yuborishdagi har bir urinish
+type WebhookDelivery struct {
+	ID           uuid.UUID `db:"id" json:"id"`
+	WebhookID    uuid.UUID `db:"webhook_id" json:"webhook_id"`
+	EventID      uuid.UUID `db:"event_id" json:"event_id"`
+	Event        string    `db:"event" json:"event"`
+	Payload      string    `db:"payload" json:"payload"`
+	Attempt      int       `db:"attempt" json:"attempt"`
+	StatusCode   int       `db:"status_code" json:"status_code"`
+	ResponseBody string    `db:"response_body" json:"response_body"`
+	Error        string    `db:"error" json:"error"`
+	Success      bool      `db:"success" json:"success"`
+	DurationMs   int64     `db:"duration_ms" json:"duration_ms"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+}
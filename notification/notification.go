@@ -0,0 +1,74 @@
+package notification
+
+import "errors"
+
+// Kind - bildirishnoma turi
+type Kind string
+
+const (
+	KindInterviewScheduled       Kind = "interview_scheduled"
+	KindInterviewRescheduled     Kind = "interview_rescheduled"
+	KindInterviewCancelled       Kind = "interview_cancelled"
+	KindInterviewReminder        Kind = "interview_reminder"
+	KindApplicationStatusChanged Kind = "application_status_changed"
+)
+
+// Kinds - barcha bildirishnoma turlari
+var Kinds = []Kind{
+	KindInterviewScheduled,
+	KindInterviewRescheduled,
+	KindInterviewCancelled,
+	KindInterviewReminder,
+	KindApplicationStatusChanged,
+}
+
+// IsValidKind bildirishnoma turi mavjudligini tekshiradi
+func IsValidKind(kind string) bool {
+	for _, k := range Kinds {
+		if string(k) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Channel - xabar yetkaziladigan kanal
+type Channel string
+
+const (
+	ChannelEmail    Channel = "email"
+	ChannelSMS      Channel = "sms"
+	ChannelTelegram Channel = "telegram"
+)
+
+// Channels - barcha kanallar
+var Channels = []Channel{ChannelEmail, ChannelSMS, ChannelTelegram}
+
+// IsValidChannel kanal nomini tekshiradi
+func IsValidChannel(channel string) bool {
+	for _, ch := range Channels {
+		if string(ch) == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// Message - yuboriladigan tayyor xabar. To kanalga qarab email, telefon raqami yoki Telegram chat ID bo'ladi
+type Message struct {
+	Channel Channel
+	To      string
+	Subject string
+	Body    string
+	Kind    Kind
+}
+
+// Driver xabarni haqiqatda yetkazib beradi (SMTP, SMS gateway, Telegram, fayl, log ...)
+type Driver interface {
+	Send(msg Message) error
+}
+
+var (
+	ErrQueueFull          = errors.New("notification queue is full")
+	ErrChannelUnavailable = errors.New("notification channel is not configured")
+)
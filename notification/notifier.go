@@ -0,0 +1,156 @@
+package notification
+
+import (
+	"context"
+	"log"
+
+	"hrplatform/models"
+	"hrplatform/postgres"
+	"hrplatform/utils"
+
+	"github.com/google/uuid"
+)
+
+// DateFormat - xabarlarda sana va vaqt ko'rinishi
+const DateFormat = "2006-01-02 15:04"
+
+// TemplateData - shablonlarga beriladigan ma'lumotlar
+type TemplateData struct {
+	Name          string
+	VacancyName   string
+	InterviewDate string
+	Status        string
+}
+
+// Notifier foydalanuvchi sozlamalarini hisobga olib xabarni tayyorlaydi va Senderga beradi.
+// nil Notifier hech narsa yubormaydi.
+type Notifier struct {
+	Users       postgres.UserRepository
+	Recruiters  postgres.RecruiterRepository
+	Vacancies   postgres.VacancyRepository
+	Preferences postgres.NotificationPreferenceRepository
+	Sender      *Sender
+}
+
+// NotifyUser foydalanuvchiga berilgan turdagi xabarni uning tilida, tanlagan kanali orqali yuboradi.
+// Tanlangan kanal mavjud bo'lmasa boshqa yoqilgan kanalga o'tiladi.
+func (n *Notifier) NotifyUser(ctx context.Context, userID uuid.UUID, kind Kind, data TemplateData) error {
+	if n == nil {
+		return nil
+	}
+
+	preference, err := n.Preferences.GetPreference(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if isMuted(preference, kind) {
+		return nil
+	}
+
+	user, err := n.Users.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return err
+	}
+	if data.Name == "" {
+		data.Name = user.Name
+	}
+
+	channel, to := n.pickChannel(preference, user)
+	if channel == "" {
+		return nil
+	}
+
+	subject, body, err := Render(preference.Locale, kind, data)
+	if err != nil {
+		return err
+	}
+
+	return n.Sender.Enqueue(Message{
+		Channel: channel,
+		To:      to,
+		Subject: subject,
+		Body:    body,
+		Kind:    kind,
+	})
+}
+
+// pickChannel avval foydalanuvchi tanlagan kanalni, keyin email, sms, telegram tartibida
+// yoqilgan, manzili ma'lum va serverda sozlangan birinchi kanalni tanlaydi
+func (n *Notifier) pickChannel(preference models.NotificationPreference, user models.User) (Channel, string) {
+	candidates := append([]Channel{Channel(preference.PreferredChannel)}, Channels...)
+	for _, channel := range candidates {
+		if !n.Sender.Supports(channel) {
+			continue
+		}
+		switch channel {
+		case ChannelEmail:
+			if preference.EmailEnabled && user.Email != "" {
+				return channel, user.Email
+			}
+		case ChannelSMS:
+			if phone, ok := utils.NormalizeUzPhone(user.PhoneNumber); ok && preference.SMSEnabled {
+				return channel, phone
+			}
+		case ChannelTelegram:
+			if preference.TelegramEnabled && preference.TelegramChatID != "" {
+				return channel, preference.TelegramChatID
+			}
+		}
+	}
+	return "", ""
+}
+
+// NotifyInterview intervyu bilan bog'liq xabarni nomzodga yuboradi, xatoliklar faqat logga yoziladi
+func (n *Notifier) NotifyInterview(ctx context.Context, kind Kind, interview models.Interview) {
+	if n == nil {
+		return
+	}
+
+	data := TemplateData{InterviewDate: interview.InterviewDate.Format(DateFormat)}
+	if vacancy, err := n.Vacancies.GetVacancyByID(ctx, interview.VacancyID); err == nil {
+		data.VacancyName = vacancy.Name
+	}
+
+	if err := n.NotifyUser(ctx, interview.UserID, kind, data); err != nil {
+		log.Printf("notification: %s xabarini tayyorlashda xatolik: %v", kind, err)
+	}
+}
+
+// NotifyInterviewRecruiter intervyu haqidagi xabarni rekruiterga email orqali yuboradi.
+// Rekruiterlarning sozlamalari yo'q, shuning uchun DefaultLocale ishlatiladi.
+func (n *Notifier) NotifyInterviewRecruiter(ctx context.Context, kind Kind, interview models.Interview) {
+	if n == nil || n.Recruiters == nil {
+		return
+	}
+
+	recruiter, err := n.Recruiters.GetRecruiterByID(ctx, interview.RecruiterID.String())
+	if err != nil {
+		log.Printf("notification: rekruiterni olishda xatolik: %v", err)
+		return
+	}
+
+	data := TemplateData{Name: recruiter.Name, InterviewDate: interview.InterviewDate.Format(DateFormat)}
+	if vacancy, err := n.Vacancies.GetVacancyByID(ctx, interview.VacancyID); err == nil {
+		data.VacancyName = vacancy.Name
+	}
+
+	subject, body, err := Render(DefaultLocale, kind, data)
+	if err != nil {
+		log.Printf("notification: %s xabarini tayyorlashda xatolik: %v", kind, err)
+		return
+	}
+
+	err = n.Sender.Enqueue(Message{Channel: ChannelEmail, To: recruiter.Email, Subject: subject, Body: body, Kind: kind})
+	if err != nil {
+		log.Printf("notification: rekruiterga xabarni navbatga qo'yishda xatolik: %v", err)
+	}
+}
+
+func isMuted(preference models.NotificationPreference, kind Kind) bool {
+	for _, muted := range preference.MutedKinds {
+		if muted == string(kind) {
+			return true
+		}
+	}
+	return false
+}
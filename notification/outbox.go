@@ -0,0 +1,59 @@
+package notification
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileDriver lokal ishlab chiqish uchun: har bir xabarni Dir papkasiga .eml fayl qilib yozadi.
+// Har qanday kanal uchun ishlatish mumkin.
+type FileDriver struct {
+	Dir  string
+	From string
+}
+
+func (d *FileDriver) Send(msg Message) error {
+	if err := os.MkdirAll(d.Dir, 0o755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s-%s-%s.eml", time.Now().Format("20060102T150405"), msg.Channel, msg.Kind, uuid.NewString()[:8])
+	return os.WriteFile(filepath.Join(d.Dir, name), buildMIME(d.From, msg), 0o644)
+}
+
+// LogDriver xabarlarni faqat logga chiqaradi
+type LogDriver struct{}
+
+func (d *LogDriver) Send(msg Message) error {
+	log.Printf("notification [%s/%s] to=%s subject=%q\n%s", msg.Channel, msg.Kind, msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// FakeDriver yuborilgan xabarlarni xotirada saqlaydi (testlar va lokal demo uchun)
+type FakeDriver struct {
+	mu       sync.Mutex
+	messages []Message
+	Err      error // nil bo'lmasa Send shu xatoni qaytaradi
+}
+
+func (d *FakeDriver) Send(msg Message) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.Err != nil {
+		return d.Err
+	}
+	d.messages = append(d.messages, msg)
+	return nil
+}
+
+// Messages shu paytgacha yuborilgan xabarlar nusxasini qaytaradi
+func (d *FakeDriver) Messages() []Message {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]Message(nil), d.messages...)
+}
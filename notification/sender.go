@@ -0,0 +1,95 @@
+package notification
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// Sender xabarlarni navbatga qo'yib, fonda kanalga mos Driver orqali yuboradi.
+// Yuborish muvaffaqiyatsiz bo'lsa exponential backoff bilan qayta urinadi.
+type Sender struct {
+	Drivers     map[Channel]Driver
+	MaxAttempts int
+	BaseDelay   time.Duration
+
+	queue chan Message
+	wg    sync.WaitGroup
+}
+
+// NewSender navbat hajmi va workerlar soni bilan Sender yaratadi va ishga tushiradi
+func NewSender(drivers map[Channel]Driver, workers, queueSize, maxAttempts int, baseDelay time.Duration) *Sender {
+	if workers < 1 {
+		workers = 1
+	}
+	s := &Sender{
+		Drivers:     drivers,
+		MaxAttempts: maxAttempts,
+		BaseDelay:   baseDelay,
+		queue:       make(chan Message, queueSize),
+	}
+	for i := 0; i < workers; i++ {
+		s.wg.Add(1)
+		go s.worker()
+	}
+	return s
+}
+
+// Supports kanal uchun driver sozlanganligini tekshiradi
+func (s *Sender) Supports(channel Channel) bool {
+	_, ok := s.Drivers[channel]
+	return ok
+}
+
+// Enqueue xabarni navbatga qo'yadi, navbat to'la bo'lsa ErrQueueFull qaytaradi
+func (s *Sender) Enqueue(msg Message) error {
+	if msg.Channel == "" {
+		msg.Channel = ChannelEmail
+	}
+	if !s.Supports(msg.Channel) {
+		return ErrChannelUnavailable
+	}
+	select {
+	case s.queue <- msg:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Close yangi xabarlarni qabul qilishni to'xtatadi va navbatdagilar yuborilishini kutadi
+func (s *Sender) Close() {
+	close(s.queue)
+	s.wg.Wait()
+}
+
+func (s *Sender) worker() {
+	defer s.wg.Done()
+	for msg := range s.queue {
+		s.send(msg)
+	}
+}
+
+func (s *Sender) send(msg Message) {
+	maxAttempts := s.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	delay := s.BaseDelay
+	if delay <= 0 {
+		delay = time.Second
+	}
+
+	driver := s.Drivers[msg.Channel]
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err := driver.Send(msg)
+		if err == nil {
+			return
+		}
+		log.Printf("notification: %s %s ga yuborishda xatolik (urinish %d/%d): %v", msg.Channel, msg.To, attempt, maxAttempts, err)
+		if attempt < maxAttempts {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"hrplatform/utils"
+)
+
+// EskizSMSDriver SMS xabarlarni Eskiz.uz gateway orqali yuboradi.
+// Token Eskiz kabinetidan olinadi (POST /api/auth/login).
+type EskizSMSDriver struct {
+	BaseURL string // masalan https://notify.eskiz.uz
+	Token   string
+	From    string // alfa-nom, masalan "4546"
+	Client  *http.Client
+}
+
+func (d *EskizSMSDriver) Send(msg Message) error {
+	phone, ok := utils.NormalizeUzPhone(msg.To)
+	if !ok {
+		return fmt.Errorf("invalid phone number %q", msg.To)
+	}
+
+	form := url.Values{}
+	form.Set("mobile_phone", strings.TrimPrefix(phone, "+"))
+	form.Set("message", SMSText(msg))
+	form.Set("from", d.From)
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimRight(d.BaseURL, "/")+"/api/message/sms/send", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+d.Token)
+
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("sms gateway returned %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// SMSText xabarni bitta qatorli SMS matniga aylantiradi
+func SMSText(msg Message) string {
+	return strings.Join(strings.Fields(msg.Body), " ")
+}
@@ -0,0 +1,42 @@
+package notification
+
+import (
+	"fmt"
+	"mime"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPDriver xabarlarni SMTP server orqali yuboradi
+type SMTPDriver struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+func (d *SMTPDriver) Send(msg Message) error {
+	var auth smtp.Auth
+	if d.Username != "" {
+		auth = smtp.PlainAuth("", d.Username, d.Password, d.Host)
+	}
+	return smtp.SendMail(d.Host+":"+d.Port, auth, d.From, []string{msg.To}, buildMIME(d.From, msg))
+}
+
+// buildMIME xabarni oddiy text/plain UTF-8 MIME formatiga keltiradi
+func buildMIME(from string, msg Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,61 @@
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// TelegramDriver xabarlarni Telegram Bot API (sendMessage) orqali yuboradi.
+// Message.To - foydalanuvchining bot bilan bog'langan chat ID si.
+type TelegramDriver struct {
+	BaseURL string // standart: https://api.telegram.org
+	Token   string
+	Client  *http.Client
+}
+
+func (d *TelegramDriver) Send(msg Message) error {
+	baseURL := d.BaseURL
+	if baseURL == "" {
+		baseURL = "https://api.telegram.org"
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"chat_id": msg.To,
+		"text":    msg.Subject + "\n\n" + msg.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", strings.TrimRight(baseURL, "/"), d.Token)
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("telegram api returned %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// TelegramUpdate - bot webhookiga keladigan update ning bizga kerakli qismi
+type TelegramUpdate struct {
+	UpdateID int64 `json:"update_id"`
+	Message  *struct {
+		Text string `json:"text"`
+		Chat struct {
+			ID int64 `json:"id"`
+		} `json:"chat"`
+	} `json:"message"`
+}
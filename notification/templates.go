@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"fmt"
+
+	"hrplatform/i18n"
+)
+
+// Qo'llab-quvvatlanadigan tillar. Tarjimalar i18n katalogida saqlanadi.
+const (
+	LocaleUz = i18n.LocaleUz
+	LocaleRu = i18n.LocaleRu
+	LocaleEn = i18n.LocaleEn
+
+	DefaultLocale = i18n.DefaultLocale
+)
+
+// Locales - qo'llab-quvvatlanadigan barcha tillar
+var Locales = i18n.Locales
+
+// IsSupportedLocale tilni qo'llab-quvvatlanishini tekshiradi
+func IsSupportedLocale(locale string) bool {
+	return i18n.IsSupported(locale)
+}
+
+// Render berilgan til va tur uchun mavzu va matnni tayyorlaydi.
+// Shablonlar "notification.<tur>.subject" va "notification.<tur>.body"
+// kalitlari ostida i18n katalogida turadi; til topilmasa DefaultLocale ishlatiladi.
+// Shablonlarda ishlatiladigan maydonlar: .Name, .VacancyName, .InterviewDate, .Status
+func Render(locale string, kind Kind, data interface{}) (string, string, error) {
+	locale = i18n.Normalize(locale)
+	prefix := "notification." + string(kind)
+
+	subject, ok, err := i18n.Render(locale, prefix+".subject", data)
+	if !ok {
+		return "", "", fmt.Errorf("no template for notification kind %q", kind)
+	}
+	if err != nil {
+		return "", "", err
+	}
+	body, _, err := i18n.Render(locale, prefix+".body", data)
+	if err != nil {
+		return "", "", err
+	}
+	return subject, body, nil
+}
@@ -0,0 +1,323 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"hrplatform/api/middleware"
+	"hrplatform/expand"
+
+	"github.com/gin-gonic/gin"
+)
+
+// tags - Swagger UI dagi guruhlar tartibi
+var tags = []Tag{
+	{Name: "users", Description: "Candidates"},
+	{Name: "resumes", Description: "Candidate resumes"},
+	{Name: "companies", Description: "Hiring companies"},
+	{Name: "recruiters", Description: "Company recruiters"},
+	{Name: "vacancies", Description: "Open positions"},
+	{Name: "interviews", Description: "Scheduled interviews"},
+	{Name: "eligibility", Description: "Candidate eligibility rules"},
+	{Name: "notifications", Description: "Notification preferences and Telegram bot"},
+	{Name: "webhooks", Description: "Outgoing event webhooks"},
+	{Name: "imports", Description: "Bulk creation from CSV and XLSX files"},
+	{Name: "audit", Description: "Change history"},
+	{Name: "graphql", Description: "GraphQL API over the same records"},
+	{Name: "docs", Description: "API documentation"},
+}
+
+const description = `Errors share one shape (ErrorResponse) with a stable code such as user_not_found.
+PUT, PATCH and DELETE require If-Match with the ETag from a previous GET.
+POST requests may carry an Idempotency-Key header to be retried safely.
+Messages follow Accept-Language (uz, ru, en).
+Paths are relative to /api/v1. The same paths without the prefix still work but are deprecated:
+they answer with Deprecation, Sunset and a Link to the /api/v1 path.
+Imports take a file plus an optional mapping such as {"columns": {"name": "Full name"}, "defaults": {"company_id": "..."}};
+columns default to the field names, rows are reported by their line in the file.`
+
+// BasePath - Routes dagi yo'llar ulangan prefiks (api.SetupRouter dagi v1 guruhi)
+const BasePath = "/api/v1"
+
+// Build Routes dan to'liq hujjat yasaydi
+func Build() *Document {
+	g := newGenerator()
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info:    Info{Title: "HR Platform API", Version: "1.0.0", Description: description},
+		Servers: []Server{{URL: BasePath, Description: "API v1"}},
+		Tags:    tags,
+		Paths:   make(map[string]PathItem),
+	}
+
+	for _, route := range Routes {
+		path, params := convertPath(route.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(PathItem)
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = g.operation(route, params)
+	}
+
+	doc.Components.Schemas = g.schemas
+	return doc
+}
+
+// convertPath gin yo'lini OpenAPI ko'rinishiga o'tkazadi: /users/:id -> /users/{id}
+func convertPath(path string) (string, []string) {
+	var params []string
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			params = append(params, segment[1:])
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+func (g *generator) operation(route Route, pathParams []string) *Operation {
+	op := &Operation{
+		Tags:        []string{route.Tag},
+		Summary:     route.Summary,
+		OperationID: operationID(route.Name),
+		Responses:   make(map[string]Response),
+	}
+
+	for _, name := range pathParams {
+		op.Parameters = append(op.Parameters, Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string", Format: "uuid"}})
+	}
+	for _, query := range route.Query {
+		op.Parameters = append(op.Parameters, Parameter{Name: query.Name, In: "query", Description: query.Description, Schema: querySchema(query.Type)})
+	}
+	if route.Expand != "" {
+		op.Parameters = append(op.Parameters,
+			Parameter{Name: "expand", In: "query", Schema: &Schema{Type: "string"}, Description: fmt.Sprintf(
+				"Comma-separated related records to embed, nested with dots up to %d levels (e.g. vacancy.company). Relations: %s",
+				expand.MaxDepth, strings.Join(expand.Relations(route.Expand), ", "))},
+			Parameter{Name: "fields", In: "query", Schema: &Schema{Type: "string"},
+				Description: "Comma-separated fields to keep (e.g. id,name,company.name); an embedded relation is kept only if listed"},
+		)
+	}
+	if route.IfMatch {
+		op.Parameters = append(op.Parameters, Parameter{Name: "If-Match", In: "header", Required: true, Description: "ETag of the version being changed", Schema: &Schema{Type: "string"}})
+	}
+	if route.Method == http.MethodPost {
+		op.Parameters = append(op.Parameters, Parameter{Name: middleware.IdempotencyKeyHeader, In: "header", Description: "Replays the stored response when the same request is retried", Schema: &Schema{Type: "string", MaxLength: intPtr(255)}})
+	}
+
+	if route.Body != nil {
+		schema := g.schemaFor(route.Body)
+		content := map[string]MediaType{"application/json": {Schema: schema}}
+		if route.Patch {
+			schema = g.patchSchema(route.Body)
+			content = map[string]MediaType{
+				"application/merge-patch+json": {Schema: schema},
+				"application/json":             {Schema: schema},
+			}
+		}
+		op.RequestBody = &RequestBody{Required: true, Content: content}
+	}
+	if route.Form != nil {
+		op.RequestBody = &RequestBody{Required: true, Content: map[string]MediaType{"multipart/form-data": {Schema: g.schemaFor(route.Form)}}}
+	}
+
+	status := route.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := Response{Description: http.StatusText(status)}
+	switch {
+	case route.HTML:
+		success.Content = map[string]MediaType{"text/html": {Schema: &Schema{Type: "string"}}}
+	case route.Response != nil:
+		success.Content = map[string]MediaType{"application/json": {Schema: g.schemaFor(route.Response)}}
+	}
+	if route.ETag {
+		success.Headers = map[string]Header{"ETag": {Description: "Version of the returned record", Schema: &Schema{Type: "string"}}}
+	}
+	op.Responses[fmt.Sprint(status)] = success
+
+	errorSchema := g.schemaFor(middleware.ErrorResponse{})
+	addError := func(status int, text string) {
+		op.Responses[fmt.Sprint(status)] = Response{Description: text, Content: map[string]MediaType{"application/json": {Schema: errorSchema}}}
+	}
+	if route.Body != nil || route.Form != nil || len(route.Query) > 0 || len(pathParams) > 0 {
+		addError(http.StatusBadRequest, "Invalid ID, query or body (invalid_id, invalid_query, invalid_json, validation_failed, ...)")
+	}
+	if len(pathParams) > 0 {
+		addError(http.StatusNotFound, "Record not found")
+	}
+	if route.IfMatch {
+		addError(http.StatusPreconditionFailed, "If-Match does not match the current version (version_mismatch)")
+		addError(http.StatusPreconditionRequired, "If-Match header is missing (if_match_required)")
+	}
+	op.Responses["default"] = Response{Description: "Error", Content: map[string]MediaType{"application/json": {Schema: errorSchema}}}
+	return op
+}
+
+// patchSchema PATCH tanasi uchun sxema: PUT sxemasi bilan bir xil maydonlar, lekin
+// birortasi ham majburiy emas (<Name>Patch nomi bilan)
+func (g *generator) patchSchema(v interface{}) *Schema {
+	t := reflect.TypeOf(v)
+	full := g.schemas[g.register(t)]
+	name := g.names[t] + "Patch"
+	if _, ok := g.schemas[name]; !ok {
+		patch := *full
+		patch.Required = nil
+		patch.Description = "JSON Merge Patch (RFC 7396): only the given fields change, null resets a field"
+		g.schemas[name] = &patch
+	}
+	return ref(name)
+}
+
+func querySchema(kind string) *Schema {
+	if kind == "uuid" {
+		return &Schema{Type: "string", Format: "uuid"}
+	}
+	return &Schema{Type: kind}
+}
+
+// operationID Route.Name ning kichik harf bilan boshlangan ko'rinishi: CreateUser -> createUser
+func operationID(name string) string {
+	if name == "" {
+		return ""
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+// Diff routerdagi BasePath ostidagi marshrutlarni hujjat bilan solishtiradi va farqlarni
+// qaytaradi: hujjatda yo'q route, routerda yo'q operatsiya, bo'sh yoki takrorlangan
+// operationId va topilmaydigan $ref lar. Boshqa prefikslardagi (prefikssiz eski yo'llar,
+// keyingi versiyalar) marshrutlar hisobga olinmaydi.
+// Bo'sh natija hujjat router bilan mos ekanini bildiradi.
+func Diff(routes gin.RoutesInfo, doc *Document) []string {
+	var problems []string
+
+	routed := make(map[string]bool)
+	for _, route := range routes {
+		relative, ok := strings.CutPrefix(route.Path, BasePath)
+		if !ok || !strings.HasPrefix(relative, "/") {
+			continue
+		}
+		path, _ := convertPath(relative)
+		routed[route.Method+" "+path] = true
+		if doc.Paths[path][strings.ToLower(route.Method)] == nil {
+			problems = append(problems, fmt.Sprintf("%s %s: route is not documented", route.Method, route.Path))
+		}
+	}
+	for path, item := range doc.Paths {
+		for method := range item {
+			if !routed[strings.ToUpper(method)+" "+path] {
+				problems = append(problems, fmt.Sprintf("%s %s: documented but not routed", strings.ToUpper(method), path))
+			}
+		}
+	}
+
+	operations := make(map[string]string)
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			where := strings.ToUpper(method) + " " + path
+			switch other, taken := operations[op.OperationID]; {
+			case op.OperationID == "":
+				problems = append(problems, where+": operationId is empty")
+			case taken:
+				problems = append(problems, fmt.Sprintf("%s: operationId %s is also used by %s", where, op.OperationID, other))
+			}
+			operations[op.OperationID] = where
+		}
+	}
+
+	for _, missing := range unresolvedRefs(doc) {
+		problems = append(problems, "unresolved $ref "+missing)
+	}
+
+	sort.Strings(problems)
+	return problems
+}
+
+// unresolvedRefs hujjatdagi components/schemas da yo'q havolalar
+func unresolvedRefs(doc *Document) []string {
+	seen := make(map[string]bool)
+	var missing []string
+	var walk func(schema *Schema)
+	walk = func(schema *Schema) {
+		if schema == nil {
+			return
+		}
+		if schema.Ref != "" {
+			name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+			if _, ok := doc.Components.Schemas[name]; !ok && !seen[name] {
+				seen[name] = true
+				missing = append(missing, schema.Ref)
+			}
+			return
+		}
+		walk(schema.Items)
+		walk(schema.AdditionalProperties)
+		for _, property := range schema.Properties {
+			walk(property)
+		}
+	}
+
+	for _, schema := range doc.Components.Schemas {
+		walk(schema)
+	}
+	for _, item := range doc.Paths {
+		for _, op := range item {
+			for _, param := range op.Parameters {
+				walk(param.Schema)
+			}
+			if op.RequestBody != nil {
+				for _, media := range op.RequestBody.Content {
+					walk(media.Schema)
+				}
+			}
+			for _, response := range op.Responses {
+				for _, media := range response.Content {
+					walk(media.Schema)
+				}
+			}
+		}
+	}
+	return missing
+}
+
+// Handler hujjatni JSON ko'rinishida qaytaradi
+func Handler(doc *Document) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, doc)
+	}
+}
+
+// UIHandler specURL dagi hujjat uchun Swagger UI sahifasini qaytaradi. Skript va
+// stillar CDN dan yuklanadi, shuning uchun binarga hech narsa qo'shilmaydi.
+func UIHandler(specURL string) gin.HandlerFunc {
+	page := fmt.Sprintf(swaggerUIPage, specURL)
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(page))
+	}
+}
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>HR Platform API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.ui = SwaggerUIBundle({url: %q, dom_id: "#swagger-ui"});
+  </script>
+</body>
+</html>
+`
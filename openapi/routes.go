@@ -0,0 +1,228 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"hrplatform/api/handlers"
+	"hrplatform/apperrors"
+	"hrplatform/audit"
+	"hrplatform/eligibility"
+	"hrplatform/models"
+	"hrplatform/notification"
+
+	"github.com/google/uuid"
+)
+
+// Route - routerdagi bitta endpoint tavsifi. Path gin ko'rinishida yoziladi (/users/:id),
+// Body va Response esa namunaviy qiymatlar: ularning turidan sxema yasaladi.
+type Route struct {
+	Name     string // operationId va client metodining nomi: CreateUser
+	Method   string
+	Path     string
+	Tag      string
+	Summary  string
+	Query    []Query
+	Body     interface{} // nil bo'lsa so'rov tanasiz
+	Patch    bool        // tana JSON Merge Patch (RFC 7396): maydonlar ixtiyoriy
+	Status   int         // muvaffaqiyatli javob statusi, 0 bo'lsa 200
+	Response interface{}
+	IfMatch  bool        // If-Match majburiy: yo'q bo'lsa 428, eskirgan bo'lsa 412
+	ETag     bool        // javobda yozuv versiyasi ETag sarlavhasida qaytadi
+	HTML     bool        // javob text/html
+	Expand   string      // ?expand= va ?fields= qabul qiladigan yozuv turi (expand paketi), bo'sh bo'lsa yo'q
+	Form     interface{} // tana multipart/form-data: maydonlar json nomlari bilan, *multipart.FileHeader fayl
+}
+
+// Query - query parametri. Type: string, integer yoki uuid.
+type Query struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+// Message - PUT va DELETE muvaffaqiyat javobi (so'rov tilida)
+type Message struct {
+	Message string `json:"message"`
+}
+
+// InterviewStatus - intervyuning PUT va DELETE javobi
+type InterviewStatus struct {
+	Status string `json:"status"`
+}
+
+// TelegramAck - Telegram webhookiga har doim qaytadigan javob
+type TelegramAck struct {
+	OK bool `json:"ok"`
+}
+
+// CompanyRules - GET/PUT /companies/:id/eligibility-rules javobi
+type CompanyRules struct {
+	CompanyID uuid.UUID          `json:"company_id"`
+	Rules     []eligibility.Rule `json:"rules"`
+}
+
+// VacancyRules - GET/PUT /vacancies/:id/eligibility-rules javobi
+type VacancyRules struct {
+	VacancyID      uuid.UUID          `json:"vacancy_id"`
+	Rules          []eligibility.Rule `json:"rules"`
+	EffectiveRules []eligibility.Rule `json:"effective_rules"`
+}
+
+// EligibilityCheck - POST /vacancies/:id/eligibility-check javobi
+type EligibilityCheck struct {
+	VacancyID uuid.UUID              `json:"vacancy_id"`
+	UserID    uuid.UUID              `json:"user_id"`
+	Eligible  bool                   `json:"eligible"`
+	Rules     []eligibility.Rule     `json:"rules"`
+	Reasons   []apperrors.FieldError `json:"reasons"`
+}
+
+// GraphQLRequest - POST /graphql tanasi
+type GraphQLRequest struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// GraphQLResponse - POST /graphql javobi. Bajarilish xatoliklarida data qisman bo'lishi mumkin.
+type GraphQLResponse struct {
+	Data       json.RawMessage   `json:"data"`
+	Errors     []GraphQLError    `json:"errors,omitempty"`
+	Extensions GraphQLExtensions `json:"extensions"`
+}
+
+// GraphQLError - errors[] elementi, code va request_id extensions da
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions GraphQLErrorExtensions `json:"extensions"`
+}
+
+// GraphQLErrorExtensions - REST ErrorResponse dagi barqaror kod va maydon xatoliklari
+type GraphQLErrorExtensions struct {
+	Code      string                 `json:"code"`
+	RequestID string                 `json:"request_id"`
+	Details   []apperrors.FieldError `json:"details,omitempty"`
+}
+
+// GraphQLExtensions - javobning extensions qismi
+type GraphQLExtensions struct {
+	Cost GraphQLCost `json:"cost"`
+}
+
+// GraphQLCost - so'rovning hisoblangan narxi, chuqurligi va ularning chegaralari
+type GraphQLCost struct {
+	Cost     int `json:"cost"`
+	Depth    int `json:"depth"`
+	MaxCost  int `json:"max_cost"`
+	MaxDepth int `json:"max_depth"`
+}
+
+var companyIDQuery = Query{Name: "company_id", Type: "uuid", Description: "Only records of this company"}
+
+// Routes - api.SetupRouter dagi barcha endpointlar. Yangi route qo'shilsa shu yerga ham
+// yoziladi, aks holda Diff (va integratsion stsenariy) farqni ko'rsatadi.
+var Routes = []Route{
+	{Name: "CreateUser", Method: http.MethodPost, Path: "/users/", Tag: "users", Summary: "Create a user", Body: models.UserCreate{}, Status: http.StatusCreated, Response: models.User{}},
+	{Name: "GetUserByID", Method: http.MethodGet, Path: "/users/:id", Tag: "users", Summary: "Get a user", Response: models.User{}, ETag: true, Expand: audit.EntityUser},
+	{Name: "GetAllUsers", Method: http.MethodGet, Path: "/users/", Tag: "users", Summary: "List users", Response: []models.User{}, Expand: audit.EntityUser, Query: []Query{
+		{Name: "age", Type: "integer", Description: "Exact age in full years"},
+		{Name: "gender", Type: "string", Description: "m or f"},
+	}},
+	{Name: "GetDuplicateUsers", Method: http.MethodGet, Path: "/users/duplicates", Tag: "users", Summary: "Find users sharing an email, phone or name and birthday", Response: []models.DuplicateGroup{}},
+	{Name: "MergeUsers", Method: http.MethodPost, Path: "/users/merge", Tag: "users", Summary: "Merge a duplicate user into another one", Body: models.MergeUsers{}, Response: models.MergeResult{}, ETag: true},
+	{Name: "UpdateUser", Method: http.MethodPut, Path: "/users/:id", Tag: "users", Summary: "Replace a user", Body: models.UserUpdate{}, Response: Message{}, IfMatch: true, ETag: true},
+	{Name: "PatchUser", Method: http.MethodPatch, Path: "/users/:id", Tag: "users", Summary: "Partially update a user", Body: models.UserUpdate{}, Patch: true, Response: models.User{}, IfMatch: true, ETag: true},
+	{Name: "DeleteUser", Method: http.MethodDelete, Path: "/users/:id", Tag: "users", Summary: "Delete a user", Response: Message{}, IfMatch: true},
+	{Name: "GetUserInterviews", Method: http.MethodGet, Path: "/users/:id/myInterview", Tag: "users", Summary: "Interviews of a user", Response: []models.Interview{}},
+	{Name: "GetUserResumes", Method: http.MethodGet, Path: "/users/:id/myresume", Tag: "users", Summary: "Resumes of a user", Response: []models.Resume{}},
+	{Name: "GetUserHistory", Method: http.MethodGet, Path: "/users/:id/history", Tag: "users", Summary: "Audit history of a user", Response: []models.AuditEntry{}},
+	{Name: "GetNotificationPreferences", Method: http.MethodGet, Path: "/users/:id/notification-preferences", Tag: "notifications", Summary: "Notification preferences of a user", Response: models.NotificationPreference{}},
+	{Name: "UpdateNotificationPreferences", Method: http.MethodPut, Path: "/users/:id/notification-preferences", Tag: "notifications", Summary: "Update notification preferences", Body: models.UpdateNotificationPreference{}, Response: models.NotificationPreference{}},
+	{Name: "OptIn", Method: http.MethodPost, Path: "/users/:id/notification-preferences/opt-in", Tag: "notifications", Summary: "Enable a notification channel", Body: models.ChannelOptIn{}, Response: models.NotificationPreference{}},
+	{Name: "OptOut", Method: http.MethodPost, Path: "/users/:id/notification-preferences/opt-out", Tag: "notifications", Summary: "Disable a notification channel", Body: models.ChannelOptIn{}, Response: models.NotificationPreference{}},
+
+	{Name: "CreateResume", Method: http.MethodPost, Path: "/resumes/", Tag: "resumes", Summary: "Create a resume", Body: models.CreateResume{}, Status: http.StatusCreated, Response: models.Resume{}},
+	{Name: "GetResumeByID", Method: http.MethodGet, Path: "/resumes/:id", Tag: "resumes", Summary: "Get a resume", Response: models.Resume{}, ETag: true, Expand: audit.EntityResume},
+	{Name: "GetAllResumes", Method: http.MethodGet, Path: "/resumes/", Tag: "resumes", Summary: "List resumes with their owners", Response: []models.ResumeWithUser{}, Expand: audit.EntityResume, Query: []Query{
+		{Name: "position", Type: "string", Description: "Case-insensitive substring of the position"},
+		{Name: "min_exp", Type: "integer", Description: "Minimum years of experience"},
+	}},
+	{Name: "UpdateResume", Method: http.MethodPut, Path: "/resumes/:id", Tag: "resumes", Summary: "Replace a resume", Body: models.UpdateResume{}, Response: Message{}, IfMatch: true, ETag: true},
+	{Name: "PatchResume", Method: http.MethodPatch, Path: "/resumes/:id", Tag: "resumes", Summary: "Partially update a resume", Body: models.UpdateResume{}, Patch: true, Response: models.Resume{}, IfMatch: true, ETag: true},
+	{Name: "DeleteResume", Method: http.MethodDelete, Path: "/resumes/:id", Tag: "resumes", Summary: "Delete a resume", Response: Message{}, IfMatch: true},
+	{Name: "GetResumeHistory", Method: http.MethodGet, Path: "/resumes/:id/history", Tag: "resumes", Summary: "Audit history of a resume", Response: []models.AuditEntry{}},
+
+	{Name: "CreateCompany", Method: http.MethodPost, Path: "/companies/", Tag: "companies", Summary: "Create a company", Body: models.CreateCompany{}, Status: http.StatusCreated, Response: models.Company{}},
+	{Name: "GetCompanyByID", Method: http.MethodGet, Path: "/companies/:id", Tag: "companies", Summary: "Get a company", Response: models.Company{}, ETag: true, Expand: audit.EntityCompany},
+	{Name: "GetAllCompanies", Method: http.MethodGet, Path: "/companies/", Tag: "companies", Summary: "List companies", Response: []models.Company{}, Expand: audit.EntityCompany},
+	{Name: "UpdateCompany", Method: http.MethodPut, Path: "/companies/:id", Tag: "companies", Summary: "Replace a company", Body: models.UpdateCompany{}, Response: Message{}, IfMatch: true, ETag: true},
+	{Name: "PatchCompany", Method: http.MethodPatch, Path: "/companies/:id", Tag: "companies", Summary: "Partially update a company", Body: models.UpdateCompany{}, Patch: true, Response: models.Company{}, IfMatch: true, ETag: true},
+	{Name: "DeleteCompany", Method: http.MethodDelete, Path: "/companies/:id", Tag: "companies", Summary: "Delete a company with its vacancies, recruiters and interviews", Response: Message{}, IfMatch: true},
+	{Name: "GetCompanyHistory", Method: http.MethodGet, Path: "/companies/:id/history", Tag: "companies", Summary: "Audit history of a company", Response: []models.AuditEntry{}},
+	{Name: "GetCompanyRules", Method: http.MethodGet, Path: "/companies/:id/eligibility-rules", Tag: "eligibility", Summary: "Eligibility rules of a company", Response: CompanyRules{}},
+	{Name: "ReplaceCompanyRules", Method: http.MethodPut, Path: "/companies/:id/eligibility-rules", Tag: "eligibility", Summary: "Replace eligibility rules of a company", Body: eligibility.RuleSet{}, Response: CompanyRules{}},
+
+	{Name: "CreateRecruiter", Method: http.MethodPost, Path: "/recruiters/", Tag: "recruiters", Summary: "Create a recruiter", Body: models.CreateRecruiter{}, Status: http.StatusCreated, Response: models.Recruiter{}},
+	{Name: "GetRecruiterByID", Method: http.MethodGet, Path: "/recruiters/:id", Tag: "recruiters", Summary: "Get a recruiter", Response: models.Recruiter{}, ETag: true, Expand: audit.EntityRecruiter},
+	{Name: "GetAllRecruiters", Method: http.MethodGet, Path: "/recruiters/", Tag: "recruiters", Summary: "List recruiters", Response: []models.Recruiter{}, Expand: audit.EntityRecruiter, Query: []Query{
+		{Name: "age", Type: "integer", Description: "Exact age in full years"},
+		{Name: "gender", Type: "string", Description: "m or f"},
+		companyIDQuery,
+	}},
+	{Name: "UpdateRecruiter", Method: http.MethodPut, Path: "/recruiters/:id", Tag: "recruiters", Summary: "Replace a recruiter", Body: models.UpdateRecruiter{}, Response: Message{}, IfMatch: true, ETag: true},
+	{Name: "PatchRecruiter", Method: http.MethodPatch, Path: "/recruiters/:id", Tag: "recruiters", Summary: "Partially update a recruiter", Body: models.UpdateRecruiter{}, Patch: true, Response: models.Recruiter{}, IfMatch: true, ETag: true},
+	{Name: "DeleteRecruiter", Method: http.MethodDelete, Path: "/recruiters/:id", Tag: "recruiters", Summary: "Delete a recruiter with their interviews", Response: Message{}, IfMatch: true},
+	{Name: "GetRecruiterHistory", Method: http.MethodGet, Path: "/recruiters/:id/history", Tag: "recruiters", Summary: "Audit history of a recruiter", Response: []models.AuditEntry{}},
+
+	{Name: "CreateVacancy", Method: http.MethodPost, Path: "/vacancies/", Tag: "vacancies", Summary: "Create a vacancy", Body: models.CreateVacancy{}, Status: http.StatusCreated, Response: models.Vacancy{}},
+	{Name: "GetVacancyByID", Method: http.MethodGet, Path: "/vacancies/:id", Tag: "vacancies", Summary: "Get a vacancy", Response: models.Vacancy{}, ETag: true, Expand: audit.EntityVacancy},
+	{Name: "GetAllVacancies", Method: http.MethodGet, Path: "/vacancies/", Tag: "vacancies", Summary: "List vacancies", Response: []models.Vacancy{}, Expand: audit.EntityVacancy, Query: []Query{
+		{Name: "position", Type: "string", Description: "Case-insensitive substring of the position"},
+		{Name: "min_exp", Type: "integer", Description: "Vacancies requiring at least this many years"},
+		companyIDQuery,
+	}},
+	{Name: "UpdateVacancy", Method: http.MethodPut, Path: "/vacancies/:id", Tag: "vacancies", Summary: "Replace a vacancy", Body: models.UpdateVacancy{}, Response: Message{}, IfMatch: true, ETag: true},
+	{Name: "PatchVacancy", Method: http.MethodPatch, Path: "/vacancies/:id", Tag: "vacancies", Summary: "Partially update a vacancy", Body: models.UpdateVacancy{}, Patch: true, Response: models.Vacancy{}, IfMatch: true, ETag: true},
+	{Name: "DeleteVacancy", Method: http.MethodDelete, Path: "/vacancies/:id", Tag: "vacancies", Summary: "Delete a vacancy with its interviews", Response: Message{}, IfMatch: true},
+	{Name: "GetVacancyHistory", Method: http.MethodGet, Path: "/vacancies/:id/history", Tag: "vacancies", Summary: "Audit history of a vacancy", Response: []models.AuditEntry{}},
+	{Name: "GetVacancyRules", Method: http.MethodGet, Path: "/vacancies/:id/eligibility-rules", Tag: "eligibility", Summary: "Own and effective eligibility rules of a vacancy", Response: VacancyRules{}},
+	{Name: "ReplaceVacancyRules", Method: http.MethodPut, Path: "/vacancies/:id/eligibility-rules", Tag: "eligibility", Summary: "Replace eligibility rules of a vacancy", Body: eligibility.RuleSet{}, Response: VacancyRules{}},
+	{Name: "CheckEligibility", Method: http.MethodPost, Path: "/vacancies/:id/eligibility-check", Tag: "eligibility", Summary: "Check a candidate against the vacancy rules without creating an interview", Body: handlers.EligibilityCheckRequest{}, Response: EligibilityCheck{}},
+
+	{Name: "CreateInterview", Method: http.MethodPost, Path: "/interviews/", Tag: "interviews", Summary: "Schedule an interview for an eligible candidate", Body: models.CreateInterview{}, Status: http.StatusCreated, Response: models.Interview{}},
+	{Name: "GetInterviewByID", Method: http.MethodGet, Path: "/interviews/:id", Tag: "interviews", Summary: "Get an interview", Response: models.Interview{}, ETag: true, Expand: audit.EntityInterview},
+	{Name: "GetAllInterviews", Method: http.MethodGet, Path: "/interviews/", Tag: "interviews", Summary: "List interviews", Response: []models.Interview{}, Expand: audit.EntityInterview, Query: []Query{
+		{Name: "company_id", Type: "uuid", Description: "Interviews held by recruiters of this company"},
+		{Name: "position", Type: "string", Description: "Case-insensitive substring of the vacancy position"},
+		{Name: "experience", Type: "integer", Description: "Candidates with a resume of at least this many years"},
+	}},
+	{Name: "UpdateInterview", Method: http.MethodPut, Path: "/interviews/:id", Tag: "interviews", Summary: "Replace an interview", Body: models.UpdateInterview{}, Response: InterviewStatus{}, IfMatch: true, ETag: true},
+	{Name: "PatchInterview", Method: http.MethodPatch, Path: "/interviews/:id", Tag: "interviews", Summary: "Partially update an interview", Body: models.UpdateInterview{}, Patch: true, Response: models.Interview{}, IfMatch: true, ETag: true},
+	{Name: "DeleteInterview", Method: http.MethodDelete, Path: "/interviews/:id", Tag: "interviews", Summary: "Cancel an interview", Response: InterviewStatus{}, IfMatch: true},
+	{Name: "GetInterviewHistory", Method: http.MethodGet, Path: "/interviews/:id/history", Tag: "interviews", Summary: "Audit history of an interview", Response: []models.AuditEntry{}},
+
+	{Name: "TelegramWebhook", Method: http.MethodPost, Path: "/telegram/webhook", Tag: "notifications", Summary: "Telegram bot updates (/start <user_id>, /stop)", Body: notification.TelegramUpdate{}, Response: TelegramAck{}},
+
+	{Name: "CreateWebhook", Method: http.MethodPost, Path: "/webhooks/", Tag: "webhooks", Summary: "Subscribe a URL to company events", Body: models.CreateWebhook{}, Status: http.StatusCreated, Response: models.Webhook{}},
+	{Name: "GetWebhookByID", Method: http.MethodGet, Path: "/webhooks/:id", Tag: "webhooks", Summary: "Get a webhook", Response: models.Webhook{}, ETag: true},
+	{Name: "GetAllWebhooks", Method: http.MethodGet, Path: "/webhooks/", Tag: "webhooks", Summary: "List webhooks", Response: []models.Webhook{}, Query: []Query{companyIDQuery}},
+	{Name: "DeleteWebhook", Method: http.MethodDelete, Path: "/webhooks/:id", Tag: "webhooks", Summary: "Delete a webhook", Response: Message{}, IfMatch: true},
+	{Name: "GetWebhookDeliveries", Method: http.MethodGet, Path: "/webhooks/:id/deliveries", Tag: "webhooks", Summary: "Delivery attempts of a webhook", Response: []models.WebhookDelivery{}},
+	{Name: "GetWebhookHistory", Method: http.MethodGet, Path: "/webhooks/:id/history", Tag: "webhooks", Summary: "Audit history of a webhook", Response: []models.AuditEntry{}},
+
+	{Name: "GetAuditEntries", Method: http.MethodGet, Path: "/audit", Tag: "audit", Summary: "Audit log entries", Response: []models.AuditEntry{}, Query: []Query{
+		{Name: "entity", Type: "string", Description: "Entity type, e.g. user or vacancy"},
+		{Name: "id", Type: "string", Description: "Entity ID"},
+	}},
+
+	{Name: "ImportUsers", Method: http.MethodPost, Path: "/imports/users", Tag: "imports", Summary: "Create users from a CSV or XLSX file", Form: handlers.ImportForm{}, Response: models.ImportReport{}},
+	{Name: "ImportResumes", Method: http.MethodPost, Path: "/imports/resumes", Tag: "imports", Summary: "Create resumes from a CSV or XLSX file (user_id or user_email column)", Form: handlers.ImportForm{}, Response: models.ImportReport{}},
+	{Name: "ImportVacancies", Method: http.MethodPost, Path: "/imports/vacancies", Tag: "imports", Summary: "Create vacancies from a CSV or XLSX file", Form: handlers.ImportForm{}, Response: models.ImportReport{}},
+
+	{Name: "GraphQL", Method: http.MethodPost, Path: "/graphql", Tag: "graphql", Summary: "GraphQL queries over companies, vacancies, interviews, users and resumes (batched, cost-limited)", Body: GraphQLRequest{}, Response: GraphQLResponse{}},
+
+	{Name: "GetOpenAPI", Method: http.MethodGet, Path: "/openapi.json", Tag: "docs", Summary: "This OpenAPI document", Response: map[string]interface{}{}},
+	{Name: "GetDocs", Method: http.MethodGet, Path: "/docs", Tag: "docs", Summary: "Swagger UI", HTML: true},
+}
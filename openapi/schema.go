@@ -0,0 +1,217 @@
+package openapi
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"hrplatform/validation"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx/types"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	uuidType     = reflect.TypeOf(uuid.UUID{})
+	nullUUIDType = reflect.TypeOf(uuid.NullUUID{})
+	jsonTextType = reflect.TypeOf(types.JSONText{})
+	rawJSONType  = reflect.TypeOf(json.RawMessage{})
+	fileType     = reflect.TypeOf(&multipart.FileHeader{})
+)
+
+// generator Go turlaridan sxema yasaydi. Nomli structlar components/schemas ga bir marta
+// yoziladi va $ref orqali ishlatiladi, nomsizlari joyida ochiladi.
+type generator struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newGenerator() *generator {
+	return &generator{schemas: make(map[string]*Schema), names: make(map[reflect.Type]string)}
+}
+
+// schemaFor namunaviy qiymatning sxemasi: models.User{} yoki []models.User{}
+func (g *generator) schemaFor(v interface{}) *Schema {
+	return g.schemaOf(reflect.TypeOf(v))
+}
+
+func (g *generator) schemaOf(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	case nullUUIDType:
+		return &Schema{Type: "string", Format: "uuid", Nullable: true}
+	case jsonTextType, rawJSONType:
+		return &Schema{Description: "Arbitrary JSON value"}
+	case fileType:
+		return &Schema{Type: "string", Format: "binary"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		schema := g.schemaOf(t.Elem())
+		if schema.Ref == "" {
+			schema.Nullable = true
+		}
+		return schema
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return ref(g.register(t))
+	}
+	// interface{} va boshqalar: istalgan qiymat
+	return &Schema{}
+}
+
+// register nomli structni components/schemas ga qo'shadi va nomini qaytaradi. Turli
+// paketlarda bir xil nom uchrasa paket nomi old qo'shimcha bo'ladi: EligibilityRule.
+func (g *generator) register(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+	name := t.Name()
+	if _, taken := g.schemas[name]; taken {
+		pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+		name = strings.ToUpper(pkg[:1]) + pkg[1:] + name
+	}
+	g.names[t] = name
+	g.schemas[name] = &Schema{} // o'ziga havola qiluvchi turlar uchun oldindan band qilinadi
+	*g.schemas[name] = *g.structSchema(t)
+	return name
+}
+
+func (g *generator) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			embedded := g.structSchema(field.Type)
+			for key, value := range embedded.Properties {
+				schema.Properties[key] = value
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property := g.schemaOf(field.Type)
+		if applyBinding(property, field.Tag.Get("binding")) {
+			schema.Required = append(schema.Required, name)
+		}
+		schema.Properties[name] = property
+	}
+	return schema
+}
+
+// applyBinding gin `binding` tegidagi qoidalarni sxema cheklovlariga aylantiradi va maydon
+// majburiy ekanini qaytaradi. "dive" dan keyingi qoidalar massiv elementlariga tegishli.
+func applyBinding(schema *Schema, tag string) (required bool) {
+	if tag == "" || schema.Ref != "" {
+		return strings.Contains(tag, "required")
+	}
+	target := schema
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "required":
+			required = target == schema
+		case "dive":
+			if target.Items == nil {
+				return required
+			}
+			target = target.Items
+		case "min", "max":
+			setBound(target, name, param)
+		case "email":
+			target.Format = "email"
+		case "http_url":
+			target.Format = "uri"
+		case "oneof":
+			target.Enum = strings.Fields(param)
+		case "gender":
+			target.Enum = []string{"m", "f"}
+		case "date":
+			target.Format = "date"
+		case "pastdate":
+			target.Format = "date"
+			target.Description = "Date in the past, YYYY-MM-DD"
+		case "datetime":
+			if param == validation.DateTimeLayout {
+				target.Pattern = `^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}$`
+			}
+			target.Description = "Local date and time, " + param
+		case "phone":
+			target.Description = "E.164 or Uzbek phone number, e.g. +998 90 123 45 67"
+		}
+	}
+	return required
+}
+
+// setBound min/max ni turga qarab uzunlik, qiymat yoki elementlar soni chegarasiga aylantiradi
+func setBound(schema *Schema, name, param string) {
+	switch schema.Type {
+	case "string":
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return
+		}
+		if name == "min" {
+			schema.MinLength = &n
+		} else {
+			schema.MaxLength = &n
+		}
+	case "array":
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return
+		}
+		if name == "min" {
+			schema.MinItems = &n
+		} else {
+			schema.MaxItems = &n
+		}
+	case "integer", "number":
+		f, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return
+		}
+		if name == "min" {
+			schema.Minimum = &f
+		} else {
+			schema.Maximum = &f
+		}
+	}
+}
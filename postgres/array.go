@@ -0,0 +1,16 @@
+package postgres
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// uuidArray ID larni "= ANY($1::uuid[])" so'rovlari uchun Postgres massiviga aylantiradi.
+// uuid.UUID [16]byte bo'lgani uchun pq.Array uni ichma-ich massiv deb o'qiydi, shuning uchun matn ishlatiladi.
+func uuidArray(ids []uuid.UUID) pq.StringArray {
+	values := make(pq.StringArray, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	return values
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"hrplatform/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type AuditRepository interface {
+	CreateEntry(ctx context.Context, entry models.AuditEntry) error
+	GetEntries(ctx context.Context, entityType, entityID string) ([]models.AuditEntry, error)
+}
+
+type PostgresAuditRepository struct {
+	DB *sqlx.DB
+}
+
+func (r *PostgresAuditRepository) CreateEntry(ctx context.Context, entry models.AuditEntry) error {
+	query := `INSERT INTO audit_log (id, actor, entity_type, entity_id, action, before, after, diff, request_id, ip, created_at)
+              VALUES (:id, :actor, :entity_type, :entity_id, :action, :before, :after, :diff, :request_id, :ip, :created_at)`
+	_, err := conn(ctx, r.DB).NamedExecContext(ctx, query, entry)
+	return dbError(err, nil)
+}
+
+// GetEntries yozuvlarni eng yangisidan boshlab qaytaradi. Bo'sh parametrlar filtrlanmaydi.
+func (r *PostgresAuditRepository) GetEntries(ctx context.Context, entityType, entityID string) ([]models.AuditEntry, error) {
+	entries := []models.AuditEntry{}
+	query := `SELECT * FROM audit_log WHERE 1 = 1`
+	args := []interface{}{}
+
+	if entityType != "" {
+		args = append(args, entityType)
+		query += fmt.Sprintf(" AND entity_type = $%d", len(args))
+	}
+	if entityID != "" {
+		args = append(args, entityID)
+		query += fmt.Sprintf(" AND entity_id = $%d", len(args))
+	}
+	query += " ORDER BY created_at DESC"
+
+	err := conn(ctx, r.DB).SelectContext(ctx, &entries, query, args...)
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return entries, nil
+}
@@ -1,8 +1,10 @@
 package postgres
 
 import (
+	"context"
 	"time"
 
+	"hrplatform/apperrors"
 	"hrplatform/models"
 
 	"github.com/google/uuid"
@@ -10,18 +12,19 @@ import (
 )
 
 type CompanyRepository interface {
-	CreateCompany(companyCreate models.CreateCompany) (models.Company, error)
-	GetCompanyByID(id string) (models.Company, error)
-	GetAllCompanies() ([]models.Company, error)
-	UpdateCompany(companyUpdate models.UpdateCompany) error
-	DeleteCompany(id string) error
+	CreateCompany(ctx context.Context, companyCreate models.CreateCompany) (models.Company, error)
+	GetCompanyByID(ctx context.Context, id string) (models.Company, error)
+	GetAllCompanies(ctx context.Context) ([]models.Company, error)
+	GetCompaniesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Company, error)
+	UpdateCompany(ctx context.Context, companyUpdate models.UpdateCompany) error
+	DeleteCompany(ctx context.Context, id string) error
 }
 
 type PostgresCompanyRepository struct {
 	DB *sqlx.DB
 }
 
-func (r *PostgresCompanyRepository) CreateCompany(companyCreate models.CreateCompany) (models.Company, error) {
+func (r *PostgresCompanyRepository) CreateCompany(ctx context.Context, companyCreate models.CreateCompany) (models.Company, error) {
 	company := models.Company{
 		ID:        uuid.New(),
 		Name:      companyCreate.Name,
@@ -30,63 +33,76 @@ func (r *PostgresCompanyRepository) CreateCompany(companyCreate models.CreateCom
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 		DeletedAt: 0,
+		Version:   1,
 	}
 
 	query := `INSERT INTO companies (id, name, location, workers, created_at, updated_at, deleted_at) 
 	          VALUES (:id, :name, :location, :workers, :created_at, :updated_at, :deleted_at)`
-	_, err := r.DB.NamedExec(query, company)
+	_, err := conn(ctx, r.DB).NamedExecContext(ctx, query, company)
 	if err != nil {
-		return models.Company{}, err
+		return models.Company{}, dbError(err, nil)
 	}
 
 	var createdCompany models.Company
-	err = r.DB.Get(&createdCompany, "SELECT * FROM companies WHERE id = $1", company.ID)
+	err = conn(ctx, r.DB).GetContext(ctx, &createdCompany, "SELECT * FROM companies WHERE id = $1", company.ID)
 	if err != nil {
-		return models.Company{}, err
+		return models.Company{}, dbError(err, apperrors.NotFound("company_not_found", "Company not found"))
 	}
 
 	return createdCompany, nil
 }
 
 
-func (r *PostgresCompanyRepository) GetCompanyByID(id string) (models.Company, error) {
+func (r *PostgresCompanyRepository) GetCompanyByID(ctx context.Context, id string) (models.Company, error) {
 	var company models.Company
 	query := `SELECT * FROM companies WHERE id = $1`
-	err := r.DB.Get(&company, query, id)
+	err := conn(ctx, r.DB).GetContext(ctx, &company, query, id)
 	if err != nil {
-		return models.Company{}, err
+		return models.Company{}, dbError(err, apperrors.NotFound("company_not_found", "Company not found"))
 	}
 	return company, nil
 }
 
-func (r *PostgresCompanyRepository) GetAllCompanies() ([]models.Company, error) {
+func (r *PostgresCompanyRepository) GetAllCompanies(ctx context.Context) ([]models.Company, error) {
 	var companies []models.Company
 	query := `SELECT * FROM companies WHERE deleted_at = 0` // Exclude deleted companies
-	err := r.DB.Select(&companies, query)
+	err := conn(ctx, r.DB).SelectContext(ctx, &companies, query)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, nil)
 	}
 	return companies, nil
 }
-func (r *PostgresCompanyRepository) UpdateCompany(companyUpdate models.UpdateCompany) error {
+
+// GetCompaniesByIDs bir nechta kompaniyani bitta so'rovda oladi (GraphQL loaderlari uchun).
+// GetCompanyByID kabi o'chirilganlar ham qaytariladi, topilmagan ID lar o'tkazib yuboriladi.
+func (r *PostgresCompanyRepository) GetCompaniesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Company, error) {
+	var companies []models.Company
+	query := `SELECT * FROM companies WHERE id = ANY($1::uuid[]) ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &companies, query, uuidArray(ids))
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return companies, nil
+}
+// UpdateCompany faqat bazadagi versiya companyUpdate.Version ga teng bo'lsa yozadi (compare-and-set)
+func (r *PostgresCompanyRepository) UpdateCompany(ctx context.Context, companyUpdate models.UpdateCompany) error {
 	query := `
 		UPDATE companies
 		SET name = :name,
 			location = :location,
 			workers = :workers,
-			updated_at = NOW()
-		WHERE id = :id AND deleted_at = 0
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = :id AND deleted_at = 0 AND version = :version
 	`
 
-	_, err := r.DB.NamedExec(query, companyUpdate)
-	if err != nil {
-		return err
-	}
-	return nil
+	return versionChecked(conn(ctx, r.DB).NamedExecContext(ctx, query, companyUpdate))
 }
 
-func (r *PostgresCompanyRepository) DeleteCompany(id string) error {
-	query := `DELETE FROM vacancies WHERE id = $1`
-	_, err := r.DB.Exec(query, id)
-	return err
+// DeleteCompany kompaniyani soft delete qiladi. Bog'liq vakansiya, rekruiter va webhooklar
+// CompanyHandler da UnitOfWork ichida oldinroq o'chiriladi.
+func (r *PostgresCompanyRepository) DeleteCompany(ctx context.Context, id string) error {
+	query := `UPDATE companies SET deleted_at = EXTRACT(EPOCH FROM NOW()), updated_at = NOW(), version = version + 1 WHERE id = $1`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
+	return dbError(err, nil)
 }
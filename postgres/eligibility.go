@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"hrplatform/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type EligibilityRuleRepository interface {
+	GetCompanyRules(ctx context.Context, companyID uuid.UUID) ([]models.EligibilityRule, error)
+	GetVacancyRules(ctx context.Context, vacancyID uuid.UUID) ([]models.EligibilityRule, error)
+	ReplaceCompanyRules(ctx context.Context, companyID uuid.UUID, rules []models.EligibilityRule) error
+	ReplaceVacancyRules(ctx context.Context, vacancyID uuid.UUID, rules []models.EligibilityRule) error
+}
+
+type PostgresEligibilityRuleRepository struct {
+	DB *sqlx.DB
+}
+
+func (r *PostgresEligibilityRuleRepository) GetCompanyRules(ctx context.Context, companyID uuid.UUID) ([]models.EligibilityRule, error) {
+	return r.getRules(ctx, "company_id", companyID)
+}
+
+func (r *PostgresEligibilityRuleRepository) GetVacancyRules(ctx context.Context, vacancyID uuid.UUID) ([]models.EligibilityRule, error) {
+	return r.getRules(ctx, "vacancy_id", vacancyID)
+}
+
+// ReplaceCompanyRules kompaniyaning barcha qoidalarini rules bilan almashtiradi.
+// DELETE va INSERT lar bitta tranzaksiyada bo'lishi uchun UnitOfWork ichida chaqiring.
+func (r *PostgresEligibilityRuleRepository) ReplaceCompanyRules(ctx context.Context, companyID uuid.UUID, rules []models.EligibilityRule) error {
+	for i := range rules {
+		rules[i].CompanyID = uuid.NullUUID{UUID: companyID, Valid: true}
+		rules[i].VacancyID = uuid.NullUUID{}
+	}
+	return r.replaceRules(ctx, "company_id", companyID, rules)
+}
+
+// ReplaceVacancyRules vakansiyaning barcha qoidalarini rules bilan almashtiradi
+func (r *PostgresEligibilityRuleRepository) ReplaceVacancyRules(ctx context.Context, vacancyID uuid.UUID, rules []models.EligibilityRule) error {
+	for i := range rules {
+		rules[i].CompanyID = uuid.NullUUID{}
+		rules[i].VacancyID = uuid.NullUUID{UUID: vacancyID, Valid: true}
+	}
+	return r.replaceRules(ctx, "vacancy_id", vacancyID, rules)
+}
+
+// column faqat ichki qiymatlar ("company_id", "vacancy_id"), foydalanuvchidan kelmaydi
+func (r *PostgresEligibilityRuleRepository) getRules(ctx context.Context, column string, id uuid.UUID) ([]models.EligibilityRule, error) {
+	rules := []models.EligibilityRule{}
+	query := `SELECT * FROM eligibility_rules WHERE ` + column + ` = $1 ORDER BY position`
+	err := conn(ctx, r.DB).SelectContext(ctx, &rules, query, id)
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return rules, nil
+}
+
+func (r *PostgresEligibilityRuleRepository) replaceRules(ctx context.Context, column string, id uuid.UUID, rules []models.EligibilityRule) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, `DELETE FROM eligibility_rules WHERE `+column+` = $1`, id)
+	if err != nil {
+		return dbError(err, nil)
+	}
+
+	query := `INSERT INTO eligibility_rules (id, company_id, vacancy_id, type, params, position, created_at)
+              VALUES (:id, :company_id, :vacancy_id, :type, :params, :position, :created_at)`
+	for _, rule := range rules {
+		if _, err := conn(ctx, r.DB).NamedExecContext(ctx, query, rule); err != nil {
+			return dbError(err, nil)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"hrplatform/apperrors"
+
+	"github.com/lib/pq"
+)
+
+// Postgres xatolik kodlari: https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pqUniqueViolation     = "23505"
+	pqForeignKeyViolation = "23503"
+	pqCheckViolation      = "23514"
+	pqNotNullViolation    = "23502"
+	pqInvalidText         = "22P02"
+	pqQueryCanceled       = "57014"
+)
+
+// dbError drayver xatoliklarini domen xatoliklariga aylantiradi.
+// sql.ErrNoRows bo'lsa notFound qaytariladi.
+func dbError(err error, notFound *apperrors.Error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) && notFound != nil {
+		return notFound
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return apperrors.Timeout(err)
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case pqUniqueViolation:
+			appErr := apperrors.Conflict("already_exists", "Record already exists")
+			appErr.Err = err
+			return appErr
+		case pqForeignKeyViolation:
+			appErr := apperrors.Validation("invalid_reference", "Referenced record does not exist")
+			appErr.Err = err
+			return appErr
+		case pqCheckViolation, pqNotNullViolation:
+			appErr := apperrors.Validation("constraint_violation", "Value violates a database constraint")
+			if pqErr.Column != "" {
+				appErr.WithField(pqErr.Column, pqErr.Message)
+			}
+			appErr.Err = err
+			return appErr
+		case pqQueryCanceled:
+			// context bekor qilinganda drayver so'rovni server tomonda to'xtatadi
+			return apperrors.Timeout(err)
+		case pqInvalidText:
+			appErr := apperrors.Validation("invalid_id", "Invalid ID format")
+			appErr.Err = err
+			return appErr
+		}
+	}
+
+	return apperrors.Internal(err)
+}
+
+// versionChecked compare-and-set UPDATE natijasini tekshiradi: birorta ham qator
+// yangilanmagan bo'lsa, yozuv versiyasi kutilganidan farq qiladi (yoki yozuv o'chgan).
+func versionChecked(result sql.Result, err error) error {
+	if err != nil {
+		return dbError(err, nil)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return dbError(err, nil)
+	}
+	if rows == 0 {
+		return apperrors.PreconditionFailed("version_mismatch", "The record was modified by another request")
+	}
+	return nil
+}
+
+// uniqueError unikal indeks buzilganda indeks nomiga mos domen xatoligini qaytaradi
+// (masalan users_email_unique_idx -> user_email_taken). Qolgan hollarda dbError ishlaydi.
+func uniqueError(err error, byConstraint map[string]*apperrors.Error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation {
+		if appErr, ok := byConstraint[pqErr.Constraint]; ok {
+			appErr.Err = err
+			return appErr
+		}
+	}
+	return dbError(err, nil)
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type IdempotencyRepository interface {
+	Reserve(ctx context.Context, record models.IdempotencyKey) (models.IdempotencyKey, bool, error)
+	Complete(ctx context.Context, record models.IdempotencyKey) error
+	Release(ctx context.Context, key, method, path string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type PostgresIdempotencyRepository struct {
+	DB *sqlx.DB
+}
+
+// Reserve kalitni "bajarilmoqda" holatida band qiladi. Kalit yangi bo'lsa yoki
+// muddati o'tgan bo'lsa, record yoziladi va ikkinchi qiymat true bo'ladi.
+// Aks holda mavjud yozuv qaytariladi (false).
+func (r *PostgresIdempotencyRepository) Reserve(ctx context.Context, record models.IdempotencyKey) (models.IdempotencyKey, bool, error) {
+	query := `
+		INSERT INTO idempotency_keys (key, method, path, request_hash, status_code, content_type, response_body, created_at, expires_at)
+		VALUES (:key, :method, :path, :request_hash, 0, '', '', :created_at, :expires_at)
+		ON CONFLICT (key, method, path) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			status_code = 0,
+			content_type = '',
+			response_body = '',
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+	`
+
+	// Mavjud yozuvni o'qishdan oldin u Release qilinib qolishi mumkin, shunda qayta urinamiz
+	for attempt := 0; attempt < 3; attempt++ {
+		result, err := conn(ctx, r.DB).NamedExecContext(ctx, query, record)
+		if err != nil {
+			return models.IdempotencyKey{}, false, dbError(err, nil)
+		}
+		if rows, err := result.RowsAffected(); err == nil && rows == 1 {
+			return record, true, nil
+		}
+
+		var existing models.IdempotencyKey
+		err = conn(ctx, r.DB).GetContext(ctx, &existing, `SELECT * FROM idempotency_keys WHERE key = $1 AND method = $2 AND path = $3`,
+			record.Key, record.Method, record.Path)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return models.IdempotencyKey{}, false, dbError(err, nil)
+		}
+		return existing, false, nil
+	}
+	return models.IdempotencyKey{}, false, apperrors.Conflict("idempotency_key_in_use", "Idempotency key is being processed")
+}
+
+// Complete bajarilgan so'rov javobini saqlaydi
+func (r *PostgresIdempotencyRepository) Complete(ctx context.Context, record models.IdempotencyKey) error {
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = :status_code, content_type = :content_type, response_body = :response_body
+		WHERE key = :key AND method = :method AND path = :path
+	`
+	_, err := conn(ctx, r.DB).NamedExecContext(ctx, query, record)
+	return dbError(err, nil)
+}
+
+// Release band qilingan kalitni o'chiradi, shunda mijoz so'rovni qayta yubora oladi
+func (r *PostgresIdempotencyRepository) Release(ctx context.Context, key, method, path string) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, `DELETE FROM idempotency_keys WHERE key = $1 AND method = $2 AND path = $3`, key, method, path)
+	return dbError(err, nil)
+}
+
+// DeleteExpired muddati o'tgan kalitlarni tozalaydi
+func (r *PostgresIdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result, err := conn(ctx, r.DB).ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, dbError(err, nil)
+	}
+	return result.RowsAffected()
+}
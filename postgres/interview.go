@@ -1,7 +1,8 @@
 package postgres
 
 import (
-	"fmt"
+	"context"
+	"hrplatform/apperrors"
 	"hrplatform/models"
 	"strings"
 	"time"
@@ -11,44 +12,27 @@ import (
 )
 
 type InterviewRepository interface {
-	CreateInterview(interviewCreate models.CreateInterview) (models.Interview, error)
-	GetInterviewByID(id uuid.UUID) (models.Interview, error)
-	GetInterviewsByUserID(userID uuid.UUID) ([]models.Interview, error)
-	GetAllInterviews(filter map[string]interface{}) ([]models.Interview, error)
-	UpdateInterview(interviewUpdate models.UpdateInterview) error
-	DeleteInterview(id uuid.UUID) error
+	CreateInterview(ctx context.Context, interviewCreate models.CreateInterview) (models.Interview, error)
+	GetInterviewByID(ctx context.Context, id uuid.UUID) (models.Interview, error)
+	GetInterviewsByUserID(ctx context.Context, userID uuid.UUID) ([]models.Interview, error)
+	GetAllInterviews(ctx context.Context, filter map[string]interface{}) ([]models.Interview, error)
+	GetInterviewsByVacancyIDs(ctx context.Context, vacancyIDs []uuid.UUID) ([]models.Interview, error)
+	GetInterviewsByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]models.Interview, error)
+	UpdateInterview(ctx context.Context, interviewUpdate models.UpdateInterview) error
+	DeleteInterview(ctx context.Context, id uuid.UUID) error
+	DeleteInterviewsByVacancyID(ctx context.Context, vacancyID uuid.UUID) error
+	DeleteInterviewsByRecruiterID(ctx context.Context, recruiterID uuid.UUID) error
 }
 
 type PostgresInterviewRepository struct {
 	DB *sqlx.DB
 }
 
-func (r *PostgresInterviewRepository) CreateInterview(interviewCreate models.CreateInterview) (models.Interview, error) {
-	
-    var userAge int
-    err := r.DB.Get(&userAge, `SELECT EXTRACT(YEAR FROM AGE(birthday)) FROM users WHERE id = $1`, interviewCreate.UserID)
-    if err != nil {
-        return models.Interview{}, err
-    }
-    if userAge < 18 {
-        return models.Interview{}, fmt.Errorf("user must be at least 18 years old")
-    }
-
-    var resumePosition, vacancyPosition string
-    err = r.DB.Get(&resumePosition, `SELECT position FROM resumes WHERE user_id = $1`, interviewCreate.UserID)
-    if err != nil {
-        return models.Interview{}, err
-    }
-    err = r.DB.Get(&vacancyPosition, `SELECT position FROM vacancies WHERE id = $1`, interviewCreate.VacancyID)
-    if err != nil {
-        return models.Interview{}, err
-    }
-    if resumePosition != vacancyPosition {
-        return models.Interview{}, fmt.Errorf("position in resume and vacancy must match")
-    }
+// CreateInterview faqat yozadi, nomzod qoidalari service.InterviewService da tekshiriladi
+func (r *PostgresInterviewRepository) CreateInterview(ctx context.Context, interviewCreate models.CreateInterview) (models.Interview, error) {
 	interviewDate, err := time.Parse("2006-01-02 15:04:05", interviewCreate.InterviewDate)
 	if err != nil {
-		return models.Interview{}, err
+		return models.Interview{}, apperrors.Validation("invalid_date", "Invalid date format").WithField("interview_date", "expected YYYY-MM-DD HH:MM:SS")
 	}
 
 	interview := models.Interview{
@@ -60,40 +44,63 @@ func (r *PostgresInterviewRepository) CreateInterview(interviewCreate models.Cre
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 		DeletedAt:     0,
+		Version:       1,
 	}
 
 	query := `INSERT INTO interviews (id, user_id, vacancy_id, recruiter_id, interview_date, created_at, updated_at, deleted_at) 
               VALUES (:id, :user_id, :vacancy_id, :recruiter_id, :interview_date, :created_at, :updated_at, :deleted_at)`
 
-	_, err = r.DB.NamedExec(query, interview)
+	_, err = conn(ctx, r.DB).NamedExecContext(ctx, query, interview)
 	if err != nil {
-		return models.Interview{}, err
+		return models.Interview{}, dbError(err, nil)
 	}
 
 	return interview, nil
 }
 
-func (r *PostgresInterviewRepository) GetInterviewByID(id uuid.UUID) (models.Interview, error) {
+func (r *PostgresInterviewRepository) GetInterviewByID(ctx context.Context, id uuid.UUID) (models.Interview, error) {
 	var interview models.Interview
 	query := `SELECT * FROM interviews WHERE id = $1`
-	err := r.DB.Get(&interview, query, id)
+	err := conn(ctx, r.DB).GetContext(ctx, &interview, query, id)
 	if err != nil {
-		return models.Interview{}, err
+		return models.Interview{}, dbError(err, apperrors.NotFound("interview_not_found", "Interview not found"))
 	}
 	return interview, nil
 }
 
-func (r *PostgresInterviewRepository) GetInterviewsByUserID(userID uuid.UUID) ([]models.Interview, error) {
+func (r *PostgresInterviewRepository) GetInterviewsByUserID(ctx context.Context, userID uuid.UUID) ([]models.Interview, error) {
 	var interviews []models.Interview
 	query := `SELECT * FROM interviews WHERE user_id = $1`
-	err := r.DB.Select(&interviews, query, userID)
+	err := conn(ctx, r.DB).SelectContext(ctx, &interviews, query, userID)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, nil)
 	}
 	return interviews, nil
 }
 
-func (r *PostgresInterviewRepository) GetAllInterviews(filter map[string]interface{}) ([]models.Interview, error) {
+// GetInterviewsByVacancyIDs GetAllInterviews kabi faqat o'chirilmagan intervyularni qaytaradi
+func (r *PostgresInterviewRepository) GetInterviewsByVacancyIDs(ctx context.Context, vacancyIDs []uuid.UUID) ([]models.Interview, error) {
+	var interviews []models.Interview
+	query := `SELECT * FROM interviews WHERE vacancy_id = ANY($1::uuid[]) AND deleted_at = 0 ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &interviews, query, uuidArray(vacancyIDs))
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return interviews, nil
+}
+
+// GetInterviewsByUserIDs GetAllInterviews kabi faqat o'chirilmagan intervyularni qaytaradi
+func (r *PostgresInterviewRepository) GetInterviewsByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]models.Interview, error) {
+	var interviews []models.Interview
+	query := `SELECT * FROM interviews WHERE user_id = ANY($1::uuid[]) AND deleted_at = 0 ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &interviews, query, uuidArray(userIDs))
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return interviews, nil
+}
+
+func (r *PostgresInterviewRepository) GetAllInterviews(ctx context.Context, filter map[string]interface{}) ([]models.Interview, error) {
 	var interviews []models.Interview
 	baseQuery := `SELECT * FROM interviews WHERE deleted_at = 0`
 
@@ -103,7 +110,7 @@ func (r *PostgresInterviewRepository) GetAllInterviews(filter map[string]interfa
 	for key, value := range filter {
 		switch key {
 		case "company_id":
-			conditions = append(conditions, "recruiter_id IN (SELECT id FROM recruiters WHERE company_id = $1)")
+			conditions = append(conditions, "recruiter_id IN (SELECT id FROM recruiters WHERE company_id = ?)")
 			args = append(args, value)
 		case "position":
 			conditions = append(conditions, "vacancy_id IN (SELECT id FROM vacancies WHERE position ILIKE ?)")
@@ -118,48 +125,61 @@ func (r *PostgresInterviewRepository) GetAllInterviews(filter map[string]interfa
 		baseQuery += " AND " + strings.Join(conditions, " AND ")
 	}
 
-	err := r.DB.Select(&interviews, baseQuery, args...)
+	// "?" belgilarini Postgres uchun $1, $2 ... ga almashtirish
+	err := conn(ctx, r.DB).SelectContext(ctx, &interviews, r.DB.Rebind(baseQuery), args...)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, nil)
 	}
 
 	return interviews, nil
 }
 
-func (r *PostgresInterviewRepository) UpdateInterview(interviewUpdate models.UpdateInterview) error {
+// UpdateInterview faqat bazadagi versiya interviewUpdate.Version ga teng bo'lsa yozadi (compare-and-set)
+func (r *PostgresInterviewRepository) UpdateInterview(ctx context.Context, interviewUpdate models.UpdateInterview) error {
 	interviewDate, err := time.Parse("2006-01-02 15:04:05", interviewUpdate.InterviewDate)
 	if err != nil {
-		return err
+		return apperrors.Validation("invalid_date", "Invalid date format").WithField("interview_date", "expected YYYY-MM-DD HH:MM:SS")
 	}
 
-	fields := map[string]interface{}{
+	query := `
+		UPDATE interviews
+		SET user_id = :user_id,
+			vacancy_id = :vacancy_id,
+			recruiter_id = :recruiter_id,
+			interview_date = :interview_date,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = :id AND version = :version
+	`
+	params := map[string]interface{}{
+		"id":             interviewUpdate.ID,
 		"user_id":        interviewUpdate.UserID,
 		"vacancy_id":     interviewUpdate.VacancyID,
 		"recruiter_id":   interviewUpdate.RecruiterID,
 		"interview_date": interviewDate,
+		"version":        interviewUpdate.Version,
 	}
 
-	setClauses := []string{}
-	for key := range fields {
-		setClauses = append(setClauses, fmt.Sprintf("%s = :%s", key, key))
-	}
-	setQuery := strings.Join(setClauses, ", ")
-
-	query := fmt.Sprintf("UPDATE interviews SET %s, updated_at = NOW() WHERE id = :id", setQuery)
-	fields["id"] = interviewUpdate.ID
-
-	_, err = r.DB.NamedExec(query, fields)
-	if err != nil {
-		return err
-	}
-	return nil
+	return versionChecked(conn(ctx, r.DB).NamedExecContext(ctx, query, params))
 }
 
-func (r *PostgresInterviewRepository) DeleteInterview(id uuid.UUID) error {
+func (r *PostgresInterviewRepository) DeleteInterview(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM interviews WHERE id = $1`
-	_, err := r.DB.Exec(query, id)
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
 	if err != nil {
-		return err
+		return dbError(err, nil)
 	}
 	return nil
 }
+
+// DeleteInterviewsByVacancyID vakansiya o'chirilishidan oldin unga bog'liq intervyularni o'chiradi
+func (r *PostgresInterviewRepository) DeleteInterviewsByVacancyID(ctx context.Context, vacancyID uuid.UUID) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, `DELETE FROM interviews WHERE vacancy_id = $1`, vacancyID)
+	return dbError(err, nil)
+}
+
+// DeleteInterviewsByRecruiterID rekruiter o'chirilishidan oldin unga bog'liq intervyularni o'chiradi
+func (r *PostgresInterviewRepository) DeleteInterviewsByRecruiterID(ctx context.Context, recruiterID uuid.UUID) error {
+	_, err := conn(ctx, r.DB).ExecContext(ctx, `DELETE FROM interviews WHERE recruiter_id = $1`, recruiterID)
+	return dbError(err, nil)
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type NotificationPreferenceRepository interface {
+	GetPreference(ctx context.Context, userID uuid.UUID) (models.NotificationPreference, error)
+	UpsertPreference(ctx context.Context, preference models.NotificationPreference) (models.NotificationPreference, error)
+	GetPreferenceByTelegramChatID(ctx context.Context, chatID string) (models.NotificationPreference, error)
+}
+
+type PostgresNotificationPreferenceRepository struct {
+	DB *sqlx.DB
+}
+
+// GetPreference foydalanuvchi sozlamalarini qaytaradi. Sozlama hali saqlanmagan bo'lsa
+// standart qiymat (uz tili, faqat email yoqilgan) qaytariladi. SMS va Telegram faqat
+// foydalanuvchi o'zi obuna bo'lgandan keyin yoqiladi.
+func (r *PostgresNotificationPreferenceRepository) GetPreference(ctx context.Context, userID uuid.UUID) (models.NotificationPreference, error) {
+	var preference models.NotificationPreference
+	query := `SELECT * FROM notification_preferences WHERE user_id = $1`
+	err := conn(ctx, r.DB).GetContext(ctx, &preference, query, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.NotificationPreference{
+			UserID:           userID,
+			Locale:           "uz",
+			PreferredChannel: "email",
+			EmailEnabled:     true,
+			MutedKinds:       pq.StringArray{},
+		}, nil
+	}
+	if err != nil {
+		return models.NotificationPreference{}, dbError(err, nil)
+	}
+	return preference, nil
+}
+
+func (r *PostgresNotificationPreferenceRepository) UpsertPreference(ctx context.Context, preference models.NotificationPreference) (models.NotificationPreference, error) {
+	if preference.MutedKinds == nil {
+		preference.MutedKinds = pq.StringArray{}
+	}
+	preference.UpdatedAt = time.Now()
+
+	query := `INSERT INTO notification_preferences (user_id, locale, preferred_channel, email_enabled, sms_enabled, telegram_enabled, telegram_chat_id, muted_kinds, updated_at)
+              VALUES (:user_id, :locale, :preferred_channel, :email_enabled, :sms_enabled, :telegram_enabled, :telegram_chat_id, :muted_kinds, :updated_at)
+              ON CONFLICT (user_id) DO UPDATE
+              SET locale = EXCLUDED.locale,
+                  preferred_channel = EXCLUDED.preferred_channel,
+                  email_enabled = EXCLUDED.email_enabled,
+                  sms_enabled = EXCLUDED.sms_enabled,
+                  telegram_enabled = EXCLUDED.telegram_enabled,
+                  telegram_chat_id = EXCLUDED.telegram_chat_id,
+                  muted_kinds = EXCLUDED.muted_kinds,
+                  updated_at = EXCLUDED.updated_at`
+	_, err := conn(ctx, r.DB).NamedExecContext(ctx, query, preference)
+	if err != nil {
+		return models.NotificationPreference{}, dbError(err, nil)
+	}
+	return preference, nil
+}
+
+func (r *PostgresNotificationPreferenceRepository) GetPreferenceByTelegramChatID(ctx context.Context, chatID string) (models.NotificationPreference, error) {
+	var preference models.NotificationPreference
+	query := `SELECT * FROM notification_preferences WHERE telegram_chat_id = $1`
+	err := conn(ctx, r.DB).GetContext(ctx, &preference, query, chatID)
+	if err != nil {
+		return models.NotificationPreference{}, dbError(err, apperrors.NotFound("telegram_chat_not_linked", "Telegram chat is not linked to any user"))
+	}
+	return preference, nil
+}
@@ -1,8 +1,11 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
+	"hrplatform/apperrors"
 	"hrplatform/models"
+	"hrplatform/utils"
 	"log"
 	"strings"
 	"time"
@@ -12,77 +15,63 @@ import (
 )
 
 type RecruiterRepository interface {
-	CreateRecruiter(recruiterCreate models.CreateRecruiter) (models.Recruiter, error)
-	GetRecruiterByID(id string) (models.Recruiter, error)
-	GetAllRecruiters(age int, gender string, companyID string) ([]models.Recruiter, error)
-	UpdateRecruiter(recruiterUpdate models.UpdateRecruiter) error
-	DeleteRecruiter(id string) error
-	CheckCompanyExists(companyID uuid.UUID) (bool, error)
+	CreateRecruiter(ctx context.Context, recruiterCreate models.CreateRecruiter) (models.Recruiter, error)
+	GetRecruiterByID(ctx context.Context, id string) (models.Recruiter, error)
+	GetAllRecruiters(ctx context.Context, age int, gender string, companyID string) ([]models.Recruiter, error)
+	GetRecruitersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Recruiter, error)
+	GetRecruitersByCompanyIDs(ctx context.Context, companyIDs []uuid.UUID) ([]models.Recruiter, error)
+	UpdateRecruiter(ctx context.Context, recruiterUpdate models.UpdateRecruiter) error
+	DeleteRecruiter(ctx context.Context, id string) error
 }
 
 type PostgresRecruiterRepository struct {
 	DB *sqlx.DB
 }
 
-func (r *PostgresRecruiterRepository) CreateRecruiter(recruiterCreate models.CreateRecruiter) (models.Recruiter, error) {
-	// Check if company exists
-	exists, err := r.CheckCompanyExists(recruiterCreate.CompanyID)
-	if err != nil {
-		return models.Recruiter{}, err
-	}
-	if !exists {
-		return models.Recruiter{}, fmt.Errorf("company with ID %s does not exist", recruiterCreate.CompanyID)
-	}
-
+func (r *PostgresRecruiterRepository) CreateRecruiter(ctx context.Context, recruiterCreate models.CreateRecruiter) (models.Recruiter, error) {
 	// Parse birthday from string to time.Time
 	birthday, err := time.Parse(time.RFC3339, recruiterCreate.Birthday)
 	if err != nil {
-		return models.Recruiter{}, fmt.Errorf("invalid birthday format")
+		return models.Recruiter{}, apperrors.Validation("invalid_date", "Invalid birthday format").WithField("birthday", "expected RFC 3339 date")
 	}
 
 	recruiter := models.Recruiter{
 		ID:          uuid.New(),
 		Name:        recruiterCreate.Name,
-		Email:       recruiterCreate.Email,
-		PhoneNumber: recruiterCreate.PhoneNumber,
+		Email:       strings.TrimSpace(recruiterCreate.Email),
+		PhoneNumber: utils.NormalizePhone(recruiterCreate.PhoneNumber),
 		Birthday:    birthday,
 		Gender:      recruiterCreate.Gender,
 		CompanyID:   recruiterCreate.CompanyID,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 		DeletedAt:   0,
+		Version:     1,
 	}
 
 	query := `INSERT INTO recruiters (id, name, email, phone_number, birthday, gender, company_id, created_at, updated_at, deleted_at)
               VALUES (:id, :name, :email, :phone_number, :birthday, :gender, :company_id, :created_at, :updated_at, :deleted_at)`
 
-	_, err = r.DB.NamedExec(query, recruiter)
+	_, err = conn(ctx, r.DB).NamedExecContext(ctx, query, recruiter)
 	if err != nil {
 		log.Printf("Error creating recruiter: %v\nQuery: %s", err, query)
-		return models.Recruiter{}, err
+		return models.Recruiter{}, uniqueError(err, recruiterUniqueErrors(recruiter.Email, recruiter.PhoneNumber))
 	}
 
 	return recruiter, nil
 }
 
-func (r *PostgresRecruiterRepository) CheckCompanyExists(companyID uuid.UUID) (bool, error) {
-	var exists bool
-	query := `SELECT EXISTS (SELECT 1 FROM companies WHERE id = $1)`
-	err := r.DB.Get(&exists, query, companyID)
-	return exists, err
-}
-
-func (r *PostgresRecruiterRepository) GetRecruiterByID(id string) (models.Recruiter, error) {
+func (r *PostgresRecruiterRepository) GetRecruiterByID(ctx context.Context, id string) (models.Recruiter, error) {
 	var recruiter models.Recruiter
 	query := `SELECT * FROM recruiters WHERE id = $1`
-	err := r.DB.Get(&recruiter, query, id)
+	err := conn(ctx, r.DB).GetContext(ctx, &recruiter, query, id)
 	if err != nil {
-		return models.Recruiter{}, err
+		return models.Recruiter{}, dbError(err, apperrors.NotFound("recruiter_not_found", "Recruiter not found"))
 	}
 	return recruiter, nil
 }
 
-func (r *PostgresRecruiterRepository) GetAllRecruiters(age int, gender string, companyID string) ([]models.Recruiter, error) {
+func (r *PostgresRecruiterRepository) GetAllRecruiters(ctx context.Context, age int, gender string, companyID string) ([]models.Recruiter, error) {
 	var recruiters []models.Recruiter
 	var filters []string
 	var args []interface{}
@@ -111,64 +100,88 @@ func (r *PostgresRecruiterRepository) GetAllRecruiters(age int, gender string, c
 	}
 
 	query := fmt.Sprintf("SELECT * FROM recruiters %s", filterQuery)
-	err := r.DB.Select(&recruiters, query, args...)
+	err := conn(ctx, r.DB).SelectContext(ctx, &recruiters, query, args...)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, nil)
 	}
 	return recruiters, nil
 }
 
+// GetRecruitersByIDs topilmagan ID larni o'tkazib yuboradi
+func (r *PostgresRecruiterRepository) GetRecruitersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Recruiter, error) {
+	var recruiters []models.Recruiter
+	query := `SELECT * FROM recruiters WHERE id = ANY($1::uuid[]) ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &recruiters, query, uuidArray(ids))
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return recruiters, nil
+}
 
-func (r *PostgresRecruiterRepository) UpdateRecruiter(recruiter models.UpdateRecruiter) error {
-    fields := []string{}
-    params := map[string]interface{}{"id": recruiter.ID}
+// GetRecruitersByCompanyIDs bir nechta kompaniyaning rekruiterlarini bitta so'rovda oladi
+func (r *PostgresRecruiterRepository) GetRecruitersByCompanyIDs(ctx context.Context, companyIDs []uuid.UUID) ([]models.Recruiter, error) {
+	var recruiters []models.Recruiter
+	query := `SELECT * FROM recruiters WHERE company_id = ANY($1::uuid[]) ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &recruiters, query, uuidArray(companyIDs))
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return recruiters, nil
+}
 
-    if recruiter.Name != nil {
-        fields = append(fields, "name = :name")
-        params["name"] = *recruiter.Name
-    }
-    if recruiter.Email != nil {
-        fields = append(fields, "email = :email")
-        params["email"] = *recruiter.Email
-    }
-    if recruiter.PhoneNumber != nil {
-        fields = append(fields, "phone_number = :phone_number")
-        params["phone_number"] = *recruiter.PhoneNumber
-    }
-    if recruiter.Birthday != nil {
-        birthday, err := time.Parse(time.RFC3339, *recruiter.Birthday)
-        if err != nil {
-            return fmt.Errorf("invalid date format for birthday")
-        }
-        params["birthday"] = birthday
-        fields = append(fields, "birthday = :birthday")
-    }
-    if recruiter.Gender != nil {
-        fields = append(fields, "gender = :gender")
-        params["gender"] = *recruiter.Gender
-    }
-    if recruiter.CompanyID != nil {
-        fields = append(fields, "company_id = :company_id")
-        params["company_id"] = *recruiter.CompanyID
-    }
 
-    if len(fields) == 0 {
-        return fmt.Errorf("no fields to update")
+// UpdateRecruiter yollanma xodimning barcha tahrirlanadigan maydonlarini almashtiradi (PUT/PATCH).
+// Faqat bazadagi versiya recruiter.Version ga teng bo'lsa yoziladi (compare-and-set).
+func (r *PostgresRecruiterRepository) UpdateRecruiter(ctx context.Context, recruiter models.UpdateRecruiter) error {
+    birthday, err := time.Parse(time.RFC3339, recruiter.Birthday)
+    if err != nil {
+        return apperrors.Validation("invalid_date", "Invalid date format for birthday").WithField("birthday", "expected RFC 3339 date")
     }
 
-    fields = append(fields, "updated_at = NOW()")
-    query := fmt.Sprintf("UPDATE recruiters SET %s WHERE id = :id", strings.Join(fields, ", "))
+    query := `
+        UPDATE recruiters
+        SET name = :name,
+            email = :email,
+            phone_number = :phone_number,
+            birthday = :birthday,
+            gender = :gender,
+            company_id = :company_id,
+            updated_at = NOW(),
+            version = version + 1
+        WHERE id = :id AND version = :version
+    `
+    params := map[string]interface{}{
+        "id":           recruiter.ID,
+        "name":         recruiter.Name,
+        "email":        strings.TrimSpace(recruiter.Email),
+        "phone_number": utils.NormalizePhone(recruiter.PhoneNumber),
+        "birthday":     birthday,
+        "gender":       recruiter.Gender,
+        "company_id":   recruiter.CompanyID,
+        "version":      recruiter.Version,
+    }
 
-    _, err := r.DB.NamedExec(query, params)
-    return err
+    result, err := conn(ctx, r.DB).NamedExecContext(ctx, query, params)
+    if err != nil {
+        return uniqueError(err, recruiterUniqueErrors(params["email"].(string), params["phone_number"].(string)))
+    }
+    return versionChecked(result, nil)
 }
 
 
-func (r *PostgresRecruiterRepository) DeleteRecruiter(id string) error {
+func (r *PostgresRecruiterRepository) DeleteRecruiter(ctx context.Context, id string) error {
 	query := `DELETE FROM recruiters WHERE id = $1`
-	_, err := r.DB.Exec(query, id)
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
 	if err != nil {
-		return err
+		return dbError(err, nil)
 	}
 	return nil
 }
+
+// recruiterUniqueErrors unikal indekslar nomini 409 xatoliklariga bog'laydi
+func recruiterUniqueErrors(email, phone string) map[string]*apperrors.Error {
+	return map[string]*apperrors.Error{
+		"recruiters_email_unique_idx": apperrors.Conflict("recruiter_email_taken", "Recruiter with email '"+email+"' already exists").WithArg("Email", email).WithField("email", "already taken"),
+		"recruiters_phone_unique_idx": apperrors.Conflict("recruiter_phone_taken", "Recruiter with phone number '"+phone+"' already exists").WithArg("Phone", phone).WithField("phone_number", "already taken"),
+	}
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"hrplatform/models"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ReminderRepository interface {
+	ClaimDueReminders(ctx context.Context, lockKey int64, reminder string, from, to time.Duration) ([]models.Interview, bool, error)
+}
+
+type PostgresReminderRepository struct {
+	DB *sqlx.DB
+}
+
+// ClaimDueReminders boshlanishiga (from, to] oraliqda vaqt qolgan va hali `reminder` eslatmasi
+// yuborilmagan intervyularni qaytaradi hamda ularni interview_reminders jadvaliga yozib qo'yadi.
+// Hammasi bitta tranzaksiyada pg_try_advisory_xact_lock ostida bajariladi, shuning uchun bir nechta
+// replika bir vaqtda ishlasa ham eslatma faqat bir marta "band qilinadi". Lock boshqa replikada
+// bo'lsa, ikkinchi qiymat false bo'ladi.
+func (r *PostgresReminderRepository) ClaimDueReminders(ctx context.Context, lockKey int64, reminder string, from, to time.Duration) ([]models.Interview, bool, error) {
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.GetContext(ctx, &locked, `SELECT pg_try_advisory_xact_lock($1)`, lockKey); err != nil {
+		return nil, false, err
+	}
+	if !locked {
+		return nil, false, nil
+	}
+
+	// interview_date vaqt zonasisiz UTC da saqlanadi
+	query := fmt.Sprintf(`
+        SELECT i.* FROM interviews i
+        WHERE i.deleted_at = 0
+          AND i.interview_date >  (NOW() AT TIME ZONE 'UTC') + INTERVAL '%d seconds'
+          AND i.interview_date <= (NOW() AT TIME ZONE 'UTC') + INTERVAL '%d seconds'
+          AND NOT EXISTS (
+              SELECT 1 FROM interview_reminders r
+              WHERE r.interview_id = i.id AND r.reminder = $1
+          )`, int64(from.Seconds()), int64(to.Seconds()))
+
+	var interviews []models.Interview
+	if err := tx.SelectContext(ctx, &interviews, query, reminder); err != nil {
+		return nil, true, err
+	}
+
+	for _, interview := range interviews {
+		_, err := tx.ExecContext(ctx, `INSERT INTO interview_reminders (interview_id, reminder, sent_at)
+                           VALUES ($1, $2, NOW()) ON CONFLICT DO NOTHING`, interview.ID, reminder)
+		if err != nil {
+			return nil, true, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, true, err
+	}
+	return interviews, true, nil
+}
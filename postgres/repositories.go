@@ -0,0 +1,43 @@
+package postgres
+
+import "github.com/jmoiron/sqlx"
+
+// Repositories - ilova ishlatadigan barcha repositorylar. Handlerlar va servislar faqat
+// interfeyslarga bog'langan, shuning uchun bu to'plamni boshqa saqlash usuli
+// (masalan memory paketi) ham to'ldirishi mumkin.
+type Repositories struct {
+	Users            UserRepository
+	Resumes          ResumeRepository
+	Recruiters       RecruiterRepository
+	Companies        CompanyRepository
+	Vacancies        VacancyRepository
+	Interviews       InterviewRepository
+	Webhooks         WebhookRepository
+	Preferences      NotificationPreferenceRepository
+	Audit            AuditRepository
+	Idempotency      IdempotencyRepository
+	EligibilityRules EligibilityRuleRepository
+	UnitOfWork       UnitOfWork
+
+	// Reminders advisory lock va SQL oynalariga tayanadi, boshqa saqlash usullarida nil bo'lishi mumkin
+	Reminders ReminderRepository
+}
+
+// NewRepositories Postgres ustidagi repositorylarni yaratadi
+func NewRepositories(db *sqlx.DB, txMaxAttempts int) Repositories {
+	return Repositories{
+		Users:            &PostgresUserRepository{DB: db},
+		Resumes:          &PostgresResumeRepository{DB: db},
+		Recruiters:       &PostgresRecruiterRepository{DB: db},
+		Companies:        &PostgresCompanyRepository{DB: db},
+		Vacancies:        &PostgresVacancyRepository{DB: db},
+		Interviews:       &PostgresInterviewRepository{DB: db},
+		Webhooks:         &PostgresWebhookRepository{DB: db},
+		Preferences:      &PostgresNotificationPreferenceRepository{DB: db},
+		Audit:            &PostgresAuditRepository{DB: db},
+		Idempotency:      &PostgresIdempotencyRepository{DB: db},
+		EligibilityRules: &PostgresEligibilityRuleRepository{DB: db},
+		UnitOfWork:       &PostgresUnitOfWork{DB: db, MaxAttempts: txMaxAttempts},
+		Reminders:        &PostgresReminderRepository{DB: db},
+	}
+}
@@ -1,71 +1,92 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
+	"hrplatform/apperrors"
 	"hrplatform/models"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type ResumeRepository interface {
-	CreateResume(resumeCreate models.CreateResume) (models.Resume, error)
-	GetResumeByID(id uuid.UUID) (models.Resume, error)
-	GetResumesByUserID(userID uuid.UUID) ([]models.Resume, error)
-	GetAllResumes(filter map[string]interface{}) ([]models.ResumeWithUser, error)
-	UpdateResume(resumeUpdate models.UpdateResume) error
-	DeleteResume(id string) error
+	CreateResume(ctx context.Context, resumeCreate models.CreateResume) (models.Resume, error)
+	GetResumeByID(ctx context.Context, id uuid.UUID) (models.Resume, error)
+	GetResumesByUserID(ctx context.Context, userID uuid.UUID) ([]models.Resume, error)
+	GetResumesByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]models.Resume, error)
+	GetAllResumes(ctx context.Context, filter map[string]interface{}) ([]models.ResumeWithUser, error)
+	UpdateResume(ctx context.Context, resumeUpdate models.UpdateResume) error
+	DeleteResume(ctx context.Context, id string) error
 }
 
 type PostgresResumeRepository struct {
 	DB *sqlx.DB
 }
 
-func (r *PostgresResumeRepository) CreateResume(resumeCreate models.CreateResume) (models.Resume, error) {
+func (r *PostgresResumeRepository) CreateResume(ctx context.Context, resumeCreate models.CreateResume) (models.Resume, error) {
 	resume := models.Resume{
 		ID:          uuid.New(),
 		Position:    resumeCreate.Position,
 		Experience:  resumeCreate.Experience,
 		Description: resumeCreate.Description,
+		Languages:   pq.StringArray(resumeCreate.Languages),
 		UserID:      resumeCreate.UserID,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 		DeletedAt:   0,
+		Version:     1,
 	}
 
-	query := `INSERT INTO resumes (id, position, experience, description, user_id, created_at, updated_at, deleted_at) 
-              VALUES (:id, :position, :experience, :description, :user_id, :created_at, :updated_at, :deleted_at)`
+	if resume.Languages == nil {
+		resume.Languages = pq.StringArray{}
+	}
+
+	query := `INSERT INTO resumes (id, position, experience, description, languages, user_id, created_at, updated_at, deleted_at) 
+              VALUES (:id, :position, :experience, :description, :languages, :user_id, :created_at, :updated_at, :deleted_at)`
 
-	_, err := r.DB.NamedExec(query, resume)
+	_, err := conn(ctx, r.DB).NamedExecContext(ctx, query, resume)
 	if err != nil {
-		return models.Resume{}, err
+		return models.Resume{}, dbError(err, nil)
 	}
 
 	return resume, nil
 }
 
-func (r *PostgresResumeRepository) GetResumeByID(id uuid.UUID) (models.Resume, error) {
+func (r *PostgresResumeRepository) GetResumeByID(ctx context.Context, id uuid.UUID) (models.Resume, error) {
 	var resume models.Resume
 	query := `SELECT * FROM resumes WHERE id = $1`
-	err := r.DB.Get(&resume, query, id)
+	err := conn(ctx, r.DB).GetContext(ctx, &resume, query, id)
 	if err != nil {
-		return models.Resume{}, err
+		return models.Resume{}, dbError(err, apperrors.NotFound("resume_not_found", "Resume not found"))
 	}
 	return resume, nil
 }
 
-func (r *PostgresResumeRepository) GetResumesByUserID(userID uuid.UUID) ([]models.Resume, error) {
+func (r *PostgresResumeRepository) GetResumesByUserID(ctx context.Context, userID uuid.UUID) ([]models.Resume, error) {
 	var resumes []models.Resume
 	query := `SELECT * FROM resumes WHERE user_id = $1`
-	err := r.DB.Select(&resumes, query, userID)
+	err := conn(ctx, r.DB).SelectContext(ctx, &resumes, query, userID)
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return resumes, nil
+}
+
+// GetResumesByUserIDs bir nechta foydalanuvchining rezyumelarini bitta so'rovda oladi
+func (r *PostgresResumeRepository) GetResumesByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]models.Resume, error) {
+	var resumes []models.Resume
+	query := `SELECT * FROM resumes WHERE user_id = ANY($1::uuid[]) ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &resumes, query, uuidArray(userIDs))
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, nil)
 	}
 	return resumes, nil
 }
-func (r *PostgresResumeRepository) GetAllResumes(filter map[string]interface{}) ([]models.ResumeWithUser, error) {
+func (r *PostgresResumeRepository) GetAllResumes(ctx context.Context, filter map[string]interface{}) ([]models.ResumeWithUser, error) {
 	var resumes []models.ResumeWithUser
 
 
@@ -82,9 +103,11 @@ func (r *PostgresResumeRepository) GetAllResumes(filter map[string]interface{})
 	for key, value := range filter {
 		switch key {
 		case "position":
-			conditions = append(conditions, fmt.Sprintf("r.position ILIKE '%%%s%%'", value))
+			conditions = append(conditions, "r.position ILIKE ?")
+			args = append(args, "%"+value.(string)+"%")
 		case "min_exp":
-			conditions = append(conditions, fmt.Sprintf("r.experience >= %d", value))
+			conditions = append(conditions, "r.experience >= ?")
+			args = append(args, value)
 		}
 	}
 
@@ -93,15 +116,15 @@ func (r *PostgresResumeRepository) GetAllResumes(filter map[string]interface{})
 	}
 
 
-	err := r.DB.Select(&resumes, query, args...)
+	err := conn(ctx, r.DB).SelectContext(ctx, &resumes, r.DB.Rebind(query), args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get all resumes: %w", err)
+		return nil, dbError(fmt.Errorf("failed to get all resumes: %w", err), nil)
 	}
 
 	return resumes, nil
 }
 
-// func (r *PostgresResumeRepository) GetAllResumes(filter map[string]interface{}) ([]models.ResumeWithUser, error) {
+// func (r *PostgresResumeRepository) GetAllResumes(ctx context.Context, filter map[string]interface{}) ([]models.ResumeWithUser, error) {
 // 	var resumes []models.ResumeWithUser
 
 // 	// Base query with join
@@ -133,54 +156,47 @@ func (r *PostgresResumeRepository) GetAllResumes(filter map[string]interface{})
 // 	}
 
 // 	// Execute the query
-// 	err := r.DB.Select(&resumes, query, args...)
+// 	err := conn(ctx, r.DB).SelectContext(ctx, &resumes, query, args...)
 // 	if err != nil {
 // 		return nil, fmt.Errorf("failed to get all resumes: %w", err)
 // 	}
 
-//		return resumes, nil
-//	}
-func (r *PostgresResumeRepository) UpdateResume(resumeUpdate models.UpdateResume) error {
-	fields := make(map[string]interface{})
-
-	if resumeUpdate.Position != "" {
-		fields["position"] = resumeUpdate.Position
-	}
-	if resumeUpdate.Experience != 0 {
-		fields["experience"] = resumeUpdate.Experience
-	}
-	if resumeUpdate.Description != "" {
-		fields["description"] = resumeUpdate.Description
-	}
-	if resumeUpdate.UserID != uuid.Nil {
-		fields["user_id"] = resumeUpdate.UserID
-	}
-
-	if len(fields) == 0 {
-		return nil
+// UpdateResume rezyumening barcha tahrirlanadigan maydonlarini almashtiradi (PUT/PATCH).
+// Faqat bazadagi versiya resumeUpdate.Version ga teng bo'lsa yoziladi (compare-and-set).
+func (r *PostgresResumeRepository) UpdateResume(ctx context.Context, resumeUpdate models.UpdateResume) error {
+	query := `
+		UPDATE resumes
+		SET position = :position,
+			experience = :experience,
+			description = :description,
+			languages = :languages,
+			user_id = :user_id,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = :id AND version = :version
+	`
+
+	params := map[string]interface{}{
+		"id":          resumeUpdate.ID,
+		"position":    resumeUpdate.Position,
+		"experience":  resumeUpdate.Experience,
+		"description": resumeUpdate.Description,
+		"languages":   pq.StringArray(resumeUpdate.Languages),
+		"user_id":     resumeUpdate.UserID,
+		"version":     resumeUpdate.Version,
 	}
-
-	setClauses := []string{}
-	for key := range fields {
-		setClauses = append(setClauses, fmt.Sprintf("%s = :%s", key, key))
+	if resumeUpdate.Languages == nil {
+		params["languages"] = pq.StringArray{}
 	}
-	setQuery := strings.Join(setClauses, ", ")
-
-	query := fmt.Sprintf("UPDATE resumes SET %s, updated_at = NOW() WHERE id = :id", setQuery)
-	fields["id"] = uuid.MustParse(resumeUpdate.ID)
 
-	_, err := r.DB.NamedExec(query, fields)
-	if err != nil {
-		return err
-	}
-	return nil
+	return versionChecked(conn(ctx, r.DB).NamedExecContext(ctx, query, params))
 }
 
-func (r *PostgresResumeRepository) DeleteResume(id string) error {
+func (r *PostgresResumeRepository) DeleteResume(ctx context.Context, id string) error {
 	query := `DELETE FROM resumes WHERE id = $1`
-	_, err := r.DB.Exec(query, id)
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
 	if err != nil {
-		return err
+		return dbError(err, nil)
 	}
 	return nil
 }
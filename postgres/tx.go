@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Postgres tranzaksiyani qayta urinish mumkin bo'lgan xatolik kodlari
+const (
+	pqSerializationFailure = "40001"
+	pqDeadlockDetected     = "40P01"
+)
+
+// DBTX - *sqlx.DB va *sqlx.Tx uchun umumiy metodlar. Repositorylar so'rovlarni shu orqali
+// bajaradi, shuning uchun bir xil kod tranzaksiya ichida ham, tashqarisida ham ishlaydi.
+type DBTX interface {
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+}
+
+type txKey struct{}
+
+// conn ctx da ochiq tranzaksiya bo'lsa uni, aks holda db ni qaytaradi
+func conn(ctx context.Context, db *sqlx.DB) DBTX {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// UnitOfWork bir nechta repository chaqiruvini bitta tranzaksiyada bajaradi.
+// fn ga berilgan ctx ni repositorylarga uzatish kerak, ular shu ctx dagi tranzaksiyani ishlatadi.
+// fn qayta chaqirilishi mumkin, shuning uchun uning ichida tashqi yon ta'sirlar
+// (webhook, bildirishnoma, audit) bo'lmasligi kerak - ularni Do dan keyin bajaring.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// PostgresUnitOfWork tranzaksiyani SERIALIZABLE darajada ochadi va serialization failure
+// yoki deadlock bo'lsa fn ni MaxAttempts martagacha qayta bajaradi.
+type PostgresUnitOfWork struct {
+	DB          *sqlx.DB
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+func (u *PostgresUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Ichma-ich chaqirilganda tashqi tranzaksiyaga qo'shiladi
+	if _, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	maxAttempts := u.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	baseDelay := u.BaseDelay
+	if baseDelay <= 0 {
+		baseDelay = 10 * time.Millisecond
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err = u.run(ctx, fn)
+		if err == nil || !retryable(err) || attempt == maxAttempts {
+			return err
+		}
+		log.Printf("postgres: tranzaksiya qayta bajariladi (%d/%d): %v", attempt, maxAttempts, err)
+
+		select {
+		case <-ctx.Done():
+			return dbError(ctx.Err(), nil)
+		case <-time.After(baseDelay * time.Duration(attempt)):
+		}
+	}
+	return err
+}
+
+func (u *PostgresUnitOfWork) run(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := u.DB.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return dbError(tx.Commit(), nil)
+}
+
+// retryable xatolik tranzaksiyani boshidan qayta bajarish bilan hal bo'lishini bildiradi
+func retryable(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == pqSerializationFailure || pqErr.Code == pqDeadlockDetected
+}
@@ -2,70 +2,107 @@ package postgres
 
 ////////////////
 import (
+	"context"
 	"fmt"
+	"hrplatform/apperrors"
 	"hrplatform/models"
+	"hrplatform/utils"
 	"strings"
 	"time"
 	// "log/slog"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type UserRepository interface {
-	CreateUser(userCreate models.UserCreate) (models.User, error)
-	GetUserByID(id string) (models.User, error)
-	GetAllUsers(filters map[string]interface{}) ([]models.User, error)
-	UpdateUser(userUpdate models.UserUpdate) error
-	DeleteUser(id string) error
-	GetUserInterviews(userID uuid.UUID) ([]models.Interview, error)
-	GetUserResume(userID uuid.UUID) ([]models.Resume, error)
+	CreateUser(ctx context.Context, userCreate models.UserCreate) (models.User, error)
+	GetUserByID(ctx context.Context, id string) (models.User, error)
+	GetAllUsers(ctx context.Context, filters map[string]interface{}) ([]models.User, error)
+	GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.User, error)
+	GetUsersByEmails(ctx context.Context, emails []string) ([]models.User, error)
+	UpdateUser(ctx context.Context, userUpdate models.UserUpdate) error
+	DeleteUser(ctx context.Context, id string) error
+	GetUserInterviews(ctx context.Context, userID uuid.UUID) ([]models.Interview, error)
+	GetUserResume(ctx context.Context, userID uuid.UUID) ([]models.Resume, error)
+	FindDuplicates(ctx context.Context) ([]models.DuplicateGroup, error)
+	MergeUsers(ctx context.Context, sourceID, targetID uuid.UUID) (models.MergeResult, error)
 }
 
 type PostgresUserRepository struct {
 	DB *sqlx.DB
 }
 
-func (r *PostgresUserRepository) CreateUser(userCreate models.UserCreate) (models.User, error) {
+func (r *PostgresUserRepository) CreateUser(ctx context.Context, userCreate models.UserCreate) (models.User, error) {
 	birthday, err := time.Parse("2006-01-02", userCreate.Birthday)
 	if err != nil {
-		return models.User{}, err
+		return models.User{}, apperrors.Validation("invalid_date", "Invalid date format").WithField("birthday", "expected YYYY-MM-DD")
 	}
 
 	user := models.User{
 		ID:          uuid.New(),
 		Name:        userCreate.Name,
-		Email:       userCreate.Email,
-		PhoneNumber: userCreate.PhoneNumber,
+		Email:       strings.TrimSpace(userCreate.Email),
+		PhoneNumber: utils.NormalizePhone(userCreate.PhoneNumber),
 		Birthday:    birthday,
 		Gender:      userCreate.Gender,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 		DeletedAt:   0,
+		Version:     1,
 	}
 
 	query := `INSERT INTO users (id, name, email, phone_number, birthday, gender, created_at, updated_at, deleted_at) 
               VALUES (:id, :name, :email, :phone_number, :birthday, :gender, :created_at, :updated_at, :deleted_at)`
 
-	_, err = r.DB.NamedExec(query, user)
+	_, err = conn(ctx, r.DB).NamedExecContext(ctx, query, user)
 	if err != nil {
-		return models.User{}, err
+		return models.User{}, uniqueError(err, userUniqueErrors(user.Email, user.PhoneNumber))
 	}
 
 	return user, nil
 }
 
-func (r *PostgresUserRepository) GetUserByID(id string) (models.User, error) {
+func (r *PostgresUserRepository) GetUserByID(ctx context.Context, id string) (models.User, error) {
 	var user models.User
 	query := `SELECT * FROM users WHERE id = $1`
-	err := r.DB.Get(&user, query, id)
+	err := conn(ctx, r.DB).GetContext(ctx, &user, query, id)
 	if err != nil {
-		return models.User{}, err
+		return models.User{}, dbError(err, apperrors.NotFound("user_not_found", "User not found"))
 	}
 	return user, nil
 }
 
-func (r *PostgresUserRepository) GetAllUsers(filters map[string]interface{}) ([]models.User, error) {
+// GetUsersByIDs GetUserByID kabi o'chirilganlarni ham qaytaradi, topilmagan ID lar o'tkazib yuboriladi
+func (r *PostgresUserRepository) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.User, error) {
+	var users []models.User
+	query := `SELECT * FROM users WHERE id = ANY($1::uuid[]) ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &users, query, uuidArray(ids))
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return users, nil
+}
+
+// GetUsersByEmails faol foydalanuvchilarni email bo'yicha katta-kichik harfni farqlamasdan
+// qidiradi (users_email_unique_idx kabi), topilmagan emaillar o'tkazib yuboriladi
+func (r *PostgresUserRepository) GetUsersByEmails(ctx context.Context, emails []string) ([]models.User, error) {
+	lowered := make(pq.StringArray, len(emails))
+	for i, email := range emails {
+		lowered[i] = strings.ToLower(strings.TrimSpace(email))
+	}
+
+	var users []models.User
+	query := `SELECT * FROM users WHERE deleted_at = 0 AND LOWER(email) = ANY($1::text[]) ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &users, query, lowered)
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return users, nil
+}
+
+func (r *PostgresUserRepository) GetAllUsers(ctx context.Context, filters map[string]interface{}) ([]models.User, error) {
 	var users []models.User
 	query := `SELECT * FROM users WHERE deleted_at = 0`
 	params := []interface{}{}
@@ -83,82 +120,191 @@ func (r *PostgresUserRepository) GetAllUsers(filters map[string]interface{}) ([]
 		counter++
 	}
 
-	err := r.DB.Select(&users, query, params...)
+	err := conn(ctx, r.DB).SelectContext(ctx, &users, query, params...)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, nil)
 	}
 	return users, nil
 }
 
-func (r *PostgresUserRepository) UpdateUser(userUpdate models.UserUpdate) error {
-	fields := make(map[string]interface{})
-
-	if userUpdate.Name != "" {
-		fields["name"] = userUpdate.Name
-	}
-	if userUpdate.Email != "" {
-		fields["email"] = userUpdate.Email
-	}
-	if userUpdate.PhoneNumber != "" {
-		fields["phone_number"] = userUpdate.PhoneNumber
-	}
-	if userUpdate.Birthday != "" {
-		birthday, err := time.Parse("2006-01-02", userUpdate.Birthday)
-		if err != nil {
-			return err
-		}
-		fields["birthday"] = birthday
-	}
-	if userUpdate.Gender != "" {
-		fields["gender"] = userUpdate.Gender
-	}
-
-	if len(fields) == 0 {
-		return nil
+// UpdateUser foydalanuvchining barcha tahrirlanadigan maydonlarini almashtiradi (PUT/PATCH).
+// Faqat bazadagi versiya userUpdate.Version ga teng bo'lsa yoziladi (compare-and-set).
+func (r *PostgresUserRepository) UpdateUser(ctx context.Context, userUpdate models.UserUpdate) error {
+	birthday, err := time.Parse("2006-01-02", userUpdate.Birthday)
+	if err != nil {
+		return apperrors.Validation("invalid_date", "Invalid date format").WithField("birthday", "expected YYYY-MM-DD")
 	}
 
-	setClauses := []string{}
-	for key := range fields {
-		setClauses = append(setClauses, fmt.Sprintf("%s = :%s", key, key))
+	query := `
+		UPDATE users
+		SET name = :name,
+			email = :email,
+			phone_number = :phone_number,
+			birthday = :birthday,
+			gender = :gender,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = :id AND deleted_at = 0 AND version = :version
+	`
+	params := map[string]interface{}{
+		"id":           userUpdate.ID,
+		"name":         userUpdate.Name,
+		"email":        strings.TrimSpace(userUpdate.Email),
+		"phone_number": utils.NormalizePhone(userUpdate.PhoneNumber),
+		"birthday":     birthday,
+		"gender":       userUpdate.Gender,
+		"version":      userUpdate.Version,
 	}
-	setQuery := strings.Join(setClauses, ", ")
 
-	query := fmt.Sprintf("UPDATE users SET %s, updated_at = NOW() WHERE id = :id", setQuery)
-	fields["id"] = userUpdate.ID
-
-	_, err := r.DB.NamedExec(query, fields)
+	result, err := conn(ctx, r.DB).NamedExecContext(ctx, query, params)
 	if err != nil {
-		return err
+		return uniqueError(err, userUniqueErrors(params["email"].(string), params["phone_number"].(string)))
 	}
-	return nil
+	return versionChecked(result, nil)
 }
 
-func (r *PostgresUserRepository) DeleteUser(id string) error {
+func (r *PostgresUserRepository) DeleteUser(ctx context.Context, id string) error {
 	query := `UPDATE users SET deleted_at = EXTRACT(EPOCH FROM NOW()) WHERE id = $1`
-	_, err := r.DB.Exec(query, id)
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
 	if err != nil {
 		
-		return err
+		return dbError(err, nil)
 	}
 	return nil
 }
 
-func (r *PostgresUserRepository) GetUserInterviews(userID uuid.UUID) ([]models.Interview, error) {
+func (r *PostgresUserRepository) GetUserInterviews(ctx context.Context, userID uuid.UUID) ([]models.Interview, error) {
 	var interviews []models.Interview
 	query := `SELECT * FROM interviews WHERE user_id = $1`
-	err := r.DB.Select(&interviews, query, userID)
+	err := conn(ctx, r.DB).SelectContext(ctx, &interviews, query, userID)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, nil)
 	}
 	return interviews, nil
 }
 
-func (r *PostgresUserRepository) GetUserResume(userID uuid.UUID) ([]models.Resume, error) {
+func (r *PostgresUserRepository) GetUserResume(ctx context.Context, userID uuid.UUID) ([]models.Resume, error) {
 	var resumes []models.Resume
 	query := `SELECT * FROM resumes WHERE user_id = $1`
-	err := r.DB.Select(&resumes, query, userID)
+	err := conn(ctx, r.DB).SelectContext(ctx, &resumes, query, userID)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, nil)
 	}
 	return resumes, nil
 }
+
+// userUniqueErrors unikal indekslar nomini 409 xatoliklariga bog'laydi
+func userUniqueErrors(email, phone string) map[string]*apperrors.Error {
+	return map[string]*apperrors.Error{
+		"users_email_unique_idx": apperrors.Conflict("user_email_taken", "User with email '"+email+"' already exists").WithArg("Email", email).WithField("email", "already taken"),
+		"users_phone_unique_idx": apperrors.Conflict("user_phone_taken", "User with phone number '"+phone+"' already exists").WithArg("Phone", phone).WithField("phone_number", "already taken"),
+	}
+}
+
+// FindDuplicates faol foydalanuvchilar orasidan ehtimoliy dublikatlarni topadi: bir xil email
+// (katta-kichik harfsiz), normallashtirilgan telefon yoki ism va tug'ilgan sana. Unikal indekslar
+// yaratilishidan oldin kiritilgan eski yozuvlarni tozalash uchun ishlatiladi.
+func (r *PostgresUserRepository) FindDuplicates(ctx context.Context) ([]models.DuplicateGroup, error) {
+	var users []models.User
+	err := conn(ctx, r.DB).SelectContext(ctx, &users, `SELECT * FROM users WHERE deleted_at = 0 ORDER BY created_at`)
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return GroupDuplicates(users), nil
+}
+
+// GroupDuplicates created_at bo'yicha tartiblangan faol foydalanuvchilarni dublikat guruhlariga ajratadi.
+// Boshqa saqlash usullari (masalan memory) ham aynan shu qoidalardan foydalanadi.
+func GroupDuplicates(users []models.User) []models.DuplicateGroup {
+	keys := map[string]func(models.User) string{
+		"email": func(u models.User) string { return strings.ToLower(strings.TrimSpace(u.Email)) },
+		"phone": func(u models.User) string { return utils.NormalizePhone(u.PhoneNumber) },
+		"name_birthday": func(u models.User) string {
+			return strings.ToLower(strings.Join(strings.Fields(u.Name), " ")) + "|" + u.Birthday.Format("2006-01-02")
+		},
+	}
+
+	groups := []models.DuplicateGroup{}
+	for _, reason := range []string{"email", "phone", "name_birthday"} {
+		byKey := make(map[string][]models.User)
+		var order []string
+		for _, u := range users {
+			key := keys[reason](u)
+			if key == "" {
+				continue
+			}
+			if _, seen := byKey[key]; !seen {
+				order = append(order, key)
+			}
+			byKey[key] = append(byKey[key], u)
+		}
+		for _, key := range order {
+			if len(byKey[key]) > 1 {
+				groups = append(groups, models.DuplicateGroup{Reason: reason, Key: key, Users: byKey[key]})
+			}
+		}
+	}
+	return groups
+}
+
+// MergeUsers bitta tranzaksiyada source foydalanuvchining rezyume, intervyu va bildirishnoma
+// sozlamalarini target ga o'tkazadi va source ni soft delete qiladi. ctx da UnitOfWork
+// tranzaksiyasi bo'lsa, shunga qo'shiladi.
+func (r *PostgresUserRepository) MergeUsers(ctx context.Context, sourceID, targetID uuid.UUID) (models.MergeResult, error) {
+	var result models.MergeResult
+	uow := &PostgresUnitOfWork{DB: r.DB, MaxAttempts: 3}
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		db := conn(ctx, r.DB)
+
+		// Ikkala yozuvni ham bir xil tartibda qulflash (deadlock bo'lmasligi uchun)
+		var locked []uuid.UUID
+		err := db.SelectContext(ctx, &locked, `SELECT id FROM users WHERE id IN ($1, $2) AND deleted_at = 0 ORDER BY id FOR UPDATE`, sourceID, targetID)
+		if err != nil {
+			return dbError(err, nil)
+		}
+		if len(locked) != 2 {
+			return apperrors.NotFound("user_not_found", "User not found")
+		}
+
+		result = models.MergeResult{MergedUserID: sourceID}
+
+		res, err := db.ExecContext(ctx, `UPDATE resumes SET user_id = $1, updated_at = NOW(), version = version + 1 WHERE user_id = $2`, targetID, sourceID)
+		if err != nil {
+			return dbError(err, nil)
+		}
+		result.MovedResumes, _ = res.RowsAffected()
+
+		res, err = db.ExecContext(ctx, `UPDATE interviews SET user_id = $1, updated_at = NOW(), version = version + 1 WHERE user_id = $2`, targetID, sourceID)
+		if err != nil {
+			return dbError(err, nil)
+		}
+		result.MovedInterviews, _ = res.RowsAffected()
+
+		// target ning o'z sozlamalari bo'lsa ular saqlanadi, aks holda source niki o'tkaziladi
+		_, err = db.ExecContext(ctx, `
+			UPDATE notification_preferences SET user_id = $1
+			WHERE user_id = $2 AND NOT EXISTS (SELECT 1 FROM notification_preferences WHERE user_id = $1)`, targetID, sourceID)
+		if err != nil {
+			return dbError(err, nil)
+		}
+		_, err = db.ExecContext(ctx, `DELETE FROM notification_preferences WHERE user_id = $1`, sourceID)
+		if err != nil {
+			return dbError(err, nil)
+		}
+
+		_, err = db.ExecContext(ctx, `UPDATE users SET deleted_at = EXTRACT(EPOCH FROM NOW()), updated_at = NOW(), version = version + 1 WHERE id = $1`, sourceID)
+		if err != nil {
+			return dbError(err, nil)
+		}
+		_, err = db.ExecContext(ctx, `UPDATE users SET updated_at = NOW(), version = version + 1 WHERE id = $1`, targetID)
+		if err != nil {
+			return dbError(err, nil)
+		}
+
+		return dbError(db.GetContext(ctx, &result.User, `SELECT * FROM users WHERE id = $1`, targetID), nil)
+	})
+	if err != nil {
+		return models.MergeResult{}, err
+	}
+	return result, nil
+}
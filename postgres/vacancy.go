@@ -1,7 +1,8 @@
 package postgres
 ////////////////
 import (
-	"fmt"
+	"context"
+	"hrplatform/apperrors"
 	"hrplatform/models"
 	"strings"
 	"time"
@@ -11,19 +12,20 @@ import (
 )
 
 type VacancyRepository interface {
-	CreateVacancy(vacancyCreate models.CreateVacancy) (models.Vacancy, error)
-	GetVacancyByID(id uuid.UUID) (models.Vacancy, error)
-	GetAllVacancies(filter map[string]interface{}) ([]models.Vacancy, error)
-	UpdateVacancy(vacancyUpdate models.UpdateVacancy) error
-	DeleteVacancy(id uuid.UUID) error
-	CheckCompanyExists(companyID uuid.UUID) (bool, error)
+	CreateVacancy(ctx context.Context, vacancyCreate models.CreateVacancy) (models.Vacancy, error)
+	GetVacancyByID(ctx context.Context, id uuid.UUID) (models.Vacancy, error)
+	GetAllVacancies(ctx context.Context, filter map[string]interface{}) ([]models.Vacancy, error)
+	GetVacanciesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Vacancy, error)
+	GetVacanciesByCompanyIDs(ctx context.Context, companyIDs []uuid.UUID) ([]models.Vacancy, error)
+	UpdateVacancy(ctx context.Context, vacancyUpdate models.UpdateVacancy) error
+	DeleteVacancy(ctx context.Context, id uuid.UUID) error
 }
 
 type PostgresVacancyRepository struct {
 	DB *sqlx.DB
 }
 
-func (r *PostgresVacancyRepository) CreateVacancy(vacancyCreate models.CreateVacancy) (models.Vacancy, error) {
+func (r *PostgresVacancyRepository) CreateVacancy(ctx context.Context, vacancyCreate models.CreateVacancy) (models.Vacancy, error) {
 	vacancy := models.Vacancy{
 		ID:          uuid.New(),
 		Name:        vacancyCreate.Name,
@@ -34,30 +36,31 @@ func (r *PostgresVacancyRepository) CreateVacancy(vacancyCreate models.CreateVac
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 		DeletedAt:   0,
+		Version:     1,
 	}
 
 	query := `INSERT INTO vacancies (id, name, position, min_exp, company_id, description, created_at, updated_at, deleted_at) 
               VALUES (:id, :name, :position, :min_exp, :company_id, :description, :created_at, :updated_at, :deleted_at)`
 
-	_, err := r.DB.NamedExec(query, vacancy)
+	_, err := conn(ctx, r.DB).NamedExecContext(ctx, query, vacancy)
 	if err != nil {
-		return models.Vacancy{}, err
+		return models.Vacancy{}, dbError(err, nil)
 	}
 
 	return vacancy, nil
 }
 
-func (r *PostgresVacancyRepository) GetVacancyByID(id uuid.UUID) (models.Vacancy, error) {
+func (r *PostgresVacancyRepository) GetVacancyByID(ctx context.Context, id uuid.UUID) (models.Vacancy, error) {
 	var vacancy models.Vacancy
 	query := `SELECT * FROM vacancies WHERE id = $1`
-	err := r.DB.Get(&vacancy, query, id)
+	err := conn(ctx, r.DB).GetContext(ctx, &vacancy, query, id)
 	if err != nil {
-		return models.Vacancy{}, err
+		return models.Vacancy{}, dbError(err, apperrors.NotFound("vacancy_not_found", "Vacancy not found"))
 	}
 	return vacancy, nil
 }
 
-func (r *PostgresVacancyRepository) GetAllVacancies(filter map[string]interface{}) ([]models.Vacancy, error) {
+func (r *PostgresVacancyRepository) GetAllVacancies(ctx context.Context, filter map[string]interface{}) ([]models.Vacancy, error) {
 	var vacancies []models.Vacancy
 	var conditions []string
 	var params []interface{}
@@ -81,61 +84,59 @@ func (r *PostgresVacancyRepository) GetAllVacancies(filter map[string]interface{
 		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	err := r.DB.Select(&vacancies, query, params...)
+	// "?" belgilarini Postgres uchun $1, $2 ... ga almashtirish
+	err := conn(ctx, r.DB).SelectContext(ctx, &vacancies, r.DB.Rebind(query), params...)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, nil)
 	}
 	return vacancies, nil
 }
 
-func (r *PostgresVacancyRepository) UpdateVacancy(vacancyUpdate models.UpdateVacancy) error {
-	fields := make(map[string]interface{})
-
-	if vacancyUpdate.Name != "" {
-		fields["name"] = vacancyUpdate.Name
-	}
-	if vacancyUpdate.Position != "" {
-		fields["position"] = vacancyUpdate.Position
-	}
-	if vacancyUpdate.MinExp != 0 {
-		fields["min_exp"] = vacancyUpdate.MinExp
-	}
-	if vacancyUpdate.CompanyID != uuid.Nil {
-		fields["company_id"] = vacancyUpdate.CompanyID
-	}
-
-	if len(fields) == 0 {
-		return nil
-	}
-
-	setClauses := []string{}
-	for key := range fields {
-		setClauses = append(setClauses, fmt.Sprintf("%s = :%s", key, key))
+// GetVacanciesByIDs topilmagan ID larni o'tkazib yuboradi
+func (r *PostgresVacancyRepository) GetVacanciesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Vacancy, error) {
+	var vacancies []models.Vacancy
+	query := `SELECT * FROM vacancies WHERE id = ANY($1::uuid[]) ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &vacancies, query, uuidArray(ids))
+	if err != nil {
+		return nil, dbError(err, nil)
 	}
-	setQuery := strings.Join(setClauses, ", ")
-
-	query := fmt.Sprintf("UPDATE vacancies SET %s, updated_at = NOW() WHERE id = :id", setQuery)
-	fields["id"] = vacancyUpdate.ID
+	return vacancies, nil
+}
 
-	_, err := r.DB.NamedExec(query, fields)
+// GetVacanciesByCompanyIDs bir nechta kompaniyaning vakansiyalarini bitta so'rovda oladi
+func (r *PostgresVacancyRepository) GetVacanciesByCompanyIDs(ctx context.Context, companyIDs []uuid.UUID) ([]models.Vacancy, error) {
+	var vacancies []models.Vacancy
+	query := `SELECT * FROM vacancies WHERE company_id = ANY($1::uuid[]) ORDER BY created_at, id`
+	err := conn(ctx, r.DB).SelectContext(ctx, &vacancies, query, uuidArray(companyIDs))
 	if err != nil {
-		return err
+		return nil, dbError(err, nil)
 	}
-	return nil
+	return vacancies, nil
+}
+
+// UpdateVacancy vakansiyaning barcha tahrirlanadigan maydonlarini almashtiradi (PUT/PATCH).
+// Faqat bazadagi versiya vacancyUpdate.Version ga teng bo'lsa yoziladi (compare-and-set).
+func (r *PostgresVacancyRepository) UpdateVacancy(ctx context.Context, vacancyUpdate models.UpdateVacancy) error {
+	query := `
+		UPDATE vacancies
+		SET name = :name,
+			position = :position,
+			min_exp = :min_exp,
+			company_id = :company_id,
+			description = :description,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = :id AND version = :version
+	`
+
+	return versionChecked(conn(ctx, r.DB).NamedExecContext(ctx, query, vacancyUpdate))
 }
 
-func (r *PostgresVacancyRepository) DeleteVacancy(id uuid.UUID) error {
+func (r *PostgresVacancyRepository) DeleteVacancy(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM vacancies WHERE id = $1`
-	_, err := r.DB.Exec(query, id)
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
 	if err != nil {
-		return err
+		return dbError(err, nil)
 	}
 	return nil
 }
-
-func (r *PostgresVacancyRepository) CheckCompanyExists(companyID uuid.UUID) (bool, error) {
-	var exists bool
-	query := `SELECT EXISTS (SELECT 1 FROM companies WHERE LOWER(id::text) = $1)`
-	err := r.DB.Get(&exists, query, strings.ToLower(companyID.String()))
-	return exists, err
-}
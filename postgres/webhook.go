@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhookCreate models.CreateWebhook) (models.Webhook, error)
+	GetWebhookByID(ctx context.Context, id uuid.UUID) (models.Webhook, error)
+	GetAllWebhooks(ctx context.Context, companyID string) ([]models.Webhook, error)
+	GetSubscribedWebhooks(ctx context.Context, companyID uuid.UUID, event string) ([]models.Webhook, error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+	DeleteWebhooksByCompanyID(ctx context.Context, companyID uuid.UUID) error
+	CreateDelivery(ctx context.Context, delivery models.WebhookDelivery) error
+	GetDeliveries(ctx context.Context, webhookID uuid.UUID) ([]models.WebhookDelivery, error)
+}
+
+type PostgresWebhookRepository struct {
+	DB *sqlx.DB
+}
+
+func (r *PostgresWebhookRepository) CreateWebhook(ctx context.Context, webhookCreate models.CreateWebhook) (models.Webhook, error) {
+	webhook := models.Webhook{
+		ID:        uuid.New(),
+		CompanyID: webhookCreate.CompanyID,
+		URL:       webhookCreate.URL,
+		Secret:    webhookCreate.Secret,
+		Events:    pq.StringArray(webhookCreate.Events),
+		Active:    true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		DeletedAt: 0,
+		Version:   1,
+	}
+
+	query := `INSERT INTO webhooks (id, company_id, url, secret, events, active, created_at, updated_at, deleted_at)
+              VALUES (:id, :company_id, :url, :secret, :events, :active, :created_at, :updated_at, :deleted_at)`
+
+	_, err := conn(ctx, r.DB).NamedExecContext(ctx, query, webhook)
+	if err != nil {
+		return models.Webhook{}, dbError(err, nil)
+	}
+
+	return webhook, nil
+}
+
+func (r *PostgresWebhookRepository) GetWebhookByID(ctx context.Context, id uuid.UUID) (models.Webhook, error) {
+	var webhook models.Webhook
+	query := `SELECT * FROM webhooks WHERE id = $1 AND deleted_at = 0`
+	err := conn(ctx, r.DB).GetContext(ctx, &webhook, query, id)
+	if err != nil {
+		return models.Webhook{}, dbError(err, apperrors.NotFound("webhook_not_found", "Webhook not found"))
+	}
+	return webhook, nil
+}
+
+func (r *PostgresWebhookRepository) GetAllWebhooks(ctx context.Context, companyID string) ([]models.Webhook, error) {
+	webhooks := []models.Webhook{}
+	query := `SELECT * FROM webhooks WHERE deleted_at = 0`
+	args := []interface{}{}
+	if companyID != "" {
+		query += ` AND company_id = $1`
+		args = append(args, companyID)
+	}
+
+	err := conn(ctx, r.DB).SelectContext(ctx, &webhooks, query, args...)
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return webhooks, nil
+}
+
+// GetSubscribedWebhooks kompaniyaning berilgan eventga obuna bo'lgan faol webhooklarini qaytaradi
+func (r *PostgresWebhookRepository) GetSubscribedWebhooks(ctx context.Context, companyID uuid.UUID, event string) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+	query := `SELECT * FROM webhooks
+              WHERE company_id = $1 AND $2 = ANY(events) AND active AND deleted_at = 0`
+	err := conn(ctx, r.DB).SelectContext(ctx, &webhooks, query, companyID, event)
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return webhooks, nil
+}
+
+func (r *PostgresWebhookRepository) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE webhooks SET active = FALSE, deleted_at = EXTRACT(EPOCH FROM NOW()) WHERE id = $1`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, id)
+	return dbError(err, nil)
+}
+
+// DeleteWebhooksByCompanyID kompaniyaning barcha webhooklarini o'chiradi (kompaniya o'chirilganda)
+func (r *PostgresWebhookRepository) DeleteWebhooksByCompanyID(ctx context.Context, companyID uuid.UUID) error {
+	query := `UPDATE webhooks SET active = FALSE, deleted_at = EXTRACT(EPOCH FROM NOW()) WHERE company_id = $1 AND deleted_at = 0`
+	_, err := conn(ctx, r.DB).ExecContext(ctx, query, companyID)
+	return dbError(err, nil)
+}
+
+func (r *PostgresWebhookRepository) CreateDelivery(ctx context.Context, delivery models.WebhookDelivery) error {
+	query := `INSERT INTO webhook_deliveries (id, webhook_id, event_id, event, payload, attempt, status_code, response_body, error, success, duration_ms, created_at)
+              VALUES (:id, :webhook_id, :event_id, :event, :payload, :attempt, :status_code, :response_body, :error, :success, :duration_ms, :created_at)`
+	_, err := conn(ctx, r.DB).NamedExecContext(ctx, query, delivery)
+	return dbError(err, nil)
+}
+
+func (r *PostgresWebhookRepository) GetDeliveries(ctx context.Context, webhookID uuid.UUID) ([]models.WebhookDelivery, error) {
+	deliveries := []models.WebhookDelivery{}
+	query := `SELECT * FROM webhook_deliveries WHERE webhook_id = $1 ORDER BY created_at DESC`
+	err := conn(ctx, r.DB).SelectContext(ctx, &deliveries, query, webhookID)
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return deliveries, nil
+}
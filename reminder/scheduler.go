@@ -0,0 +1,86 @@
+package reminder
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"hrplatform/notification"
+	"hrplatform/postgres"
+)
+
+// LockKey - eslatmalar uchun Postgres advisory lock kaliti (barcha replikalarda bir xil)
+const LockKey int64 = 20240529
+
+// Window - eslatma turi: intervyuga Before vaqt qolganda yuboriladi
+type Window struct {
+	Name   string
+	Before time.Duration
+}
+
+// DefaultWindows - 24 soat va 1 soat oldin yuboriladigan eslatmalar
+var DefaultWindows = []Window{
+	{Name: "24h", Before: 24 * time.Hour},
+	{Name: "1h", Before: time.Hour},
+}
+
+// Scheduler yaqinlashayotgan intervyularni vaqti-vaqti bilan tekshirib, nomzod va
+// rekruiterga eslatma yuboradi. Yuborilgan eslatmalar bazada saqlanadi, shuning uchun
+// server qayta ishga tushganda takrorlanmaydi.
+type Scheduler struct {
+	Reminders postgres.ReminderRepository
+	Notifier  *notification.Notifier
+	Interval  time.Duration
+	Windows   []Window
+}
+
+// Run ctx bekor qilinguncha har Interval da tekshiruv o'tkazadi
+func (s *Scheduler) Run(ctx context.Context) {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.Tick(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick barcha eslatma oynalarini bir marta tekshiradi
+func (s *Scheduler) Tick(ctx context.Context) {
+	windows := s.Windows
+	if len(windows) == 0 {
+		windows = DefaultWindows
+	}
+
+	for i, window := range windows {
+		// Har bir oyna keyingi (kichikroq) oynagacha bo'lgan oraliqni qamraydi,
+		// masalan 24h eslatmasi intervyuga 1 soatdan ko'p qolganda yuboriladi
+		var from time.Duration
+		if i+1 < len(windows) {
+			from = windows[i+1].Before
+		}
+
+		interviews, locked, err := s.Reminders.ClaimDueReminders(ctx, LockKey, window.Name, from, window.Before)
+		if err != nil {
+			log.Printf("reminder: %s eslatmalarini olishda xatolik: %v", window.Name, err)
+			continue
+		}
+		if !locked {
+			// boshqa replika hozir shu ishni bajaryapti
+			return
+		}
+
+		for _, interview := range interviews {
+			s.Notifier.NotifyInterview(ctx, notification.KindInterviewReminder, interview)
+			s.Notifier.NotifyInterviewRecruiter(ctx, notification.KindInterviewReminder, interview)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"hrplatform/apperrors"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+type CompanyService struct {
+	Companies  postgres.CompanyRepository
+	Vacancies  postgres.VacancyRepository
+	Recruiters postgres.RecruiterRepository
+	Interviews postgres.InterviewRepository
+	Webhooks   postgres.WebhookRepository
+	UnitOfWork postgres.UnitOfWork
+}
+
+// CompanyDeletion - kompaniya bilan birga o'chirilgan yozuvlar (audit uchun)
+type CompanyDeletion struct {
+	Vacancies  []models.Vacancy
+	Recruiters []models.Recruiter
+}
+
+// RequireCompany kompaniya mavjud va faol ekanini tekshiradi
+func (s *CompanyService) RequireCompany(ctx context.Context, companyID uuid.UUID) error {
+	company, err := s.Companies.GetCompanyByID(ctx, companyID.String())
+	if apperrors.Is(err, apperrors.KindNotFound) {
+		return companyNotFound()
+	}
+	if err != nil {
+		return err
+	}
+	return CheckCompanyActive(company)
+}
+
+// Delete kompaniyani uning vakansiyalari, rekruiterlari, ularning intervyulari va
+// webhooklari bilan birga bitta tranzaksiyada o'chiradi
+func (s *CompanyService) Delete(ctx context.Context, companyID uuid.UUID) (CompanyDeletion, error) {
+	var deleted CompanyDeletion
+	err := s.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deleted.Vacancies, err = s.Vacancies.GetAllVacancies(ctx, map[string]interface{}{"company_id": companyID})
+		if err != nil {
+			return err
+		}
+		for _, vacancy := range deleted.Vacancies {
+			if err := s.Interviews.DeleteInterviewsByVacancyID(ctx, vacancy.ID); err != nil {
+				return err
+			}
+			if err := s.Vacancies.DeleteVacancy(ctx, vacancy.ID); err != nil {
+				return err
+			}
+		}
+
+		deleted.Recruiters, err = s.Recruiters.GetAllRecruiters(ctx, 0, "", companyID.String())
+		if err != nil {
+			return err
+		}
+		for _, recruiter := range deleted.Recruiters {
+			if err := s.Interviews.DeleteInterviewsByRecruiterID(ctx, recruiter.ID); err != nil {
+				return err
+			}
+			if err := s.Recruiters.DeleteRecruiter(ctx, recruiter.ID.String()); err != nil {
+				return err
+			}
+		}
+
+		if err := s.Webhooks.DeleteWebhooksByCompanyID(ctx, companyID); err != nil {
+			return err
+		}
+		return s.Companies.DeleteCompany(ctx, companyID.String())
+	})
+	if err != nil {
+		return CompanyDeletion{}, err
+	}
+	return deleted, nil
+}
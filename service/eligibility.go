@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/eligibility"
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+// EligibilityService nomzodni vakansiyaga mosligini bazada saqlangan qoidalar bo'yicha tekshiradi.
+// Amaldagi qoidalar: standart qoidalar, ularning ustiga kompaniya, undan keyin vakansiya qoidalari.
+type EligibilityService struct {
+	Rules      postgres.EligibilityRuleRepository
+	Users      postgres.UserRepository
+	Resumes    postgres.ResumeRepository
+	Vacancies  postgres.VacancyRepository
+	Companies  *CompanyService
+	UnitOfWork postgres.UnitOfWork
+
+	// Now sinovlarda vaqtni almashtirish uchun, nil bo'lsa time.Now
+	Now func() time.Time
+}
+
+// CompanyRules kompaniya darajasida saqlangan qoidalarni qaytaradi
+func (s *EligibilityService) CompanyRules(ctx context.Context, companyID uuid.UUID) ([]eligibility.Rule, error) {
+	if err := s.requireCompany(ctx, companyID); err != nil {
+		return nil, err
+	}
+	stored, err := s.Rules.GetCompanyRules(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	return toRules(stored)
+}
+
+// VacancyRules vakansiya darajasida saqlangan qoidalarni va standart hamda kompaniya
+// qoidalari bilan birlashtirilgan amaldagi qoidalarni qaytaradi
+func (s *EligibilityService) VacancyRules(ctx context.Context, vacancyID uuid.UUID) (own, effective []eligibility.Rule, err error) {
+	vacancy, err := s.Vacancies.GetVacancyByID(ctx, vacancyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	stored, err := s.Rules.GetVacancyRules(ctx, vacancyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if own, err = toRules(stored); err != nil {
+		return nil, nil, err
+	}
+	effective, err = s.EffectiveRules(ctx, vacancy)
+	if err != nil {
+		return nil, nil, err
+	}
+	return own, effective, nil
+}
+
+// ReplaceCompanyRules kompaniyaning qoidalarini to'liq almashtiradi. Bo'sh ro'yxat qoidalarni o'chiradi.
+func (s *EligibilityService) ReplaceCompanyRules(ctx context.Context, companyID uuid.UUID, rules []eligibility.Rule) error {
+	stored, err := fromRules(rules)
+	if err != nil {
+		return err
+	}
+	return s.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.requireCompany(ctx, companyID); err != nil {
+			return err
+		}
+		return s.Rules.ReplaceCompanyRules(ctx, companyID, stored)
+	})
+}
+
+// ReplaceVacancyRules vakansiyaning qoidalarini to'liq almashtiradi. Bo'sh ro'yxat qoidalarni o'chiradi.
+func (s *EligibilityService) ReplaceVacancyRules(ctx context.Context, vacancyID uuid.UUID, rules []eligibility.Rule) error {
+	stored, err := fromRules(rules)
+	if err != nil {
+		return err
+	}
+	return s.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, err := s.Vacancies.GetVacancyByID(ctx, vacancyID); err != nil {
+			return err
+		}
+		return s.Rules.ReplaceVacancyRules(ctx, vacancyID, stored)
+	})
+}
+
+// EffectiveRules vakansiyaga amal qiladigan qoidalarni qaytaradi
+func (s *EligibilityService) EffectiveRules(ctx context.Context, vacancy models.Vacancy) ([]eligibility.Rule, error) {
+	companyStored, err := s.Rules.GetCompanyRules(ctx, vacancy.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	companyRules, err := toRules(companyStored)
+	if err != nil {
+		return nil, err
+	}
+	vacancyStored, err := s.Rules.GetVacancyRules(ctx, vacancy.ID)
+	if err != nil {
+		return nil, err
+	}
+	vacancyRules, err := toRules(vacancyStored)
+	if err != nil {
+		return nil, err
+	}
+	return eligibility.Merge(eligibility.DefaultRules(), companyRules, vacancyRules), nil
+}
+
+// Check nomzodni vakansiya qoidalari bo'yicha tekshiradi va hech narsa yozmaydi.
+// Nomzod mos kelmasligi xatolik emas, sabablari natijada qaytariladi.
+func (s *EligibilityService) Check(ctx context.Context, vacancyID, userID uuid.UUID) (eligibility.Result, error) {
+	user, err := s.Users.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return eligibility.Result{}, err
+	}
+	if user.DeletedAt != 0 {
+		return eligibility.Result{}, apperrors.NotFound("user_not_found", "User not found")
+	}
+	resumes, err := s.Resumes.GetResumesByUserID(ctx, userID)
+	if err != nil {
+		return eligibility.Result{}, err
+	}
+	vacancy, err := s.Vacancies.GetVacancyByID(ctx, vacancyID)
+	if err != nil {
+		return eligibility.Result{}, err
+	}
+	rules, err := s.EffectiveRules(ctx, vacancy)
+	if err != nil {
+		return eligibility.Result{}, err
+	}
+
+	candidate := eligibility.Candidate{User: user, Resumes: resumes}
+	return eligibility.Evaluate(rules, candidate, vacancy, s.now()), nil
+}
+
+// Require Check kabi, lekin nomzod mos kelmasa barcha sabablari bilan validatsiya xatoligini qaytaradi
+func (s *EligibilityService) Require(ctx context.Context, vacancyID, userID uuid.UUID) error {
+	result, err := s.Check(ctx, vacancyID, userID)
+	if err != nil {
+		return err
+	}
+	if !result.Eligible {
+		return apperrors.Validation("candidate_not_eligible", "Candidate does not meet the vacancy requirements", result.Reasons...)
+	}
+	return nil
+}
+
+// requireCompany yo'l parametridagi kompaniya mavjud va faol ekanini tekshiradi
+func (s *EligibilityService) requireCompany(ctx context.Context, companyID uuid.UUID) error {
+	company, err := s.Companies.Companies.GetCompanyByID(ctx, companyID.String())
+	if err != nil {
+		return err
+	}
+	if company.DeletedAt != 0 {
+		return apperrors.NotFound("company_not_found", "Company not found")
+	}
+	return nil
+}
+
+func (s *EligibilityService) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// fromRules qoidalarni tekshirib saqlash uchun tayyorlaydi. Bitta to'plamda har bir tur bir marta.
+func fromRules(rules []eligibility.Rule) ([]models.EligibilityRule, error) {
+	invalid := apperrors.Validation("invalid_eligibility_rule", "Invalid eligibility rules")
+	seen := make(map[string]bool)
+	stored := make([]models.EligibilityRule, 0, len(rules))
+	now := time.Now()
+
+	for i, rule := range rules {
+		field := fmt.Sprintf("rules[%d]", i)
+		if err := rule.Validate(); err != nil {
+			invalid.WithField(field, err.Error())
+			continue
+		}
+		if seen[rule.Type] {
+			invalid.WithField(field, fmt.Sprintf("duplicate rule type %q", rule.Type))
+			continue
+		}
+		seen[rule.Type] = true
+
+		params, err := json.Marshal(rule.Params)
+		if err != nil {
+			return nil, apperrors.Internal(err)
+		}
+		stored = append(stored, models.EligibilityRule{
+			ID:        uuid.New(),
+			Type:      rule.Type,
+			Params:    params,
+			Position:  i,
+			CreatedAt: now,
+		})
+	}
+
+	if len(invalid.Fields) > 0 {
+		return nil, invalid
+	}
+	return stored, nil
+}
+
+// toRules bazadagi yozuvlarni dvigatel qoidalariga aylantiradi
+func toRules(stored []models.EligibilityRule) ([]eligibility.Rule, error) {
+	rules := make([]eligibility.Rule, 0, len(stored))
+	for _, row := range stored {
+		params, err := eligibility.ParseParams(row.Params)
+		if err != nil {
+			return nil, apperrors.Internal(fmt.Errorf("eligibility rule %s: %w", row.ID, err))
+		}
+		rules = append(rules, eligibility.Rule{Type: row.Type, Params: params})
+	}
+	return rules, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+type InterviewService struct {
+	Interviews  postgres.InterviewRepository
+	Eligibility *EligibilityService
+	UnitOfWork  postgres.UnitOfWork
+}
+
+// Create nomzodni tekshirib intervyu yaratadi. Tekshiruvlar va INSERT bitta SERIALIZABLE
+// tranzaksiyada, shuning uchun tekshiruvdan keyin ma'lumot o'zgarsa tranzaksiya qayta bajariladi.
+func (s *InterviewService) Create(ctx context.Context, interviewCreate models.CreateInterview) (models.Interview, error) {
+	var interview models.Interview
+	err := s.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.checkCandidate(ctx, interviewCreate.UserID, interviewCreate.VacancyID); err != nil {
+			return err
+		}
+		var err error
+		interview, err = s.Interviews.CreateInterview(ctx, interviewCreate)
+		return err
+	})
+	if err != nil {
+		return models.Interview{}, err
+	}
+	return interview, nil
+}
+
+// Update intervyuni yozadi. Nomzod yoki vakansiya o'zgargan bo'lsa qoidalar qayta tekshiriladi.
+func (s *InterviewService) Update(ctx context.Context, before models.Interview, interviewUpdate models.UpdateInterview) error {
+	return s.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if interviewUpdate.UserID != before.UserID || interviewUpdate.VacancyID != before.VacancyID {
+			if err := s.checkCandidate(ctx, interviewUpdate.UserID, interviewUpdate.VacancyID); err != nil {
+				return err
+			}
+		}
+		return s.Interviews.UpdateInterview(ctx, interviewUpdate)
+	})
+}
+
+// checkCandidate nomzodni vakansiyaning amaldagi qoidalari bo'yicha tekshiradi
+func (s *InterviewService) checkCandidate(ctx context.Context, userID, vacancyID uuid.UUID) error {
+	return s.Eligibility.Require(ctx, vacancyID, userID)
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+type RecruiterService struct {
+	Recruiters postgres.RecruiterRepository
+	Interviews postgres.InterviewRepository
+	Companies  *CompanyService
+	UnitOfWork postgres.UnitOfWork
+}
+
+// Create rekruiterni faqat faol kompaniya uchun yaratadi
+func (s *RecruiterService) Create(ctx context.Context, recruiterCreate models.CreateRecruiter) (models.Recruiter, error) {
+	if err := s.Companies.RequireCompany(ctx, recruiterCreate.CompanyID); err != nil {
+		return models.Recruiter{}, err
+	}
+	return s.Recruiters.CreateRecruiter(ctx, recruiterCreate)
+}
+
+// Update rekruiterni yozadi. Kompaniya o'zgargan bo'lsa yangisi tekshiriladi.
+func (s *RecruiterService) Update(ctx context.Context, before models.Recruiter, recruiterUpdate models.UpdateRecruiter) error {
+	if recruiterUpdate.CompanyID != before.CompanyID {
+		if err := s.Companies.RequireCompany(ctx, recruiterUpdate.CompanyID); err != nil {
+			return err
+		}
+	}
+	return s.Recruiters.UpdateRecruiter(ctx, recruiterUpdate)
+}
+
+// Delete rekruiterni uning intervyulari bilan birga bitta tranzaksiyada o'chiradi
+func (s *RecruiterService) Delete(ctx context.Context, recruiterID uuid.UUID) error {
+	return s.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.Interviews.DeleteInterviewsByRecruiterID(ctx, recruiterID); err != nil {
+			return err
+		}
+		return s.Recruiters.DeleteRecruiter(ctx, recruiterID.String())
+	})
+}
@@ -0,0 +1,21 @@
+// Package service - biznes qoidalari. Handlerlar HTTP bilan, repositorylar faqat saqlash bilan
+// shug'ullanadi, qoidalar esa shu yerda. Sof qoida funksiyalari bazasiz tekshirilishi mumkin.
+package service
+
+import (
+	"hrplatform/apperrors"
+	"hrplatform/models"
+)
+
+// CheckCompanyActive kompaniya o'chirilmaganini tekshiradi. Vakansiya va rekruiterlar
+// faqat faol kompaniyaga bog'lanadi.
+func CheckCompanyActive(company models.Company) error {
+	if company.DeletedAt != 0 {
+		return companyNotFound()
+	}
+	return nil
+}
+
+func companyNotFound() *apperrors.Error {
+	return apperrors.Validation("company_not_found", "Company with the given ID does not exist").WithField("company_id", "does not exist")
+}
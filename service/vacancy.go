@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+type VacancyService struct {
+	Vacancies  postgres.VacancyRepository
+	Interviews postgres.InterviewRepository
+	Companies  *CompanyService
+	UnitOfWork postgres.UnitOfWork
+}
+
+// Create vakansiyani faqat faol kompaniya uchun yaratadi
+func (s *VacancyService) Create(ctx context.Context, vacancyCreate models.CreateVacancy) (models.Vacancy, error) {
+	if err := s.Companies.RequireCompany(ctx, vacancyCreate.CompanyID); err != nil {
+		return models.Vacancy{}, err
+	}
+	return s.Vacancies.CreateVacancy(ctx, vacancyCreate)
+}
+
+// Update vakansiyani yozadi. Kompaniya o'zgargan bo'lsa yangisi tekshiriladi.
+func (s *VacancyService) Update(ctx context.Context, before models.Vacancy, vacancyUpdate models.UpdateVacancy) error {
+	if vacancyUpdate.CompanyID != before.CompanyID {
+		if err := s.Companies.RequireCompany(ctx, vacancyUpdate.CompanyID); err != nil {
+			return err
+		}
+	}
+	return s.Vacancies.UpdateVacancy(ctx, vacancyUpdate)
+}
+
+// Delete vakansiyani uning intervyulari bilan birga bitta tranzaksiyada o'chiradi
+func (s *VacancyService) Delete(ctx context.Context, vacancyID uuid.UUID) error {
+	return s.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.Interviews.DeleteInterviewsByVacancyID(ctx, vacancyID); err != nil {
+			return err
+		}
+		return s.Vacancies.DeleteVacancy(ctx, vacancyID)
+	})
+}
@@ -0,0 +1,42 @@
+package utils
+
+import "strings"
+
+// NormalizeUzPhone telefon raqamidan ortiqcha belgilarni olib tashlab "+998XXXXXXXXX" ko'rinishiga keltiradi.
+// Raqam O'zbekiston formatiga to'g'ri kelmasa false qaytaradi.
+func NormalizeUzPhone(phone string) (string, bool) {
+	var digits strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	d := digits.String()
+
+	switch {
+	case len(d) == 12 && strings.HasPrefix(d, "998"):
+		return "+" + d, true
+	case len(d) == 9:
+		return "+998" + d, true
+	}
+	return "", false
+}
+
+// NormalizePhone unikallikni tekshirish va saqlash uchun telefon raqamini yagona ko'rinishga keltiradi:
+// O'zbekiston raqamlari "+998XXXXXXXXX", qolganlari "+" va faqat raqamlar.
+func NormalizePhone(phone string) string {
+	if normalized, ok := NormalizeUzPhone(phone); ok {
+		return normalized
+	}
+
+	var digits strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	if digits.Len() == 0 {
+		return ""
+	}
+	return "+" + digits.String()
+}
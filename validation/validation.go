@@ -0,0 +1,171 @@
+// Package validation DTOlardagi `binding` teglarini tekshiradigan gin validatoriga
+// loyiha qoidalarini (telefon, jins, sana) qo'shadi va validator xatoliklarini
+// maydonlar ro'yxatiga ega bitta apperrors.Error ga aylantiradi.
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"hrplatform/apperrors"
+	"hrplatform/utils"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// Sana formatlari
+const (
+	DateLayout     = "2006-01-02"
+	DateTimeLayout = "2006-01-02 15:04:05"
+)
+
+// e164 - xalqaro E.164 formatidagi raqam: "+" va 8-15 ta raqam
+var e164 = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// Register loyiha qoidalarini gin ishlatadigan validatorga qo'shadi va xatoliklarda
+// Go maydon nomi o'rniga json nomini ishlatishni yoqadi. main da bir marta chaqiriladi.
+func Register() error {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return errors.New("validation: gin validator is not go-playground/validator")
+	}
+
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	rules := map[string]validator.Func{
+		"phone":    isPhone,
+		"gender":   isGender,
+		"date":     isDate,
+		"pastdate": isPastDate,
+	}
+	for tag, fn := range rules {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isPhone E.164 yoki O'zbekiston formatidagi raqamni qabul qiladi
+func isPhone(fl validator.FieldLevel) bool {
+	phone := fl.Field().String()
+	if e164.MatchString(phone) {
+		return true
+	}
+	_, ok := utils.NormalizeUzPhone(phone)
+	return ok
+}
+
+// isGender bazadagi CHECK (gender IN ('m', 'f')) bilan bir xil
+func isGender(fl validator.FieldLevel) bool {
+	gender := fl.Field().String()
+	return gender == "m" || gender == "f"
+}
+
+// isDate YYYY-MM-DD formatini tekshiradi
+func isDate(fl validator.FieldLevel) bool {
+	_, err := time.Parse(DateLayout, fl.Field().String())
+	return err == nil
+}
+
+// isPastDate YYYY-MM-DD formatidagi, bugundan oldingi va 1900-yildan keyingi sanani qabul qiladi
+func isPastDate(fl validator.FieldLevel) bool {
+	date, err := time.Parse(DateLayout, fl.Field().String())
+	if err != nil {
+		return false
+	}
+	return date.Year() >= 1900 && date.Before(time.Now())
+}
+
+// FromBindError ShouldBindJSON xatoligini domen xatoligiga aylantiradi:
+// validator xatoliklari barcha maydonlari bilan "validation_failed",
+// qolganlari (buzilgan JSON, noto'g'ri tur) "invalid_json" bo'ladi.
+func FromBindError(err error) *apperrors.Error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return apperrors.InvalidJSON(err)
+	}
+
+	appErr := apperrors.Validation("validation_failed", "Request validation failed")
+	for _, fe := range validationErrors {
+		appErr.Fields = append(appErr.Fields, apperrors.FieldError{
+			Field:   fieldPath(fe),
+			Message: message(fe),
+			Rule:    rule(fe),
+			Param:   fe.Param(),
+		})
+	}
+	return appErr
+}
+
+// Struct JSON dan o'qilmagan DTO ni (masalan gRPC so'rovidan yig'ilgan) o'sha `binding`
+// qoidalari bilan tekshiradi. Xatolik FromBindError dagi kabi "validation_failed" bo'ladi.
+func Struct(obj interface{}) error {
+	if err := binding.Validator.ValidateStruct(obj); err != nil {
+		return FromBindError(err)
+	}
+	return nil
+}
+
+// fieldPath "CreateUser.email" ko'rinishidagi nomdan struktura nomini olib tashlaydi
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return fe.Field()
+}
+
+// rule qoida nomini qaytaradi. Satrlar uchun min/max uzunlikni bildiradi,
+// shuning uchun ular alohida nomlanadi.
+func rule(fe validator.FieldError) string {
+	if fe.Kind() == reflect.String && (fe.Tag() == "min" || fe.Tag() == "max") {
+		return fe.Tag() + "_length"
+	}
+	return fe.Tag()
+}
+
+// message tarjima topilmaganda ishlatiladigan inglizcha matn
+func message(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "phone":
+		return "must be an E.164 or Uzbek phone number"
+	case "gender":
+		return "must be 'm' or 'f'"
+	case "date":
+		return "must be a date in YYYY-MM-DD format"
+	case "pastdate":
+		return "must be a past date in YYYY-MM-DD format"
+	case "datetime":
+		return "must match format " + fe.Param()
+	case "min":
+		if fe.Kind() == reflect.String {
+			return "must be at least " + fe.Param() + " characters long"
+		}
+		return "must be at least " + fe.Param()
+	case "max":
+		if fe.Kind() == reflect.String {
+			return "must be at most " + fe.Param() + " characters long"
+		}
+		return "must be at most " + fe.Param()
+	case "oneof":
+		return "must be one of: " + fe.Param()
+	case "http_url":
+		return "must be an absolute http(s) URL"
+	}
+	return "failed on the '" + fe.Tag() + "' rule"
+}
@@ -0,0 +1,208 @@
+package webhook
+
+import (
+	"context"
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"hrplatform/models"
+	"hrplatform/postgres"
+
+	"github.com/google/uuid"
+)
+
+// Webhook eventlari
+const (
+	EventInterviewScheduled       = "interview.scheduled"
+	EventApplicationStatusChanged = "application.status_changed"
+	EventVacancyClosed            = "vacancy.closed"
+)
+
+// Events - obuna bo'lish mumkin bo'lgan barcha eventlar
+var Events = []string{
+	EventInterviewScheduled,
+	EventApplicationStatusChanged,
+	EventVacancyClosed,
+}
+
+// IsValidEvent event nomi qo'llab-quvvatlanishini tekshiradi
+func IsValidEvent(event string) bool {
+	for _, e := range Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// maxResponseBody - delivery logida saqlanadigan javobning maksimal hajmi
+const maxResponseBody = 4096
+
+// Payload - webhook orqali yuboriladigan JSON tanasi
+type Payload struct {
+	ID        uuid.UUID   `json:"id"`
+	Event     string      `json:"event"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// Dispatcher eventlarni obuna bo'lgan webhooklarga imzolab yuboradi va
+// muvaffaqiyatsiz urinishlarni exponential backoff bilan qaytaradi.
+// nil Dispatcher hech narsa yubormaydi.
+type Dispatcher struct {
+	Repository  postgres.WebhookRepository
+	Client      *http.Client
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+// Dispatch eventni fonda yuboradi, so'rovni kutib turmaydi. ctx faqat obunalarni o'qish uchun,
+// yuborish esa so'rov tugaganidan keyin ham davom etadi.
+func (d *Dispatcher) Dispatch(ctx context.Context, companyID uuid.UUID, event string, data interface{}) {
+	if d == nil {
+		return
+	}
+
+	webhooks, err := d.Repository.GetSubscribedWebhooks(ctx, companyID, event)
+	if err != nil {
+		log.Printf("webhook: %s uchun webhooklarni olishda xatolik: %v", event, err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload := Payload{
+		ID:        uuid.New(),
+		Event:     event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("webhook: %s payloadini tayyorlashda xatolik: %v", event, err)
+		return
+	}
+
+	for _, wh := range webhooks {
+		go d.deliver(wh, payload.ID, event, body)
+	}
+}
+
+// deliver bitta webhookka MaxAttempts martagacha yuborishga urinadi
+func (d *Dispatcher) deliver(wh models.Webhook, eventID uuid.UUID, event string, body []byte) {
+	maxAttempts := d.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		delivery := d.send(wh, eventID, event, body)
+		delivery.Attempt = attempt
+
+		if err := d.Repository.CreateDelivery(context.Background(), delivery); err != nil {
+			log.Printf("webhook: delivery logini saqlashda xatolik: %v", err)
+		}
+		if delivery.Success {
+			return
+		}
+		if attempt < maxAttempts {
+			time.Sleep(Backoff(d.BaseDelay, attempt))
+		}
+	}
+}
+
+func (d *Dispatcher) send(wh models.Webhook, eventID uuid.UUID, event string, body []byte) models.WebhookDelivery {
+	delivery := models.WebhookDelivery{
+		ID:        uuid.New(),
+		WebhookID: wh.ID,
+		EventID:   eventID,
+		Event:     event,
+		Payload:   string(body),
+		CreatedAt: time.Now(),
+	}
+
+	req, err := http.NewRequest(http.MethodPost, wh.URL, bytes.NewReader(body))
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(DeliveryHeader, eventID.String())
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(wh.Secret, timestamp, body))
+
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	delivery.DurationMs = time.Since(start).Milliseconds()
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	delivery.StatusCode = resp.StatusCode
+	delivery.ResponseBody = string(respBody)
+	delivery.Success = resp.StatusCode >= 200 && resp.StatusCode < 300
+	if !delivery.Success {
+		delivery.Error = fmt.Sprintf("unexpected status code %d", resp.StatusCode)
+	}
+	return delivery
+}
+
+// Sign "timestamp.body" ustidan HMAC-SHA256 imzosini "sha256=<hex>" ko'rinishida qaytaradi.
+// Qabul qiluvchi tomon ham xuddi shu tartibda imzoni hisoblab solishtirishi kerak.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify kelgan imzoni tekshiradi
+func Verify(secret, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Backoff attempt-urinishdan keyin kutish vaqtini qaytaradi: base, 2*base, 4*base, ...
+func Backoff(base time.Duration, attempt int) time.Duration {
+	if base <= 0 {
+		base = time.Second
+	}
+	return base << uint(attempt-1)
+}
+
+// GenerateSecret webhook uchun tasodifiy maxfiy kalit yaratadi
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}